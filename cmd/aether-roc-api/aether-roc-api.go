@@ -6,13 +6,20 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"github.com/onosproject/aether-roc-api/pkg/manager"
+	toplevel "github.com/onosproject/aether-roc-api/pkg/toplevel/server"
 	"github.com/onosproject/onos-lib-go/pkg/certs"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"io/ioutil"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 )
 
@@ -48,19 +55,182 @@ func stringToLogLevel(l string) logging.Level {
 	}
 }
 
+// syncTLSConfig - the client TLS config for calling the sdcore synchronize service over https.
+// nil if no CA or client certificate is given
+func syncTLSConfig(caPath string, keyPath string, certPath string) (*tls.Config, error) {
+	if caPath == "" && certPath == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{}
+	if caPath != "" {
+		caCert, err := ioutil.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read sync CA certificate %s. %v", caPath, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", caPath)
+		}
+	}
+	if certPath != "" {
+		clientCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load sync client certificate %s. %v", certPath, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return tlsConfig, nil
+}
+
+// clientCertModes - how the HTTP server asks for client certificates, by clientCertMode
+var clientCertModes = map[string]tls.ClientAuthType{
+	"none":     tls.NoClientCert,
+	"optional": tls.VerifyClientCertIfGiven,
+	"require":  tls.RequireAndVerifyClientCert,
+}
+
+// serverTLSConfig - the TLS config of the HTTP server, verifying client certificates against the CA
+// of clientCaPath as clientCertMode says. nil, being plain http, if no server certificate is given
+func serverTLSConfig(certPath string, keyPath string, clientCaPath string, clientCertMode string) (*tls.Config, error) {
+	clientAuth, ok := clientCertModes[clientCertMode]
+	if !ok {
+		return nil, fmt.Errorf("clientCertMode %s is not none, optional or require", clientCertMode)
+	}
+	if certPath == "" {
+		if clientAuth != tls.NoClientCert {
+			return nil, fmt.Errorf("clientCertMode %s needs a tlsCertPath", clientCertMode)
+		}
+		return nil, nil
+	}
+	serverCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load server certificate %s. %v", certPath, err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   clientAuth,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientAuth == tls.NoClientCert {
+		return tlsConfig, nil
+	}
+	if clientCaPath == "" {
+		return nil, fmt.Errorf("clientCertMode %s needs a tlsClientCaPath", clientCertMode)
+	}
+	caCert, err := ioutil.ReadFile(clientCaPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client CA certificate %s. %v", clientCaPath, err)
+	}
+	tlsConfig.ClientCAs = x509.NewCertPool()
+	if !tlsConfig.ClientCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificates found in %s", clientCaPath)
+	}
+	return tlsConfig, nil
+}
+
+// parseClientCertRoles - the roles of client certificate identities, each given as identity=role1,role2
+func parseClientCertRoles(identityRoles []string) (map[string][]string, error) {
+	parsed := make(map[string][]string, len(identityRoles))
+	for _, identityRole := range identityRoles {
+		idx := strings.Index(identityRole, "=")
+		if idx <= 0 || idx == len(identityRole)-1 {
+			return nil, fmt.Errorf("client certificate role %s is not identity=role1,role2", identityRole)
+		}
+		identity := identityRole[:idx]
+		for _, role := range strings.Split(identityRole[idx+1:], ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				parsed[identity] = append(parsed[identity], role)
+			}
+		}
+	}
+	return parsed, nil
+}
+
+// parseTargetAliases - the aliases of targets, each given as target=alias
+func parseTargetAliases(aliases []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		idx := strings.Index(alias, "=")
+		if idx <= 0 || idx == len(alias)-1 {
+			return nil, fmt.Errorf("target alias %s is not target=alias", alias)
+		}
+		parsed[alias[:idx]] = alias[idx+1:]
+	}
+	return parsed, nil
+}
+
+// auditSink - the sink of the audit events given by auditLog: - for stdout, else a file appended to.
+// nil for none, leaving the default of stdout when authorization is enabled
+func auditSink(auditLog string) (toplevel.AuditSink, error) {
+	switch auditLog {
+	case "":
+		return nil, nil
+	case "-":
+		return toplevel.NewJSONAuditSink(os.Stdout), nil
+	}
+	file, err := os.OpenFile(auditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log %s %v", auditLog, err)
+	}
+	return toplevel.NewJSONAuditSink(file), nil
+}
+
 // Start a web server with REST interface proxying the gNMI interface to onos-config
 func main() {
 	var allowCorsOrigins arrayFlags
-	flag.Var(&allowCorsOrigins, "allowCorsOrigin", "URLs of CORS origins (repeated)")
+	flag.Var(&allowCorsOrigins, "allowCorsOrigin", "URLs of CORS origins (repeated). With none, only same origin browser pages may call the API")
+	var trustedProxies arrayFlags
+	flag.Var(&trustedProxies, "trustedProxy", "IP or CIDR of a proxy whose X-Forwarded-For gives the client IP (repeated)")
+	var webhooks arrayFlags
+	flag.Var(&webhooks, "webhook", "URL to POST each transaction to when it is applied (repeated). With none, no webhooks are called")
+	var targetAliases arrayFlags
+	flag.Var(&targetAliases, "targetAlias", "human readable name of a target, as target=alias (repeated)")
+	var redactPaths arrayFlags
+	flag.Var(&redactPaths, "redactPath", "gNMI path, with * for any name or key, whose values are shown as **** (repeated)")
+	var bodyContentTypes arrayFlags
+	flag.Var(&bodyContentTypes, "bodyContentType", "Content-Type allowed for PATCH and PUT bodies at /aether-roc-api (repeated). With none, JSON, JSON Merge Patch and YAML")
+	var clientCertRoles arrayFlags
+	flag.Var(&clientCertRoles, "clientCertRole", "roles of a client certificate common name or SAN, as identity=role1,role2 (repeated)")
 	caPath := flag.String("caPath", "", "path to CA certificate")
 	keyPath := flag.String("keyPath", "", "path to client private key")
 	certPath := flag.String("certPath", "", "path to client certificate")
 	gnmiEndpoint := flag.String("gnmiEndpoint", "onos-config:5150", "address of onos-config")
+	defaultTarget := flag.String("defaultTarget", "", "target of the requests to /aether-roc-api that do not give one (default the target must be given)")
 	gnmiTimeout := flag.Duration("gnmiTimeout", 10*time.Second, "timeout for the gnmi requests")
+	pathPrefix := flag.String("pathPrefix", "", "gNMI path put before the paths read, patched and deleted at /aether-roc-api, e.g. /config")
+	skipTargetCheck := flag.Bool("skipTargetCheck", false, "patch targets without checking that onos-config knows them, for targets created on first write")
+	transactionsCacheTTL := flag.Duration("transactionsCacheTTL", 2*time.Second, "how long the list of transactions is shared by GET /transactions requests and the ETags of reads (0 is not cached)")
+	targetsCacheTTL := flag.Duration("targetsCacheTTL", 10*time.Second, "how long the list of targets is cached (0 is not cached)")
+	gnmiMaxInFlight := flag.Int("gnmiMaxInFlight", 0, "most gnmi gets and sets to onos-config at once (0 is unlimited)")
+	handlerTimeout := flag.Duration("handlerTimeout", 3*time.Minute, "longest a request may take before it gets 503, never less than its gnmi timeout (0 is unlimited)")
+	gnmiTimeoutMax := flag.Duration("gnmiTimeoutMax", 2*time.Minute, "longest gnmi timeout a request may ask for with the X-Gnmi-Timeout header")
 	analyticsEndpoint := flag.String("analyticsEndpoint", "http://aether-roc-umbrella-prometheus-acc-server:9090", "prometheus address")
 	port := flag.Uint("port", 8181, "http port")
+	tlsCertPath := flag.String("tlsCertPath", "", "path to the server certificate, to serve https (default http)")
+	tlsKeyPath := flag.String("tlsKeyPath", "", "path to the server private key")
+	tlsClientCaPath := flag.String("tlsClientCaPath", "", "path to the CA certificate that client certificates are verified against")
+	clientCertMode := flag.String("clientCertMode", "none", "whether clients give a certificate (none, optional, require)")
 	validateResp := flag.Bool("validateResp", true, "Validate response are compliant with OpenAPI3 schema")
+	validateReq := flag.Bool("validateReq", false, "Validate the whole of PATCH bodies against the OpenAPI3 schema, listing every error")
 	logLevel := flag.String("logLevel", "INFO", "Set the log level (DEBUG, INFO, WARN, ERROR)")
+	gnmiRetries := flag.Int("gnmiRetries", 0, "how many times to retry a gnmi get while onos-config is unavailable")
+	syncScheme := flag.String("syncScheme", "http", "scheme (http or https) of the sdcore synchronize service")
+	syncPort := flag.Uint("syncPort", 8080, "port of the sdcore synchronize service")
+	syncTimeout := flag.Duration("syncTimeout", 30*time.Second, "timeout for calls to the sdcore synchronize service")
+	syncCaPath := flag.String("syncCaPath", "", "path to CA certificate of the sdcore synchronize service")
+	syncKeyPath := flag.String("syncKeyPath", "", "path to client private key for the sdcore synchronize service")
+	syncCertPath := flag.String("syncCertPath", "", "path to client certificate for the sdcore synchronize service")
+	transactionsReadRate := flag.Float64("transactionsReadRate", 0, "max transactions per second read from onos-config (0 is unlimited)")
+	jwksCacheTTL := flag.Duration("jwksCacheTTL", time.Hour, "how long the keys from jwksURL are used before they are fetched again (0 is until an unknown key ID)")
+	jwksURL := flag.String("jwksURL", "", "URL of the JWKS token signing keys of the ID provider (default from the OIDC server)")
+	jwtAudience := flag.String("jwtAudience", "", "audience that tokens must be issued for (default not checked)")
+	maxBodyBytes := flag.Int64("maxBodyBytes", 4<<20, "largest PATCH body accepted in bytes")
+	maxStreams := flag.Int("maxStreams", 0, "most transaction streams open at once. 0 is unlimited")
+	shutdownGrace := flag.Duration("shutdownGrace", 25*time.Second, "how long requests in flight are given to finish on SIGTERM")
+	rateLimit := flag.Float64("rateLimit", 0, "requests per second allowed from each client IP (0 is unlimited)")
+	rateBurst := flag.Int("rateBurst", 20, "requests a client IP may make at once before rateLimit applies")
+	auditLog := flag.String("auditLog", "", "file the audit events of changes are appended to as JSON, or - for stdout (default stdout when authorization is enabled, else none)")
+	roleMap := flag.String("roleMap", "", "path to a YAML file of the roles needed for each operation (default read for GET, write or admin for changes)")
 	flag.Parse()
 
 	log.SetLevel(stringToLogLevel(*logLevel))
@@ -73,8 +243,44 @@ func main() {
 		"keyPath", *keyPath,
 		"gnmiTimeout", fmt.Sprintf("%gs", gnmiTimeout.Seconds()),
 		"port", *port,
+		"tlsCertPath", *tlsCertPath,
+		"tlsKeyPath", *tlsKeyPath,
+		"tlsClientCaPath", *tlsClientCaPath,
+		"clientCertMode", *clientCertMode,
+		"clientCertRole", clientCertRoles,
 		"validateResp", *validateResp,
-		"logLevel", *logLevel)
+		"validateReq", *validateReq,
+		"logLevel", *logLevel,
+		"gnmiRetries", *gnmiRetries,
+		"syncScheme", *syncScheme,
+		"syncPort", *syncPort,
+		"syncTimeout", fmt.Sprintf("%gs", syncTimeout.Seconds()),
+		"syncCaPath", *syncCaPath,
+		"syncKeyPath", *syncKeyPath,
+		"transactionsReadRate", *transactionsReadRate,
+		"jwksURL", *jwksURL,
+		"jwksCacheTTL", fmt.Sprintf("%gs", jwksCacheTTL.Seconds()),
+		"jwtAudience", *jwtAudience,
+		"gnmiMaxInFlight", *gnmiMaxInFlight,
+		"gnmiTimeoutMax", fmt.Sprintf("%gs", gnmiTimeoutMax.Seconds()),
+		"handlerTimeout", fmt.Sprintf("%gs", handlerTimeout.Seconds()),
+		"targetsCacheTTL", fmt.Sprintf("%gs", targetsCacheTTL.Seconds()),
+		"transactionsCacheTTL", fmt.Sprintf("%gs", transactionsCacheTTL.Seconds()),
+		"pathPrefix", *pathPrefix,
+		"skipTargetCheck", *skipTargetCheck,
+		"maxBodyBytes", *maxBodyBytes,
+		"maxStreams", *maxStreams,
+		"roleMap", *roleMap,
+		"auditLog", *auditLog,
+		"rateLimit", *rateLimit,
+		"rateBurst", *rateBurst,
+		"trustedProxy", trustedProxies,
+		"webhook", webhooks,
+		"redactPath", redactPaths,
+		"bodyContentType", bodyContentTypes,
+		"targetAlias", targetAliases,
+		"defaultTarget", *defaultTarget,
+		"shutdownGrace", fmt.Sprintf("%gs", shutdownGrace.Seconds()))
 
 	opts, err := certs.HandleCertPaths(*caPath, *keyPath, *certPath, true)
 	if err != nil {
@@ -87,16 +293,116 @@ func main() {
 		authorization = true
 		log.Infof("Authorization enabled. %s=%s", OIDCServerURL, oidcURL)
 		// OIDCServerURL is also referenced in jwt.go (from onos-lib-go)
-		// It only applies to /sdcore/synchronize/:id (all gnmi requests are passed
-		// down to onos-config for authorization)
+		// Every route is then checked against the roles it needs in the role map (-roleMap,
+		// else server.DefaultRoleRequirements), and gnmi requests still carry the token
+		// down to onos-config for its own authorization
+	} else if *jwksURL != "" {
+		authorization = true
+		log.Infof("Authorization enabled. jwksURL=%s", *jwksURL)
+	} else if *clientCertMode != "none" {
+		authorization = true
+		log.Infof("Authorization enabled. clientCertMode=%s", *clientCertMode)
 	} else {
 		log.Infof("Authorization not enabled %s", os.Getenv(OIDCServerURL))
 	}
 
-	mgr, err := manager.NewManager(*gnmiEndpoint, *analyticsEndpoint, allowCorsOrigins, *validateResp, authorization, *gnmiTimeout, opts...)
+	serverTLS, err := serverTLSConfig(*tlsCertPath, *tlsKeyPath, *tlsClientCaPath, *clientCertMode)
 	if err != nil {
 		log.Fatal(err)
 		os.Exit(-1)
 	}
-	mgr.Run(*port)
+
+	certRoles, err := parseClientCertRoles(clientCertRoles)
+	if err != nil {
+		log.Fatal(err)
+		os.Exit(-1)
+	}
+
+	syncTLS, err := syncTLSConfig(*syncCaPath, *syncKeyPath, *syncCertPath)
+	if err != nil {
+		log.Fatal(err)
+		os.Exit(-1)
+	}
+
+	aliases, err := parseTargetAliases(targetAliases)
+	if err != nil {
+		log.Fatal(err)
+		os.Exit(-1)
+	}
+
+	audit, err := auditSink(*auditLog)
+	if err != nil {
+		log.Fatal(err)
+		os.Exit(-1)
+	}
+
+	var roles toplevel.RoleRequirements
+	if *roleMap != "" {
+		if roles, err = toplevel.LoadRoleRequirements(*roleMap); err != nil {
+			log.Fatal(err)
+			os.Exit(-1)
+		}
+	}
+
+	topLevel := &toplevel.TopLevelServer{
+		GnmiRetries:          *gnmiRetries,
+		GnmiTimeoutMax:       *gnmiTimeoutMax,
+		HandlerTimeout:       *handlerTimeout,
+		GnmiMaxInFlight:      *gnmiMaxInFlight,
+		TransactionsReadRate: *transactionsReadRate,
+		SyncScheme:           *syncScheme,
+		SyncPort:             *syncPort,
+		SyncTLSConfig:        syncTLS,
+		SyncTimeout:          *syncTimeout,
+		JWKSURL:              *jwksURL,
+		JWKSCacheTTL:         *jwksCacheTTL,
+		JWTAudience:          *jwtAudience,
+		ClientCertRoles:      certRoles,
+		RoleRequirements:     roles,
+		AuditSink:            audit,
+		MaxBodyBytes:         *maxBodyBytes,
+		MaxStreams:           *maxStreams,
+		ValidateRequests:     *validateReq,
+		RateLimit:            *rateLimit,
+		RateBurst:            *rateBurst,
+		TrustedProxies:       trustedProxies,
+		TargetsCacheTTL:      *targetsCacheTTL,
+		TransactionsCacheTTL: *transactionsCacheTTL,
+		PathPrefix:           *pathPrefix,
+		SkipTargetCheck:      *skipTargetCheck,
+		WebhookURLs:          webhooks,
+		RedactPaths:          redactPaths,
+		BodyContentTypes:     bodyContentTypes,
+		TargetAliases:        aliases,
+		DefaultTarget:        *defaultTarget,
+	}
+
+	mgr, err := manager.NewManager(*gnmiEndpoint, *analyticsEndpoint, allowCorsOrigins, *validateResp, authorization, *gnmiTimeout, topLevel, opts...)
+	if err != nil {
+		log.Fatal(err)
+		os.Exit(-1)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- mgr.Run(*port, serverTLS)
+	}()
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			log.Fatal(err)
+			os.Exit(-1)
+		}
+	case sig := <-signals:
+		// Run returns as soon as Shutdown starts, so wait here for the requests in flight
+		log.Infof("Received %s. Shutting down", sig)
+		ctx, cancel := context.WithTimeout(context.Background(), *shutdownGrace)
+		defer cancel()
+		if err := mgr.Shutdown(ctx); err != nil {
+			log.Warn(err)
+		}
+	}
 }
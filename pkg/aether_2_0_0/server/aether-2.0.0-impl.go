@@ -33,7 +33,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServices(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetConnectivityServices(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -41,7 +41,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServices(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -58,7 +58,7 @@ func (i *ServerImpl) gnmiGetConnectivityServices(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -99,7 +99,7 @@ func (i *ServerImpl) gnmiPostConnectivityServices(ctx context.Context, body []by
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -114,7 +114,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServicesConnectivityService(ctx conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetConnectivityServicesConnectivityService(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -122,7 +122,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServicesConnectivityService(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -139,7 +139,7 @@ func (i *ServerImpl) gnmiGetConnectivityServicesConnectivityService(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -180,7 +180,7 @@ func (i *ServerImpl) gnmiPostConnectivityServicesConnectivityService(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -195,7 +195,7 @@ func (i *ServerImpl) gnmiDeleteEnterprises(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprises(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -203,7 +203,7 @@ func (i *ServerImpl) gnmiDeleteEnterprises(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -220,7 +220,7 @@ func (i *ServerImpl) gnmiGetEnterprises(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -261,7 +261,7 @@ func (i *ServerImpl) gnmiPostEnterprises(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -276,7 +276,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterprise(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterprise(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -284,7 +284,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -301,7 +301,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -342,7 +342,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterprise(ctx context.Context, body []b
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -357,7 +357,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplication(ctx context.Cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseApplication(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -365,7 +365,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplication(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -382,7 +382,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseApplication(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -423,7 +423,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseApplication(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -438,7 +438,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpoint(ctx cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseApplicationEndpoint(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -446,7 +446,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpoint(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -463,7 +463,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseApplicationEndpoint(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -504,7 +504,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseApplicationEndpoint(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -519,7 +519,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpointList(ctx
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseApplicationEndpointList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -527,7 +527,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpointList(ctx
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -544,7 +544,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseApplicationEndpointList(ctx con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -585,7 +585,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseApplicationEndpointList(ctx co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -600,7 +600,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpointMbr(ctx c
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseApplicationEndpointMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -608,7 +608,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationEndpointMbr(ctx c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -625,7 +625,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseApplicationEndpointMbr(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -666,7 +666,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseApplicationEndpointMbr(ctx con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -681,7 +681,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationList(ctx context.
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseApplicationList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -689,7 +689,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseApplicationList(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -706,7 +706,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseApplicationList(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -747,7 +747,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseApplicationList(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -762,7 +762,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseConnectivityService(ctx cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseConnectivityService(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -770,7 +770,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseConnectivityService(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -787,7 +787,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseConnectivityService(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -828,7 +828,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseConnectivityService(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -843,7 +843,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseConnectivityServiceList(ctx
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseConnectivityServiceList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -851,7 +851,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseConnectivityServiceList(ctx
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -868,7 +868,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseConnectivityServiceList(ctx con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -909,7 +909,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseConnectivityServiceList(ctx co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -924,7 +924,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseList(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -932,7 +932,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseList(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -949,7 +949,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseList(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -990,7 +990,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseList(ctx context.Context, body
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1005,7 +1005,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSite(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSite(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1013,7 +1013,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1030,7 +1030,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1071,7 +1071,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSite(ctx context.Context, body
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1086,7 +1086,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDevice(ctx context.Conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDevice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1094,7 +1094,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDevice(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1111,7 +1111,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDevice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1152,7 +1152,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDevice(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1167,7 +1167,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroup(ctx context.
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceGroup(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1175,7 +1175,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroup(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1192,7 +1192,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceGroup(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1233,7 +1233,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceGroup(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1248,7 +1248,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupDevice(ctx co
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceGroupDevice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1256,7 +1256,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupDevice(ctx co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1273,7 +1273,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceGroupDevice(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1314,7 +1314,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceGroupDevice(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1329,7 +1329,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupDeviceList(ct
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceGroupDeviceList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1337,7 +1337,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupDeviceList(ct
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1354,7 +1354,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceGroupDeviceList(ctx c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1395,7 +1395,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceGroupDeviceList(ctx
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1410,7 +1410,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupList(ctx cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceGroupList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1418,7 +1418,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupList(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1435,7 +1435,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceGroupList(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1476,7 +1476,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceGroupList(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1491,7 +1491,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupMbr(ctx conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceGroupMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1499,7 +1499,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupMbr(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1516,7 +1516,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceGroupMbr(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1557,7 +1557,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceGroupMbr(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1572,7 +1572,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceList(ctx context.C
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteDeviceList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1580,7 +1580,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteDeviceList(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1597,7 +1597,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteDeviceList(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1638,7 +1638,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteDeviceList(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1653,7 +1653,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteImsiDefinition(ctx conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteImsiDefinition(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1661,7 +1661,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteImsiDefinition(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1678,7 +1678,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteImsiDefinition(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1719,7 +1719,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteImsiDefinition(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1734,7 +1734,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteIpDomain(ctx context.Con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteIpDomain(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1742,7 +1742,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteIpDomain(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1759,7 +1759,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteIpDomain(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1800,7 +1800,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteIpDomain(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1815,7 +1815,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteIpDomainList(ctx context
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteIpDomainList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1823,7 +1823,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteIpDomainList(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1840,7 +1840,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteIpDomainList(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1881,7 +1881,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteIpDomainList(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1896,7 +1896,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteList(ctx context.Context
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1904,7 +1904,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteList(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1921,7 +1921,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteList(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1962,7 +1962,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteList(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1977,7 +1977,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoring(ctx context.C
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteMonitoring(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1985,7 +1985,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoring(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2002,7 +2002,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteMonitoring(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2043,7 +2043,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteMonitoring(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2058,7 +2058,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2066,7 +2066,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2083,7 +2083,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2124,7 +2124,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2139,7 +2139,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoringEdgeDeviceList
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteMonitoringEdgeDeviceList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2147,7 +2147,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteMonitoringEdgeDeviceList
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2164,7 +2164,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteMonitoringEdgeDeviceList(ct
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2205,7 +2205,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteMonitoringEdgeDeviceList(c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2220,7 +2220,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSimCard(ctx context.Cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSimCard(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2228,7 +2228,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSimCard(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2245,7 +2245,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSimCard(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2286,7 +2286,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSimCard(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2301,7 +2301,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSimCardList(ctx context.
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSimCardList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2309,7 +2309,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSimCardList(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2326,7 +2326,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSimCardList(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2367,7 +2367,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSimCardList(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2382,7 +2382,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlice(ctx context.Contex
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSlice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2390,7 +2390,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlice(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2407,7 +2407,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2448,7 +2448,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2463,7 +2463,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceDeviceGroup(ctx con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceDeviceGroup(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2471,7 +2471,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceDeviceGroup(ctx con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2488,7 +2488,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceDeviceGroup(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2529,7 +2529,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceDeviceGroup(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2544,7 +2544,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2552,7 +2552,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2569,7 +2569,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2610,7 +2610,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2625,7 +2625,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceFilter(ctx context.
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceFilter(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2633,7 +2633,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceFilter(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2650,7 +2650,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceFilter(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2691,7 +2691,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceFilter(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2706,7 +2706,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceFilterList(ctx cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceFilterList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2714,7 +2714,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceFilterList(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2731,7 +2731,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceFilterList(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2772,7 +2772,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceFilterList(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2787,7 +2787,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceList(ctx context.Co
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2795,7 +2795,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceList(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2812,7 +2812,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceList(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2853,7 +2853,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceList(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2868,7 +2868,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceMbr(ctx context.Con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSliceMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2876,7 +2876,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSliceMbr(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2893,7 +2893,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSliceMbr(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2934,7 +2934,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSliceMbr(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2949,7 +2949,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2957,7 +2957,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2974,7 +2974,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ct
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3015,7 +3015,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSlicePriorityTrafficRule(c
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3030,7 +3030,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleGbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3038,7 +3038,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3055,7 +3055,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleGbr
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3096,7 +3096,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSlicePriorityTrafficRuleGb
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3111,7 +3111,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3119,7 +3119,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3136,7 +3136,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleLis
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3177,7 +3177,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSlicePriorityTrafficRuleLi
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3192,7 +3192,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3200,7 +3200,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3217,7 +3217,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleMbr
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3258,7 +3258,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSlicePriorityTrafficRuleMb
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3273,7 +3273,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSmallCell(ctx context.Co
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSmallCell(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3281,7 +3281,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSmallCell(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3298,7 +3298,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSmallCell(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3339,7 +3339,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSmallCell(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3354,7 +3354,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSmallCellList(ctx contex
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteSmallCellList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3362,7 +3362,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteSmallCellList(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3379,7 +3379,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteSmallCellList(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3420,7 +3420,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteSmallCellList(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3435,7 +3435,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteUpf(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteUpf(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3443,7 +3443,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3460,7 +3460,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3501,7 +3501,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteUpf(ctx context.Context, b
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3516,7 +3516,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteUpfList(ctx context.Cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseSiteUpfList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3524,7 +3524,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseSiteUpfList(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3541,7 +3541,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseSiteUpfList(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3582,7 +3582,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseSiteUpfList(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3597,7 +3597,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplate(ctx context.Context
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseTemplate(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3605,7 +3605,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplate(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3622,7 +3622,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3663,7 +3663,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3678,7 +3678,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplateList(ctx context.Con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseTemplateList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3686,7 +3686,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplateList(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3703,7 +3703,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseTemplateList(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3744,7 +3744,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseTemplateList(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3759,7 +3759,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplateMbr(ctx context.Cont
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseTemplateMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3767,7 +3767,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTemplateMbr(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3784,7 +3784,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseTemplateMbr(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3825,7 +3825,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseTemplateMbr(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3840,7 +3840,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTrafficClass(ctx context.Con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseTrafficClass(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3848,7 +3848,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTrafficClass(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3865,7 +3865,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseTrafficClass(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3906,7 +3906,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseTrafficClass(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3921,7 +3921,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTrafficClassList(ctx context
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprisesEnterpriseTrafficClassList(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -3929,7 +3929,7 @@ func (i *ServerImpl) gnmiDeleteEnterprisesEnterpriseTrafficClassList(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -3946,7 +3946,7 @@ func (i *ServerImpl) gnmiGetEnterprisesEnterpriseTrafficClassList(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -3987,7 +3987,7 @@ func (i *ServerImpl) gnmiPostEnterprisesEnterpriseTrafficClassList(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -4002,7 +4002,7 @@ func (i *ServerImpl) gnmiDeleteTarget(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTarget(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -4010,7 +4010,7 @@ func (i *ServerImpl) gnmiDeleteTarget(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -4027,7 +4027,7 @@ func (i *ServerImpl) gnmiGetTarget(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -4201,7 +4201,7 @@ func (i *ServerImpl) DeleteConnectivityServices(ctx echo.Context, target externa
 	// Response
 	extension100, err := i.gnmiDeleteConnectivityServices(gnmiCtx, "/aether/v2.0.0/{target}/connectivity-services", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4213,7 +4213,7 @@ func (i *ServerImpl) DeleteConnectivityServices(ctx echo.Context, target externa
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteConnectivityServices")
+	log.Debugf("DeleteConnectivityServices")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4237,7 +4237,7 @@ func (i *ServerImpl) GetConnectivityServices(ctx echo.Context, target externalRe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetConnectivityServices")
+	log.Debugf("GetConnectivityServices")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4258,7 +4258,7 @@ func (i *ServerImpl) PostConnectivityServices(ctx echo.Context, target externalR
 	}
 	extension100, err := i.gnmiPostConnectivityServices(gnmiCtx, body, "/aether/v2.0.0/{target}/connectivity-services", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4270,7 +4270,7 @@ func (i *ServerImpl) PostConnectivityServices(ctx echo.Context, target externalR
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostConnectivityServices")
+	log.Debugf("PostConnectivityServices")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4286,7 +4286,7 @@ func (i *ServerImpl) DeleteConnectivityServicesConnectivityService(ctx echo.Cont
 	// Response
 	extension100, err := i.gnmiDeleteConnectivityServicesConnectivityService(gnmiCtx, "/aether/v2.0.0/{target}/connectivity-services/connectivity-service/{connectivity-service-id}", target, connectivityServiceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4298,7 +4298,7 @@ func (i *ServerImpl) DeleteConnectivityServicesConnectivityService(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteConnectivityServicesConnectivityService")
+	log.Debugf("DeleteConnectivityServicesConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4322,7 +4322,7 @@ func (i *ServerImpl) GetConnectivityServicesConnectivityService(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetConnectivityServicesConnectivityService")
+	log.Debugf("GetConnectivityServicesConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4343,7 +4343,7 @@ func (i *ServerImpl) PostConnectivityServicesConnectivityService(ctx echo.Contex
 	}
 	extension100, err := i.gnmiPostConnectivityServicesConnectivityService(gnmiCtx, body, "/aether/v2.0.0/{target}/connectivity-services/connectivity-service/{connectivity-service-id}", target, connectivityServiceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4355,7 +4355,7 @@ func (i *ServerImpl) PostConnectivityServicesConnectivityService(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostConnectivityServicesConnectivityService")
+	log.Debugf("PostConnectivityServicesConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4371,7 +4371,7 @@ func (i *ServerImpl) DeleteEnterprises(ctx echo.Context, target externalRef0.Tar
 	// Response
 	extension100, err := i.gnmiDeleteEnterprises(gnmiCtx, "/aether/v2.0.0/{target}/enterprises", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4383,7 +4383,7 @@ func (i *ServerImpl) DeleteEnterprises(ctx echo.Context, target externalRef0.Tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprises")
+	log.Debugf("DeleteEnterprises")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4407,7 +4407,7 @@ func (i *ServerImpl) GetEnterprises(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprises")
+	log.Debugf("GetEnterprises")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4428,7 +4428,7 @@ func (i *ServerImpl) PostEnterprises(ctx echo.Context, target externalRef0.Targe
 	}
 	extension100, err := i.gnmiPostEnterprises(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4440,7 +4440,7 @@ func (i *ServerImpl) PostEnterprises(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprises")
+	log.Debugf("PostEnterprises")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4464,7 +4464,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseList(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseList")
+	log.Debugf("GetEnterprisesEnterpriseList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4480,7 +4480,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterprise(ctx echo.Context, target extern
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterprise(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}", target, enterpriseId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4492,7 +4492,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterprise(ctx echo.Context, target extern
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterprise")
+	log.Debugf("DeleteEnterprisesEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4516,7 +4516,7 @@ func (i *ServerImpl) GetEnterprisesEnterprise(ctx echo.Context, target externalR
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterprise")
+	log.Debugf("GetEnterprisesEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4537,7 +4537,7 @@ func (i *ServerImpl) PostEnterprisesEnterprise(ctx echo.Context, target external
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterprise(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}", target, enterpriseId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4549,7 +4549,7 @@ func (i *ServerImpl) PostEnterprisesEnterprise(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterprise")
+	log.Debugf("PostEnterprisesEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4573,7 +4573,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseApplicationList(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseApplicationList")
+	log.Debugf("GetEnterprisesEnterpriseApplicationList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4589,7 +4589,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplication(ctx echo.Context, ta
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseApplication(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}", target, enterpriseId, applicationId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4601,7 +4601,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplication(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseApplication")
+	log.Debugf("DeleteEnterprisesEnterpriseApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4625,7 +4625,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseApplication(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseApplication")
+	log.Debugf("GetEnterprisesEnterpriseApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4646,7 +4646,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplication(ctx echo.Context, targ
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseApplication(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}", target, enterpriseId, applicationId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4658,7 +4658,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplication(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseApplication")
+	log.Debugf("PostEnterprisesEnterpriseApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4682,7 +4682,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseApplicationEndpointList(ctx echo.Co
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseApplicationEndpointList")
+	log.Debugf("GetEnterprisesEnterpriseApplicationEndpointList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4698,7 +4698,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplicationEndpoint(ctx echo.Con
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseApplicationEndpoint(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}/endpoint/{endpoint-id}", target, enterpriseId, applicationId, endpointId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4710,7 +4710,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplicationEndpoint(ctx echo.Con
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseApplicationEndpoint")
+	log.Debugf("DeleteEnterprisesEnterpriseApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4734,7 +4734,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseApplicationEndpoint(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseApplicationEndpoint")
+	log.Debugf("GetEnterprisesEnterpriseApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4755,7 +4755,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplicationEndpoint(ctx echo.Conte
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseApplicationEndpoint(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}/endpoint/{endpoint-id}", target, enterpriseId, applicationId, endpointId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4767,7 +4767,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplicationEndpoint(ctx echo.Conte
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseApplicationEndpoint")
+	log.Debugf("PostEnterprisesEnterpriseApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4783,7 +4783,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplicationEndpointMbr(ctx echo.
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseApplicationEndpointMbr(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}/endpoint/{endpoint-id}/mbr", target, enterpriseId, applicationId, endpointId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4795,7 +4795,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseApplicationEndpointMbr(ctx echo.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseApplicationEndpointMbr")
+	log.Debugf("DeleteEnterprisesEnterpriseApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4819,7 +4819,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseApplicationEndpointMbr(ctx echo.Con
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseApplicationEndpointMbr")
+	log.Debugf("GetEnterprisesEnterpriseApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4840,7 +4840,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplicationEndpointMbr(ctx echo.Co
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseApplicationEndpointMbr(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/application/{application-id}/endpoint/{endpoint-id}/mbr", target, enterpriseId, applicationId, endpointId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4852,7 +4852,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseApplicationEndpointMbr(ctx echo.Co
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseApplicationEndpointMbr")
+	log.Debugf("PostEnterprisesEnterpriseApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4876,7 +4876,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseConnectivityServiceList(ctx echo.Co
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseConnectivityServiceList")
+	log.Debugf("GetEnterprisesEnterpriseConnectivityServiceList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4892,7 +4892,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseConnectivityService(ctx echo.Con
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseConnectivityService(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/connectivity-service/{connectivity-service}", target, enterpriseId, connectivityService)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4904,7 +4904,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseConnectivityService(ctx echo.Con
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseConnectivityService")
+	log.Debugf("DeleteEnterprisesEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4928,7 +4928,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseConnectivityService(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseConnectivityService")
+	log.Debugf("GetEnterprisesEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4949,7 +4949,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseConnectivityService(ctx echo.Conte
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseConnectivityService(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/connectivity-service/{connectivity-service}", target, enterpriseId, connectivityService)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4961,7 +4961,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseConnectivityService(ctx echo.Conte
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseConnectivityService")
+	log.Debugf("PostEnterprisesEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4985,7 +4985,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteList(ctx echo.Context, target e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteList")
+	log.Debugf("GetEnterprisesEnterpriseSiteList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5001,7 +5001,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSite(ctx echo.Context, target ex
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSite(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5013,7 +5013,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSite(ctx echo.Context, target ex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSite")
+	log.Debugf("DeleteEnterprisesEnterpriseSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5037,7 +5037,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSite(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSite")
+	log.Debugf("GetEnterprisesEnterpriseSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5058,7 +5058,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSite(ctx echo.Context, target exte
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSite(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5070,7 +5070,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSite(ctx echo.Context, target exte
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSite")
+	log.Debugf("PostEnterprisesEnterpriseSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5094,7 +5094,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceList(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceList")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5118,7 +5118,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceGroupList(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceGroupList")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceGroupList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5134,7 +5134,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroup(ctx echo.Context
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteDeviceGroup(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}", target, enterpriseId, siteId, deviceGroupId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5146,7 +5146,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroup(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteDeviceGroup")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5170,7 +5170,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceGroup(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceGroup")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5191,7 +5191,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroup(ctx echo.Context,
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteDeviceGroup(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}", target, enterpriseId, siteId, deviceGroupId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5203,7 +5203,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroup(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteDeviceGroup")
+	log.Debugf("PostEnterprisesEnterpriseSiteDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5227,7 +5227,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceGroupDeviceList(ctx echo.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceGroupDeviceList")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceGroupDeviceList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5243,7 +5243,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroupDevice(ctx echo.C
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupDevice(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}/device/{device-id}", target, enterpriseId, siteId, deviceGroupId, deviceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5255,7 +5255,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroupDevice(ctx echo.C
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteDeviceGroupDevice")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5279,7 +5279,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceGroupDevice(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceGroupDevice")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5300,7 +5300,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroupDevice(ctx echo.Con
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteDeviceGroupDevice(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}/device/{device-id}", target, enterpriseId, siteId, deviceGroupId, deviceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5312,7 +5312,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroupDevice(ctx echo.Con
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteDeviceGroupDevice")
+	log.Debugf("PostEnterprisesEnterpriseSiteDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5328,7 +5328,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroupMbr(ctx echo.Cont
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteDeviceGroupMbr(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}/mbr", target, enterpriseId, siteId, deviceGroupId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5340,7 +5340,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDeviceGroupMbr(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteDeviceGroupMbr")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteDeviceGroupMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5364,7 +5364,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDeviceGroupMbr(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDeviceGroupMbr")
+	log.Debugf("GetEnterprisesEnterpriseSiteDeviceGroupMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5385,7 +5385,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroupMbr(ctx echo.Contex
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteDeviceGroupMbr(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device-group/{device-group-id}/mbr", target, enterpriseId, siteId, deviceGroupId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5397,7 +5397,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDeviceGroupMbr(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteDeviceGroupMbr")
+	log.Debugf("PostEnterprisesEnterpriseSiteDeviceGroupMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5413,7 +5413,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDevice(ctx echo.Context, tar
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteDevice(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device/{device-id}", target, enterpriseId, siteId, deviceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5425,7 +5425,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteDevice(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteDevice")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5449,7 +5449,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteDevice(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteDevice")
+	log.Debugf("GetEnterprisesEnterpriseSiteDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5470,7 +5470,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDevice(ctx echo.Context, targe
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteDevice(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/device/{device-id}", target, enterpriseId, siteId, deviceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5482,7 +5482,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteDevice(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteDevice")
+	log.Debugf("PostEnterprisesEnterpriseSiteDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5498,7 +5498,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteImsiDefinition(ctx echo.Cont
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteImsiDefinition(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/imsi-definition", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5510,7 +5510,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteImsiDefinition(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteImsiDefinition")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5534,7 +5534,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteImsiDefinition(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteImsiDefinition")
+	log.Debugf("GetEnterprisesEnterpriseSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5555,7 +5555,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteImsiDefinition(ctx echo.Contex
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteImsiDefinition(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/imsi-definition", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5567,7 +5567,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteImsiDefinition(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteImsiDefinition")
+	log.Debugf("PostEnterprisesEnterpriseSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5591,7 +5591,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteIpDomainList(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteIpDomainList")
+	log.Debugf("GetEnterprisesEnterpriseSiteIpDomainList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5607,7 +5607,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteIpDomain(ctx echo.Context, t
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteIpDomain(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/ip-domain/{ip-domain-id}", target, enterpriseId, siteId, ipDomainId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5619,7 +5619,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteIpDomain(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteIpDomain")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5643,7 +5643,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteIpDomain(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteIpDomain")
+	log.Debugf("GetEnterprisesEnterpriseSiteIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5664,7 +5664,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteIpDomain(ctx echo.Context, tar
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteIpDomain(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/ip-domain/{ip-domain-id}", target, enterpriseId, siteId, ipDomainId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5676,7 +5676,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteIpDomain(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteIpDomain")
+	log.Debugf("PostEnterprisesEnterpriseSiteIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5692,7 +5692,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteMonitoring(ctx echo.Context,
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteMonitoring(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/monitoring", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5704,7 +5704,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteMonitoring(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteMonitoring")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5728,7 +5728,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteMonitoring(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteMonitoring")
+	log.Debugf("GetEnterprisesEnterpriseSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5749,7 +5749,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteMonitoring(ctx echo.Context, t
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteMonitoring(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/monitoring", target, enterpriseId, siteId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5761,7 +5761,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteMonitoring(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteMonitoring")
+	log.Debugf("PostEnterprisesEnterpriseSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5785,7 +5785,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteMonitoringEdgeDeviceList(ctx ec
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteMonitoringEdgeDeviceList")
+	log.Debugf("GetEnterprisesEnterpriseSiteMonitoringEdgeDeviceList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5801,7 +5801,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx ech
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/monitoring/edge-device/{edge-device-id}", target, enterpriseId, siteId, edgeDeviceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5813,7 +5813,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx ech
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5837,7 +5837,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx echo.C
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteMonitoringEdgeDevice")
+	log.Debugf("GetEnterprisesEnterpriseSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5858,7 +5858,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx echo.
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteMonitoringEdgeDevice(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/monitoring/edge-device/{edge-device-id}", target, enterpriseId, siteId, edgeDeviceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5870,7 +5870,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteMonitoringEdgeDevice(ctx echo.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteMonitoringEdgeDevice")
+	log.Debugf("PostEnterprisesEnterpriseSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5894,7 +5894,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSimCardList(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSimCardList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSimCardList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5910,7 +5910,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSimCard(ctx echo.Context, ta
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSimCard(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/sim-card/{sim-id}", target, enterpriseId, siteId, simId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5922,7 +5922,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSimCard(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSimCard")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSimCard")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5946,7 +5946,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSimCard(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSimCard")
+	log.Debugf("GetEnterprisesEnterpriseSiteSimCard")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5967,7 +5967,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSimCard(ctx echo.Context, targ
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSimCard(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/sim-card/{sim-id}", target, enterpriseId, siteId, simId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5979,7 +5979,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSimCard(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSimCard")
+	log.Debugf("PostEnterprisesEnterpriseSiteSimCard")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6003,7 +6003,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceList(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6019,7 +6019,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSlice(ctx echo.Context, targ
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSlice(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}", target, enterpriseId, siteId, sliceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6031,7 +6031,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSlice(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSlice")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6055,7 +6055,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSlice(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSlice")
+	log.Debugf("GetEnterprisesEnterpriseSiteSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6076,7 +6076,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSlice(ctx echo.Context, target
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSlice(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}", target, enterpriseId, siteId, sliceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6088,7 +6088,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSlice(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSlice")
+	log.Debugf("PostEnterprisesEnterpriseSiteSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6112,7 +6112,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceDeviceGroupList(ctx echo.C
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceDeviceGroupList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceDeviceGroupList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6128,7 +6128,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceDeviceGroup(ctx echo.Co
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSliceDeviceGroup(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/device-group/{device-group}", target, enterpriseId, siteId, sliceId, deviceGroup)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6140,7 +6140,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceDeviceGroup(ctx echo.Co
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSliceDeviceGroup")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSliceDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6164,7 +6164,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceDeviceGroup(ctx echo.Conte
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceDeviceGroup")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6185,7 +6185,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceDeviceGroup(ctx echo.Cont
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSliceDeviceGroup(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/device-group/{device-group}", target, enterpriseId, siteId, sliceId, deviceGroup)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6197,7 +6197,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceDeviceGroup(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSliceDeviceGroup")
+	log.Debugf("PostEnterprisesEnterpriseSiteSliceDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6221,7 +6221,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceFilterList(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceFilterList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceFilterList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6237,7 +6237,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceFilter(ctx echo.Context
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSliceFilter(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/filter/{application}", target, enterpriseId, siteId, sliceId, application)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6249,7 +6249,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceFilter(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSliceFilter")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSliceFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6273,7 +6273,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceFilter(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceFilter")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6294,7 +6294,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceFilter(ctx echo.Context,
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSliceFilter(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/filter/{application}", target, enterpriseId, siteId, sliceId, application)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6306,7 +6306,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceFilter(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSliceFilter")
+	log.Debugf("PostEnterprisesEnterpriseSiteSliceFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6322,7 +6322,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceMbr(ctx echo.Context, t
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSliceMbr(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/mbr", target, enterpriseId, siteId, sliceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6334,7 +6334,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSliceMbr(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSliceMbr")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6358,7 +6358,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSliceMbr(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSliceMbr")
+	log.Debugf("GetEnterprisesEnterpriseSiteSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6379,7 +6379,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceMbr(ctx echo.Context, tar
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSliceMbr(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/mbr", target, enterpriseId, siteId, sliceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6391,7 +6391,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSliceMbr(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSliceMbr")
+	log.Debugf("PostEnterprisesEnterpriseSiteSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6415,7 +6415,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleList(ct
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSlicePriorityTrafficRuleList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6431,7 +6431,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/priority-traffic-rule/{priority-traffic-rule-id}", target, enterpriseId, siteId, sliceId, priorityTrafficRuleId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6443,7 +6443,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6467,7 +6467,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx ec
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
+	log.Debugf("GetEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6488,7 +6488,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx e
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSlicePriorityTrafficRule(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/slice/{slice-id}/priority-traffic-rule/{priority-traffic-rule-id}", target, enterpriseId, siteId, sliceId, priorityTrafficRuleId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6500,7 +6500,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSlicePriorityTrafficRule(ctx e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
+	log.Debugf("PostEnterprisesEnterpriseSiteSlicePriorityTrafficRule")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6524,7 +6524,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSmallCellList(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSmallCellList")
+	log.Debugf("GetEnterprisesEnterpriseSiteSmallCellList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6540,7 +6540,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSmallCell(ctx echo.Context,
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteSmallCell(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/small-cell/{small-cell-id}", target, enterpriseId, siteId, smallCellId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6552,7 +6552,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteSmallCell(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteSmallCell")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6576,7 +6576,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteSmallCell(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteSmallCell")
+	log.Debugf("GetEnterprisesEnterpriseSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6597,7 +6597,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSmallCell(ctx echo.Context, ta
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteSmallCell(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/small-cell/{small-cell-id}", target, enterpriseId, siteId, smallCellId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6609,7 +6609,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteSmallCell(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteSmallCell")
+	log.Debugf("PostEnterprisesEnterpriseSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6633,7 +6633,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteUpfList(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteUpfList")
+	log.Debugf("GetEnterprisesEnterpriseSiteUpfList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6649,7 +6649,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteUpf(ctx echo.Context, target
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseSiteUpf(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/upf/{upf-id}", target, enterpriseId, siteId, upfId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6661,7 +6661,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseSiteUpf(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseSiteUpf")
+	log.Debugf("DeleteEnterprisesEnterpriseSiteUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6685,7 +6685,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseSiteUpf(ctx echo.Context, target ex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseSiteUpf")
+	log.Debugf("GetEnterprisesEnterpriseSiteUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6706,7 +6706,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteUpf(ctx echo.Context, target e
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseSiteUpf(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/site/{site-id}/upf/{upf-id}", target, enterpriseId, siteId, upfId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6718,7 +6718,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseSiteUpf(ctx echo.Context, target e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseSiteUpf")
+	log.Debugf("PostEnterprisesEnterpriseSiteUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6742,7 +6742,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseTemplateList(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseTemplateList")
+	log.Debugf("GetEnterprisesEnterpriseTemplateList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6758,7 +6758,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTemplate(ctx echo.Context, targe
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseTemplate(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/template/{template-id}", target, enterpriseId, templateId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6770,7 +6770,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTemplate(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseTemplate")
+	log.Debugf("DeleteEnterprisesEnterpriseTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6794,7 +6794,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseTemplate(ctx echo.Context, target e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseTemplate")
+	log.Debugf("GetEnterprisesEnterpriseTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6815,7 +6815,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTemplate(ctx echo.Context, target
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseTemplate(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/template/{template-id}", target, enterpriseId, templateId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6827,7 +6827,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTemplate(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseTemplate")
+	log.Debugf("PostEnterprisesEnterpriseTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6843,7 +6843,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTemplateMbr(ctx echo.Context, ta
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseTemplateMbr(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/template/{template-id}/mbr", target, enterpriseId, templateId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6855,7 +6855,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTemplateMbr(ctx echo.Context, ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseTemplateMbr")
+	log.Debugf("DeleteEnterprisesEnterpriseTemplateMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6879,7 +6879,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseTemplateMbr(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseTemplateMbr")
+	log.Debugf("GetEnterprisesEnterpriseTemplateMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6900,7 +6900,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTemplateMbr(ctx echo.Context, targ
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseTemplateMbr(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/template/{template-id}/mbr", target, enterpriseId, templateId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6912,7 +6912,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTemplateMbr(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseTemplateMbr")
+	log.Debugf("PostEnterprisesEnterpriseTemplateMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6936,7 +6936,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseTrafficClassList(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseTrafficClassList")
+	log.Debugf("GetEnterprisesEnterpriseTrafficClassList")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6952,7 +6952,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTrafficClass(ctx echo.Context, t
 	// Response
 	extension100, err := i.gnmiDeleteEnterprisesEnterpriseTrafficClass(gnmiCtx, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/traffic-class/{traffic-class-id}", target, enterpriseId, trafficClassId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6964,7 +6964,7 @@ func (i *ServerImpl) DeleteEnterprisesEnterpriseTrafficClass(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprisesEnterpriseTrafficClass")
+	log.Debugf("DeleteEnterprisesEnterpriseTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6988,7 +6988,7 @@ func (i *ServerImpl) GetEnterprisesEnterpriseTrafficClass(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprisesEnterpriseTrafficClass")
+	log.Debugf("GetEnterprisesEnterpriseTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -7009,7 +7009,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTrafficClass(ctx echo.Context, tar
 	}
 	extension100, err := i.gnmiPostEnterprisesEnterpriseTrafficClass(gnmiCtx, body, "/aether/v2.0.0/{target}/enterprises/enterprise/{enterprise-id}/traffic-class/{traffic-class-id}", target, enterpriseId, trafficClassId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -7021,7 +7021,7 @@ func (i *ServerImpl) PostEnterprisesEnterpriseTrafficClass(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprisesEnterpriseTrafficClass")
+	log.Debugf("PostEnterprisesEnterpriseTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -33,7 +33,7 @@ func (i *ServerImpl) gnmiDeleteApplication(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetApplication(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -41,7 +41,7 @@ func (i *ServerImpl) gnmiDeleteApplication(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -58,7 +58,7 @@ func (i *ServerImpl) gnmiGetApplication(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -71,7 +71,7 @@ func (i *ServerImpl) gnmiGetApplication(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -99,7 +99,7 @@ func (i *ServerImpl) gnmiPostApplication(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -114,7 +114,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplication(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetApplicationApplication(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -122,7 +122,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplication(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -139,7 +139,7 @@ func (i *ServerImpl) gnmiGetApplicationApplication(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -152,7 +152,7 @@ func (i *ServerImpl) gnmiGetApplicationApplication(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -180,7 +180,7 @@ func (i *ServerImpl) gnmiPostApplicationApplication(ctx context.Context, body []
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -195,7 +195,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplicationEndpoint(ctx context.Contex
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetApplicationApplicationEndpoint(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -203,7 +203,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplicationEndpoint(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -220,7 +220,7 @@ func (i *ServerImpl) gnmiGetApplicationApplicationEndpoint(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -233,7 +233,7 @@ func (i *ServerImpl) gnmiGetApplicationApplicationEndpoint(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -261,7 +261,7 @@ func (i *ServerImpl) gnmiPostApplicationApplicationEndpoint(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -276,7 +276,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplicationEndpointMbr(ctx context.Con
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetApplicationApplicationEndpointMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -284,7 +284,7 @@ func (i *ServerImpl) gnmiDeleteApplicationApplicationEndpointMbr(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -301,7 +301,7 @@ func (i *ServerImpl) gnmiGetApplicationApplicationEndpointMbr(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -314,7 +314,7 @@ func (i *ServerImpl) gnmiGetApplicationApplicationEndpointMbr(ctx context.Contex
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -342,7 +342,7 @@ func (i *ServerImpl) gnmiPostApplicationApplicationEndpointMbr(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -357,7 +357,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityService(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetConnectivityService(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -365,7 +365,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityService(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -382,7 +382,7 @@ func (i *ServerImpl) gnmiGetConnectivityService(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -395,7 +395,7 @@ func (i *ServerImpl) gnmiGetConnectivityService(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -423,7 +423,7 @@ func (i *ServerImpl) gnmiPostConnectivityService(ctx context.Context, body []byt
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -438,7 +438,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServiceConnectivityService(ctx contex
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetConnectivityServiceConnectivityService(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -446,7 +446,7 @@ func (i *ServerImpl) gnmiDeleteConnectivityServiceConnectivityService(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -463,7 +463,7 @@ func (i *ServerImpl) gnmiGetConnectivityServiceConnectivityService(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -476,7 +476,7 @@ func (i *ServerImpl) gnmiGetConnectivityServiceConnectivityService(ctx context.C
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -504,7 +504,7 @@ func (i *ServerImpl) gnmiPostConnectivityServiceConnectivityService(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -519,7 +519,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroup(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetDeviceGroup(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -527,7 +527,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -544,7 +544,7 @@ func (i *ServerImpl) gnmiGetDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -557,7 +557,7 @@ func (i *ServerImpl) gnmiGetDeviceGroup(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -585,7 +585,7 @@ func (i *ServerImpl) gnmiPostDeviceGroup(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -600,7 +600,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroup(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetDeviceGroupDeviceGroup(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -608,7 +608,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -625,7 +625,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -638,7 +638,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroup(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -666,7 +666,7 @@ func (i *ServerImpl) gnmiPostDeviceGroupDeviceGroup(ctx context.Context, body []
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -681,7 +681,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupDevice(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetDeviceGroupDeviceGroupDevice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -689,7 +689,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupDevice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -706,7 +706,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupDevice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -719,7 +719,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupDevice(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -747,7 +747,7 @@ func (i *ServerImpl) gnmiPostDeviceGroupDeviceGroupDevice(ctx context.Context, b
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -762,7 +762,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupDeviceMbr(ctx context.Conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetDeviceGroupDeviceGroupDeviceMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -770,7 +770,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupDeviceMbr(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -787,7 +787,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupDeviceMbr(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -800,7 +800,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupDeviceMbr(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -828,7 +828,7 @@ func (i *ServerImpl) gnmiPostDeviceGroupDeviceGroupDeviceMbr(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -843,7 +843,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupImsis(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetDeviceGroupDeviceGroupImsis(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -851,7 +851,7 @@ func (i *ServerImpl) gnmiDeleteDeviceGroupDeviceGroupImsis(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -868,7 +868,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupImsis(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -881,7 +881,7 @@ func (i *ServerImpl) gnmiGetDeviceGroupDeviceGroupImsis(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -909,7 +909,7 @@ func (i *ServerImpl) gnmiPostDeviceGroupDeviceGroupImsis(ctx context.Context, bo
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -924,7 +924,7 @@ func (i *ServerImpl) gnmiDeleteEnterprise(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterprise(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -932,7 +932,7 @@ func (i *ServerImpl) gnmiDeleteEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -949,7 +949,7 @@ func (i *ServerImpl) gnmiGetEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -962,7 +962,7 @@ func (i *ServerImpl) gnmiGetEnterprise(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -990,7 +990,7 @@ func (i *ServerImpl) gnmiPostEnterprise(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1005,7 +1005,7 @@ func (i *ServerImpl) gnmiDeleteEnterpriseEnterprise(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterpriseEnterprise(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1013,7 +1013,7 @@ func (i *ServerImpl) gnmiDeleteEnterpriseEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1030,7 +1030,7 @@ func (i *ServerImpl) gnmiGetEnterpriseEnterprise(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1043,7 +1043,7 @@ func (i *ServerImpl) gnmiGetEnterpriseEnterprise(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1071,7 +1071,7 @@ func (i *ServerImpl) gnmiPostEnterpriseEnterprise(ctx context.Context, body []by
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1086,7 +1086,7 @@ func (i *ServerImpl) gnmiDeleteEnterpriseEnterpriseConnectivityService(ctx conte
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetEnterpriseEnterpriseConnectivityService(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1094,7 +1094,7 @@ func (i *ServerImpl) gnmiDeleteEnterpriseEnterpriseConnectivityService(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1111,7 +1111,7 @@ func (i *ServerImpl) gnmiGetEnterpriseEnterpriseConnectivityService(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1124,7 +1124,7 @@ func (i *ServerImpl) gnmiGetEnterpriseEnterpriseConnectivityService(ctx context.
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1152,7 +1152,7 @@ func (i *ServerImpl) gnmiPostEnterpriseEnterpriseConnectivityService(ctx context
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1167,7 +1167,7 @@ func (i *ServerImpl) gnmiDeleteIpDomain(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetIpDomain(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1175,7 +1175,7 @@ func (i *ServerImpl) gnmiDeleteIpDomain(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1192,7 +1192,7 @@ func (i *ServerImpl) gnmiGetIpDomain(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1205,7 +1205,7 @@ func (i *ServerImpl) gnmiGetIpDomain(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1233,7 +1233,7 @@ func (i *ServerImpl) gnmiPostIpDomain(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1248,7 +1248,7 @@ func (i *ServerImpl) gnmiDeleteIpDomainIpDomain(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetIpDomainIpDomain(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1256,7 +1256,7 @@ func (i *ServerImpl) gnmiDeleteIpDomainIpDomain(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1273,7 +1273,7 @@ func (i *ServerImpl) gnmiGetIpDomainIpDomain(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1286,7 +1286,7 @@ func (i *ServerImpl) gnmiGetIpDomainIpDomain(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1314,7 +1314,7 @@ func (i *ServerImpl) gnmiPostIpDomainIpDomain(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1329,7 +1329,7 @@ func (i *ServerImpl) gnmiDeleteSite(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSite(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1337,7 +1337,7 @@ func (i *ServerImpl) gnmiDeleteSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1354,7 +1354,7 @@ func (i *ServerImpl) gnmiGetSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1367,7 +1367,7 @@ func (i *ServerImpl) gnmiGetSite(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1395,7 +1395,7 @@ func (i *ServerImpl) gnmiPostSite(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1410,7 +1410,7 @@ func (i *ServerImpl) gnmiDeleteSiteSite(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSiteSite(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1418,7 +1418,7 @@ func (i *ServerImpl) gnmiDeleteSiteSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1435,7 +1435,7 @@ func (i *ServerImpl) gnmiGetSiteSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1448,7 +1448,7 @@ func (i *ServerImpl) gnmiGetSiteSite(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1476,7 +1476,7 @@ func (i *ServerImpl) gnmiPostSiteSite(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1491,7 +1491,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteImsiDefinition(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSiteSiteImsiDefinition(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1499,7 +1499,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteImsiDefinition(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1516,7 +1516,7 @@ func (i *ServerImpl) gnmiGetSiteSiteImsiDefinition(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1529,7 +1529,7 @@ func (i *ServerImpl) gnmiGetSiteSiteImsiDefinition(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1557,7 +1557,7 @@ func (i *ServerImpl) gnmiPostSiteSiteImsiDefinition(ctx context.Context, body []
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1572,7 +1572,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteMonitoring(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSiteSiteMonitoring(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1580,7 +1580,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteMonitoring(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1597,7 +1597,7 @@ func (i *ServerImpl) gnmiGetSiteSiteMonitoring(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1610,7 +1610,7 @@ func (i *ServerImpl) gnmiGetSiteSiteMonitoring(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1638,7 +1638,7 @@ func (i *ServerImpl) gnmiPostSiteSiteMonitoring(ctx context.Context, body []byte
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1653,7 +1653,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteMonitoringEdgeDevice(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSiteSiteMonitoringEdgeDevice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1661,7 +1661,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteMonitoringEdgeDevice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1678,7 +1678,7 @@ func (i *ServerImpl) gnmiGetSiteSiteMonitoringEdgeDevice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1691,7 +1691,7 @@ func (i *ServerImpl) gnmiGetSiteSiteMonitoringEdgeDevice(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1719,7 +1719,7 @@ func (i *ServerImpl) gnmiPostSiteSiteMonitoringEdgeDevice(ctx context.Context, b
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1734,7 +1734,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteSmallCell(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetSiteSiteSmallCell(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1742,7 +1742,7 @@ func (i *ServerImpl) gnmiDeleteSiteSiteSmallCell(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1759,7 +1759,7 @@ func (i *ServerImpl) gnmiGetSiteSiteSmallCell(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1772,7 +1772,7 @@ func (i *ServerImpl) gnmiGetSiteSiteSmallCell(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1800,7 +1800,7 @@ func (i *ServerImpl) gnmiPostSiteSiteSmallCell(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1815,7 +1815,7 @@ func (i *ServerImpl) gnmiDeleteTemplate(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTemplate(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1823,7 +1823,7 @@ func (i *ServerImpl) gnmiDeleteTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1840,7 +1840,7 @@ func (i *ServerImpl) gnmiGetTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1853,7 +1853,7 @@ func (i *ServerImpl) gnmiGetTemplate(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1881,7 +1881,7 @@ func (i *ServerImpl) gnmiPostTemplate(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1896,7 +1896,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplate(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTemplateTemplate(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1904,7 +1904,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1921,7 +1921,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -1934,7 +1934,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplate(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -1962,7 +1962,7 @@ func (i *ServerImpl) gnmiPostTemplateTemplate(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -1977,7 +1977,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplateSlice(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTemplateTemplateSlice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -1985,7 +1985,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplateSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2002,7 +2002,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplateSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2015,7 +2015,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplateSlice(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2043,7 +2043,7 @@ func (i *ServerImpl) gnmiPostTemplateTemplateSlice(ctx context.Context, body []b
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2058,7 +2058,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplateSliceMbr(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTemplateTemplateSliceMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2066,7 +2066,7 @@ func (i *ServerImpl) gnmiDeleteTemplateTemplateSliceMbr(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2083,7 +2083,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplateSliceMbr(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2096,7 +2096,7 @@ func (i *ServerImpl) gnmiGetTemplateTemplateSliceMbr(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2124,7 +2124,7 @@ func (i *ServerImpl) gnmiPostTemplateTemplateSliceMbr(ctx context.Context, body
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2139,7 +2139,7 @@ func (i *ServerImpl) gnmiDeleteTrafficClass(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTrafficClass(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2147,7 +2147,7 @@ func (i *ServerImpl) gnmiDeleteTrafficClass(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2164,7 +2164,7 @@ func (i *ServerImpl) gnmiGetTrafficClass(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2177,7 +2177,7 @@ func (i *ServerImpl) gnmiGetTrafficClass(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2205,7 +2205,7 @@ func (i *ServerImpl) gnmiPostTrafficClass(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2220,7 +2220,7 @@ func (i *ServerImpl) gnmiDeleteTrafficClassTrafficClass(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTrafficClassTrafficClass(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2228,7 +2228,7 @@ func (i *ServerImpl) gnmiDeleteTrafficClassTrafficClass(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2245,7 +2245,7 @@ func (i *ServerImpl) gnmiGetTrafficClassTrafficClass(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2258,7 +2258,7 @@ func (i *ServerImpl) gnmiGetTrafficClassTrafficClass(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2286,7 +2286,7 @@ func (i *ServerImpl) gnmiPostTrafficClassTrafficClass(ctx context.Context, body
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2301,7 +2301,7 @@ func (i *ServerImpl) gnmiDeleteUpf(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetUpf(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2309,7 +2309,7 @@ func (i *ServerImpl) gnmiDeleteUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2326,7 +2326,7 @@ func (i *ServerImpl) gnmiGetUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2339,7 +2339,7 @@ func (i *ServerImpl) gnmiGetUpf(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2367,7 +2367,7 @@ func (i *ServerImpl) gnmiPostUpf(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2382,7 +2382,7 @@ func (i *ServerImpl) gnmiDeleteUpfUpf(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetUpfUpf(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2390,7 +2390,7 @@ func (i *ServerImpl) gnmiDeleteUpfUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2407,7 +2407,7 @@ func (i *ServerImpl) gnmiGetUpfUpf(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2420,7 +2420,7 @@ func (i *ServerImpl) gnmiGetUpfUpf(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2448,7 +2448,7 @@ func (i *ServerImpl) gnmiPostUpfUpf(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2463,7 +2463,7 @@ func (i *ServerImpl) gnmiDeleteVcs(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcs(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2471,7 +2471,7 @@ func (i *ServerImpl) gnmiDeleteVcs(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2488,7 +2488,7 @@ func (i *ServerImpl) gnmiGetVcs(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2501,7 +2501,7 @@ func (i *ServerImpl) gnmiGetVcs(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2529,7 +2529,7 @@ func (i *ServerImpl) gnmiPostVcs(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2544,7 +2544,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcs(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcsVcs(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2552,7 +2552,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcs(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2569,7 +2569,7 @@ func (i *ServerImpl) gnmiGetVcsVcs(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2582,7 +2582,7 @@ func (i *ServerImpl) gnmiGetVcsVcs(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2610,7 +2610,7 @@ func (i *ServerImpl) gnmiPostVcsVcs(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2625,7 +2625,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsDeviceGroup(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcsVcsDeviceGroup(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2633,7 +2633,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2650,7 +2650,7 @@ func (i *ServerImpl) gnmiGetVcsVcsDeviceGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2663,7 +2663,7 @@ func (i *ServerImpl) gnmiGetVcsVcsDeviceGroup(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2691,7 +2691,7 @@ func (i *ServerImpl) gnmiPostVcsVcsDeviceGroup(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2706,7 +2706,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsFilter(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcsVcsFilter(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2714,7 +2714,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsFilter(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2731,7 +2731,7 @@ func (i *ServerImpl) gnmiGetVcsVcsFilter(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2744,7 +2744,7 @@ func (i *ServerImpl) gnmiGetVcsVcsFilter(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2772,7 +2772,7 @@ func (i *ServerImpl) gnmiPostVcsVcsFilter(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2787,7 +2787,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsSlice(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcsVcsSlice(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2795,7 +2795,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2812,7 +2812,7 @@ func (i *ServerImpl) gnmiGetVcsVcsSlice(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2825,7 +2825,7 @@ func (i *ServerImpl) gnmiGetVcsVcsSlice(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2853,7 +2853,7 @@ func (i *ServerImpl) gnmiPostVcsVcsSlice(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2868,7 +2868,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsSliceMbr(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetVcsVcsSliceMbr(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2876,7 +2876,7 @@ func (i *ServerImpl) gnmiDeleteVcsVcsSliceMbr(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2893,7 +2893,7 @@ func (i *ServerImpl) gnmiGetVcsVcsSliceMbr(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2906,7 +2906,7 @@ func (i *ServerImpl) gnmiGetVcsVcsSliceMbr(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -2934,7 +2934,7 @@ func (i *ServerImpl) gnmiPostVcsVcsSliceMbr(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2949,7 +2949,7 @@ func (i *ServerImpl) gnmiDeleteTarget(ctx context.Context,
 	// check to see if the item exists before deleting it
 	response, err := i.gnmiGetTarget(ctx, openApiPath, target, args...)
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
-		log.Infof("Item at path %s with args %v not found", openApiPath, args)
+		log.Debugf("Item at path %s with args %v not found", openApiPath, args)
 		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("item at path %s with args %v does not exists", openApiPath, args))
 	}
 
@@ -2957,7 +2957,7 @@ func (i *ServerImpl) gnmiDeleteTarget(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
 	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
 	if err != nil {
 		return nil, err
@@ -2974,7 +2974,7 @@ func (i *ServerImpl) gnmiGetTarget(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -2987,7 +2987,7 @@ func (i *ServerImpl) gnmiGetTarget(ctx context.Context,
 		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
 	}
 
-	log.Infof("gNMI Json %s", string(gnmiJsonVal.JsonVal))
+	log.Debugf("gNMI Json %s", string(gnmiJsonVal.JsonVal))
 	var gnmiResponse externalRef1.Device
 	if err = externalRef1.Unmarshal(gnmiJsonVal.JsonVal, &gnmiResponse); err != nil {
 		return nil, fmt.Errorf("error unmarshalling gnmiResponse %v", err)
@@ -3151,7 +3151,7 @@ func (i *ServerImpl) DeleteApplication(ctx echo.Context, target externalRef0.Tar
 	// Response
 	extension100, err := i.gnmiDeleteApplication(gnmiCtx, "/aether/v4.0.0/{target}/application", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3163,7 +3163,7 @@ func (i *ServerImpl) DeleteApplication(ctx echo.Context, target externalRef0.Tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteApplication")
+	log.Debugf("DeleteApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3187,7 +3187,7 @@ func (i *ServerImpl) GetApplication(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetApplication")
+	log.Debugf("GetApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3208,7 +3208,7 @@ func (i *ServerImpl) PostApplication(ctx echo.Context, target externalRef0.Targe
 	}
 	extension100, err := i.gnmiPostApplication(gnmiCtx, body, "/aether/v4.0.0/{target}/application", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3220,7 +3220,7 @@ func (i *ServerImpl) PostApplication(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostApplication")
+	log.Debugf("PostApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3236,7 +3236,7 @@ func (i *ServerImpl) DeleteApplicationApplication(ctx echo.Context, target exter
 	// Response
 	extension100, err := i.gnmiDeleteApplicationApplication(gnmiCtx, "/aether/v4.0.0/{target}/application/application/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3248,7 +3248,7 @@ func (i *ServerImpl) DeleteApplicationApplication(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteApplicationApplication")
+	log.Debugf("DeleteApplicationApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3272,7 +3272,7 @@ func (i *ServerImpl) GetApplicationApplication(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetApplicationApplication")
+	log.Debugf("GetApplicationApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3293,7 +3293,7 @@ func (i *ServerImpl) PostApplicationApplication(ctx echo.Context, target externa
 	}
 	extension100, err := i.gnmiPostApplicationApplication(gnmiCtx, body, "/aether/v4.0.0/{target}/application/application/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3305,7 +3305,7 @@ func (i *ServerImpl) PostApplicationApplication(ctx echo.Context, target externa
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostApplicationApplication")
+	log.Debugf("PostApplicationApplication")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3321,7 +3321,7 @@ func (i *ServerImpl) DeleteApplicationApplicationEndpoint(ctx echo.Context, targ
 	// Response
 	extension100, err := i.gnmiDeleteApplicationApplicationEndpoint(gnmiCtx, "/aether/v4.0.0/{target}/application/application/{id}/endpoint/{endpoint-id}", target, id, endpointId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3333,7 +3333,7 @@ func (i *ServerImpl) DeleteApplicationApplicationEndpoint(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteApplicationApplicationEndpoint")
+	log.Debugf("DeleteApplicationApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3357,7 +3357,7 @@ func (i *ServerImpl) GetApplicationApplicationEndpoint(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetApplicationApplicationEndpoint")
+	log.Debugf("GetApplicationApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3378,7 +3378,7 @@ func (i *ServerImpl) PostApplicationApplicationEndpoint(ctx echo.Context, target
 	}
 	extension100, err := i.gnmiPostApplicationApplicationEndpoint(gnmiCtx, body, "/aether/v4.0.0/{target}/application/application/{id}/endpoint/{endpoint-id}", target, id, endpointId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3390,7 +3390,7 @@ func (i *ServerImpl) PostApplicationApplicationEndpoint(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostApplicationApplicationEndpoint")
+	log.Debugf("PostApplicationApplicationEndpoint")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3406,7 +3406,7 @@ func (i *ServerImpl) DeleteApplicationApplicationEndpointMbr(ctx echo.Context, t
 	// Response
 	extension100, err := i.gnmiDeleteApplicationApplicationEndpointMbr(gnmiCtx, "/aether/v4.0.0/{target}/application/application/{id}/endpoint/{endpoint-id}/mbr", target, id, endpointId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3418,7 +3418,7 @@ func (i *ServerImpl) DeleteApplicationApplicationEndpointMbr(ctx echo.Context, t
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteApplicationApplicationEndpointMbr")
+	log.Debugf("DeleteApplicationApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3442,7 +3442,7 @@ func (i *ServerImpl) GetApplicationApplicationEndpointMbr(ctx echo.Context, targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetApplicationApplicationEndpointMbr")
+	log.Debugf("GetApplicationApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3463,7 +3463,7 @@ func (i *ServerImpl) PostApplicationApplicationEndpointMbr(ctx echo.Context, tar
 	}
 	extension100, err := i.gnmiPostApplicationApplicationEndpointMbr(gnmiCtx, body, "/aether/v4.0.0/{target}/application/application/{id}/endpoint/{endpoint-id}/mbr", target, id, endpointId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3475,7 +3475,7 @@ func (i *ServerImpl) PostApplicationApplicationEndpointMbr(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostApplicationApplicationEndpointMbr")
+	log.Debugf("PostApplicationApplicationEndpointMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3491,7 +3491,7 @@ func (i *ServerImpl) DeleteConnectivityService(ctx echo.Context, target external
 	// Response
 	extension100, err := i.gnmiDeleteConnectivityService(gnmiCtx, "/aether/v4.0.0/{target}/connectivity-service", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3503,7 +3503,7 @@ func (i *ServerImpl) DeleteConnectivityService(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteConnectivityService")
+	log.Debugf("DeleteConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3527,7 +3527,7 @@ func (i *ServerImpl) GetConnectivityService(ctx echo.Context, target externalRef
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetConnectivityService")
+	log.Debugf("GetConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3548,7 +3548,7 @@ func (i *ServerImpl) PostConnectivityService(ctx echo.Context, target externalRe
 	}
 	extension100, err := i.gnmiPostConnectivityService(gnmiCtx, body, "/aether/v4.0.0/{target}/connectivity-service", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3560,7 +3560,7 @@ func (i *ServerImpl) PostConnectivityService(ctx echo.Context, target externalRe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostConnectivityService")
+	log.Debugf("PostConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3576,7 +3576,7 @@ func (i *ServerImpl) DeleteConnectivityServiceConnectivityService(ctx echo.Conte
 	// Response
 	extension100, err := i.gnmiDeleteConnectivityServiceConnectivityService(gnmiCtx, "/aether/v4.0.0/{target}/connectivity-service/connectivity-service/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3588,7 +3588,7 @@ func (i *ServerImpl) DeleteConnectivityServiceConnectivityService(ctx echo.Conte
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteConnectivityServiceConnectivityService")
+	log.Debugf("DeleteConnectivityServiceConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3612,7 +3612,7 @@ func (i *ServerImpl) GetConnectivityServiceConnectivityService(ctx echo.Context,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetConnectivityServiceConnectivityService")
+	log.Debugf("GetConnectivityServiceConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3633,7 +3633,7 @@ func (i *ServerImpl) PostConnectivityServiceConnectivityService(ctx echo.Context
 	}
 	extension100, err := i.gnmiPostConnectivityServiceConnectivityService(gnmiCtx, body, "/aether/v4.0.0/{target}/connectivity-service/connectivity-service/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3645,7 +3645,7 @@ func (i *ServerImpl) PostConnectivityServiceConnectivityService(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostConnectivityServiceConnectivityService")
+	log.Debugf("PostConnectivityServiceConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3661,7 +3661,7 @@ func (i *ServerImpl) DeleteDeviceGroup(ctx echo.Context, target externalRef0.Tar
 	// Response
 	extension100, err := i.gnmiDeleteDeviceGroup(gnmiCtx, "/aether/v4.0.0/{target}/device-group", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3673,7 +3673,7 @@ func (i *ServerImpl) DeleteDeviceGroup(ctx echo.Context, target externalRef0.Tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteDeviceGroup")
+	log.Debugf("DeleteDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3697,7 +3697,7 @@ func (i *ServerImpl) GetDeviceGroup(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetDeviceGroup")
+	log.Debugf("GetDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3718,7 +3718,7 @@ func (i *ServerImpl) PostDeviceGroup(ctx echo.Context, target externalRef0.Targe
 	}
 	extension100, err := i.gnmiPostDeviceGroup(gnmiCtx, body, "/aether/v4.0.0/{target}/device-group", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3730,7 +3730,7 @@ func (i *ServerImpl) PostDeviceGroup(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostDeviceGroup")
+	log.Debugf("PostDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3746,7 +3746,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroup(ctx echo.Context, target exter
 	// Response
 	extension100, err := i.gnmiDeleteDeviceGroupDeviceGroup(gnmiCtx, "/aether/v4.0.0/{target}/device-group/device-group/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3758,7 +3758,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroup(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteDeviceGroupDeviceGroup")
+	log.Debugf("DeleteDeviceGroupDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3782,7 +3782,7 @@ func (i *ServerImpl) GetDeviceGroupDeviceGroup(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetDeviceGroupDeviceGroup")
+	log.Debugf("GetDeviceGroupDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3803,7 +3803,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroup(ctx echo.Context, target externa
 	}
 	extension100, err := i.gnmiPostDeviceGroupDeviceGroup(gnmiCtx, body, "/aether/v4.0.0/{target}/device-group/device-group/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3815,7 +3815,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroup(ctx echo.Context, target externa
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostDeviceGroupDeviceGroup")
+	log.Debugf("PostDeviceGroupDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3831,7 +3831,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupDevice(ctx echo.Context, target
 	// Response
 	extension100, err := i.gnmiDeleteDeviceGroupDeviceGroupDevice(gnmiCtx, "/aether/v4.0.0/{target}/device-group/device-group/{id}/device", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3843,7 +3843,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupDevice(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteDeviceGroupDeviceGroupDevice")
+	log.Debugf("DeleteDeviceGroupDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3867,7 +3867,7 @@ func (i *ServerImpl) GetDeviceGroupDeviceGroupDevice(ctx echo.Context, target ex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetDeviceGroupDeviceGroupDevice")
+	log.Debugf("GetDeviceGroupDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3888,7 +3888,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupDevice(ctx echo.Context, target e
 	}
 	extension100, err := i.gnmiPostDeviceGroupDeviceGroupDevice(gnmiCtx, body, "/aether/v4.0.0/{target}/device-group/device-group/{id}/device", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3900,7 +3900,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupDevice(ctx echo.Context, target e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostDeviceGroupDeviceGroupDevice")
+	log.Debugf("PostDeviceGroupDeviceGroupDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3916,7 +3916,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupDeviceMbr(ctx echo.Context, tar
 	// Response
 	extension100, err := i.gnmiDeleteDeviceGroupDeviceGroupDeviceMbr(gnmiCtx, "/aether/v4.0.0/{target}/device-group/device-group/{id}/device/mbr", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -3928,7 +3928,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupDeviceMbr(ctx echo.Context, tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteDeviceGroupDeviceGroupDeviceMbr")
+	log.Debugf("DeleteDeviceGroupDeviceGroupDeviceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3952,7 +3952,7 @@ func (i *ServerImpl) GetDeviceGroupDeviceGroupDeviceMbr(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetDeviceGroupDeviceGroupDeviceMbr")
+	log.Debugf("GetDeviceGroupDeviceGroupDeviceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -3973,7 +3973,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupDeviceMbr(ctx echo.Context, targe
 	}
 	extension100, err := i.gnmiPostDeviceGroupDeviceGroupDeviceMbr(gnmiCtx, body, "/aether/v4.0.0/{target}/device-group/device-group/{id}/device/mbr", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -3985,7 +3985,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupDeviceMbr(ctx echo.Context, targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostDeviceGroupDeviceGroupDeviceMbr")
+	log.Debugf("PostDeviceGroupDeviceGroupDeviceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4001,7 +4001,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupImsis(ctx echo.Context, target
 	// Response
 	extension100, err := i.gnmiDeleteDeviceGroupDeviceGroupImsis(gnmiCtx, "/aether/v4.0.0/{target}/device-group/device-group/{id}/imsis/{imsi-id}", target, id, imsiId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4013,7 +4013,7 @@ func (i *ServerImpl) DeleteDeviceGroupDeviceGroupImsis(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteDeviceGroupDeviceGroupImsis")
+	log.Debugf("DeleteDeviceGroupDeviceGroupImsis")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4037,7 +4037,7 @@ func (i *ServerImpl) GetDeviceGroupDeviceGroupImsis(ctx echo.Context, target ext
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetDeviceGroupDeviceGroupImsis")
+	log.Debugf("GetDeviceGroupDeviceGroupImsis")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4058,7 +4058,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupImsis(ctx echo.Context, target ex
 	}
 	extension100, err := i.gnmiPostDeviceGroupDeviceGroupImsis(gnmiCtx, body, "/aether/v4.0.0/{target}/device-group/device-group/{id}/imsis/{imsi-id}", target, id, imsiId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4070,7 +4070,7 @@ func (i *ServerImpl) PostDeviceGroupDeviceGroupImsis(ctx echo.Context, target ex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostDeviceGroupDeviceGroupImsis")
+	log.Debugf("PostDeviceGroupDeviceGroupImsis")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4086,7 +4086,7 @@ func (i *ServerImpl) DeleteEnterprise(ctx echo.Context, target externalRef0.Targ
 	// Response
 	extension100, err := i.gnmiDeleteEnterprise(gnmiCtx, "/aether/v4.0.0/{target}/enterprise", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4098,7 +4098,7 @@ func (i *ServerImpl) DeleteEnterprise(ctx echo.Context, target externalRef0.Targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterprise")
+	log.Debugf("DeleteEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4122,7 +4122,7 @@ func (i *ServerImpl) GetEnterprise(ctx echo.Context, target externalRef0.Target)
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterprise")
+	log.Debugf("GetEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4143,7 +4143,7 @@ func (i *ServerImpl) PostEnterprise(ctx echo.Context, target externalRef0.Target
 	}
 	extension100, err := i.gnmiPostEnterprise(gnmiCtx, body, "/aether/v4.0.0/{target}/enterprise", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4155,7 +4155,7 @@ func (i *ServerImpl) PostEnterprise(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterprise")
+	log.Debugf("PostEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4171,7 +4171,7 @@ func (i *ServerImpl) DeleteEnterpriseEnterprise(ctx echo.Context, target externa
 	// Response
 	extension100, err := i.gnmiDeleteEnterpriseEnterprise(gnmiCtx, "/aether/v4.0.0/{target}/enterprise/enterprise/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4183,7 +4183,7 @@ func (i *ServerImpl) DeleteEnterpriseEnterprise(ctx echo.Context, target externa
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterpriseEnterprise")
+	log.Debugf("DeleteEnterpriseEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4207,7 +4207,7 @@ func (i *ServerImpl) GetEnterpriseEnterprise(ctx echo.Context, target externalRe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterpriseEnterprise")
+	log.Debugf("GetEnterpriseEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4228,7 +4228,7 @@ func (i *ServerImpl) PostEnterpriseEnterprise(ctx echo.Context, target externalR
 	}
 	extension100, err := i.gnmiPostEnterpriseEnterprise(gnmiCtx, body, "/aether/v4.0.0/{target}/enterprise/enterprise/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4240,7 +4240,7 @@ func (i *ServerImpl) PostEnterpriseEnterprise(ctx echo.Context, target externalR
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterpriseEnterprise")
+	log.Debugf("PostEnterpriseEnterprise")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4256,7 +4256,7 @@ func (i *ServerImpl) DeleteEnterpriseEnterpriseConnectivityService(ctx echo.Cont
 	// Response
 	extension100, err := i.gnmiDeleteEnterpriseEnterpriseConnectivityService(gnmiCtx, "/aether/v4.0.0/{target}/enterprise/enterprise/{id}/connectivity-service/{connectivity-service}", target, id, connectivityService)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4268,7 +4268,7 @@ func (i *ServerImpl) DeleteEnterpriseEnterpriseConnectivityService(ctx echo.Cont
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteEnterpriseEnterpriseConnectivityService")
+	log.Debugf("DeleteEnterpriseEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4292,7 +4292,7 @@ func (i *ServerImpl) GetEnterpriseEnterpriseConnectivityService(ctx echo.Context
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetEnterpriseEnterpriseConnectivityService")
+	log.Debugf("GetEnterpriseEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4313,7 +4313,7 @@ func (i *ServerImpl) PostEnterpriseEnterpriseConnectivityService(ctx echo.Contex
 	}
 	extension100, err := i.gnmiPostEnterpriseEnterpriseConnectivityService(gnmiCtx, body, "/aether/v4.0.0/{target}/enterprise/enterprise/{id}/connectivity-service/{connectivity-service}", target, id, connectivityService)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4325,7 +4325,7 @@ func (i *ServerImpl) PostEnterpriseEnterpriseConnectivityService(ctx echo.Contex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostEnterpriseEnterpriseConnectivityService")
+	log.Debugf("PostEnterpriseEnterpriseConnectivityService")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4341,7 +4341,7 @@ func (i *ServerImpl) DeleteIpDomain(ctx echo.Context, target externalRef0.Target
 	// Response
 	extension100, err := i.gnmiDeleteIpDomain(gnmiCtx, "/aether/v4.0.0/{target}/ip-domain", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4353,7 +4353,7 @@ func (i *ServerImpl) DeleteIpDomain(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteIpDomain")
+	log.Debugf("DeleteIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4377,7 +4377,7 @@ func (i *ServerImpl) GetIpDomain(ctx echo.Context, target externalRef0.Target) e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetIpDomain")
+	log.Debugf("GetIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4398,7 +4398,7 @@ func (i *ServerImpl) PostIpDomain(ctx echo.Context, target externalRef0.Target)
 	}
 	extension100, err := i.gnmiPostIpDomain(gnmiCtx, body, "/aether/v4.0.0/{target}/ip-domain", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4410,7 +4410,7 @@ func (i *ServerImpl) PostIpDomain(ctx echo.Context, target externalRef0.Target)
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostIpDomain")
+	log.Debugf("PostIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4426,7 +4426,7 @@ func (i *ServerImpl) DeleteIpDomainIpDomain(ctx echo.Context, target externalRef
 	// Response
 	extension100, err := i.gnmiDeleteIpDomainIpDomain(gnmiCtx, "/aether/v4.0.0/{target}/ip-domain/ip-domain/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4438,7 +4438,7 @@ func (i *ServerImpl) DeleteIpDomainIpDomain(ctx echo.Context, target externalRef
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteIpDomainIpDomain")
+	log.Debugf("DeleteIpDomainIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4462,7 +4462,7 @@ func (i *ServerImpl) GetIpDomainIpDomain(ctx echo.Context, target externalRef0.T
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetIpDomainIpDomain")
+	log.Debugf("GetIpDomainIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4483,7 +4483,7 @@ func (i *ServerImpl) PostIpDomainIpDomain(ctx echo.Context, target externalRef0.
 	}
 	extension100, err := i.gnmiPostIpDomainIpDomain(gnmiCtx, body, "/aether/v4.0.0/{target}/ip-domain/ip-domain/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4495,7 +4495,7 @@ func (i *ServerImpl) PostIpDomainIpDomain(ctx echo.Context, target externalRef0.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostIpDomainIpDomain")
+	log.Debugf("PostIpDomainIpDomain")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4511,7 +4511,7 @@ func (i *ServerImpl) DeleteSite(ctx echo.Context, target externalRef0.Target) er
 	// Response
 	extension100, err := i.gnmiDeleteSite(gnmiCtx, "/aether/v4.0.0/{target}/site", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4523,7 +4523,7 @@ func (i *ServerImpl) DeleteSite(ctx echo.Context, target externalRef0.Target) er
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSite")
+	log.Debugf("DeleteSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4547,7 +4547,7 @@ func (i *ServerImpl) GetSite(ctx echo.Context, target externalRef0.Target) error
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSite")
+	log.Debugf("GetSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4568,7 +4568,7 @@ func (i *ServerImpl) PostSite(ctx echo.Context, target externalRef0.Target) erro
 	}
 	extension100, err := i.gnmiPostSite(gnmiCtx, body, "/aether/v4.0.0/{target}/site", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4580,7 +4580,7 @@ func (i *ServerImpl) PostSite(ctx echo.Context, target externalRef0.Target) erro
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSite")
+	log.Debugf("PostSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4596,7 +4596,7 @@ func (i *ServerImpl) DeleteSiteSite(ctx echo.Context, target externalRef0.Target
 	// Response
 	extension100, err := i.gnmiDeleteSiteSite(gnmiCtx, "/aether/v4.0.0/{target}/site/site/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4608,7 +4608,7 @@ func (i *ServerImpl) DeleteSiteSite(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSiteSite")
+	log.Debugf("DeleteSiteSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4632,7 +4632,7 @@ func (i *ServerImpl) GetSiteSite(ctx echo.Context, target externalRef0.Target, i
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSiteSite")
+	log.Debugf("GetSiteSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4653,7 +4653,7 @@ func (i *ServerImpl) PostSiteSite(ctx echo.Context, target externalRef0.Target,
 	}
 	extension100, err := i.gnmiPostSiteSite(gnmiCtx, body, "/aether/v4.0.0/{target}/site/site/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4665,7 +4665,7 @@ func (i *ServerImpl) PostSiteSite(ctx echo.Context, target externalRef0.Target,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSiteSite")
+	log.Debugf("PostSiteSite")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4681,7 +4681,7 @@ func (i *ServerImpl) DeleteSiteSiteImsiDefinition(ctx echo.Context, target exter
 	// Response
 	extension100, err := i.gnmiDeleteSiteSiteImsiDefinition(gnmiCtx, "/aether/v4.0.0/{target}/site/site/{id}/imsi-definition", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4693,7 +4693,7 @@ func (i *ServerImpl) DeleteSiteSiteImsiDefinition(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSiteSiteImsiDefinition")
+	log.Debugf("DeleteSiteSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4717,7 +4717,7 @@ func (i *ServerImpl) GetSiteSiteImsiDefinition(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSiteSiteImsiDefinition")
+	log.Debugf("GetSiteSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4738,7 +4738,7 @@ func (i *ServerImpl) PostSiteSiteImsiDefinition(ctx echo.Context, target externa
 	}
 	extension100, err := i.gnmiPostSiteSiteImsiDefinition(gnmiCtx, body, "/aether/v4.0.0/{target}/site/site/{id}/imsi-definition", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4750,7 +4750,7 @@ func (i *ServerImpl) PostSiteSiteImsiDefinition(ctx echo.Context, target externa
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSiteSiteImsiDefinition")
+	log.Debugf("PostSiteSiteImsiDefinition")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4766,7 +4766,7 @@ func (i *ServerImpl) DeleteSiteSiteMonitoring(ctx echo.Context, target externalR
 	// Response
 	extension100, err := i.gnmiDeleteSiteSiteMonitoring(gnmiCtx, "/aether/v4.0.0/{target}/site/site/{id}/monitoring", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4778,7 +4778,7 @@ func (i *ServerImpl) DeleteSiteSiteMonitoring(ctx echo.Context, target externalR
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSiteSiteMonitoring")
+	log.Debugf("DeleteSiteSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4802,7 +4802,7 @@ func (i *ServerImpl) GetSiteSiteMonitoring(ctx echo.Context, target externalRef0
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSiteSiteMonitoring")
+	log.Debugf("GetSiteSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4823,7 +4823,7 @@ func (i *ServerImpl) PostSiteSiteMonitoring(ctx echo.Context, target externalRef
 	}
 	extension100, err := i.gnmiPostSiteSiteMonitoring(gnmiCtx, body, "/aether/v4.0.0/{target}/site/site/{id}/monitoring", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4835,7 +4835,7 @@ func (i *ServerImpl) PostSiteSiteMonitoring(ctx echo.Context, target externalRef
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSiteSiteMonitoring")
+	log.Debugf("PostSiteSiteMonitoring")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4851,7 +4851,7 @@ func (i *ServerImpl) DeleteSiteSiteMonitoringEdgeDevice(ctx echo.Context, target
 	// Response
 	extension100, err := i.gnmiDeleteSiteSiteMonitoringEdgeDevice(gnmiCtx, "/aether/v4.0.0/{target}/site/site/{id}/monitoring/edge-device/{edge-device-id}", target, id, edgeDeviceId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4863,7 +4863,7 @@ func (i *ServerImpl) DeleteSiteSiteMonitoringEdgeDevice(ctx echo.Context, target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSiteSiteMonitoringEdgeDevice")
+	log.Debugf("DeleteSiteSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4887,7 +4887,7 @@ func (i *ServerImpl) GetSiteSiteMonitoringEdgeDevice(ctx echo.Context, target ex
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSiteSiteMonitoringEdgeDevice")
+	log.Debugf("GetSiteSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4908,7 +4908,7 @@ func (i *ServerImpl) PostSiteSiteMonitoringEdgeDevice(ctx echo.Context, target e
 	}
 	extension100, err := i.gnmiPostSiteSiteMonitoringEdgeDevice(gnmiCtx, body, "/aether/v4.0.0/{target}/site/site/{id}/monitoring/edge-device/{edge-device-id}", target, id, edgeDeviceId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -4920,7 +4920,7 @@ func (i *ServerImpl) PostSiteSiteMonitoringEdgeDevice(ctx echo.Context, target e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSiteSiteMonitoringEdgeDevice")
+	log.Debugf("PostSiteSiteMonitoringEdgeDevice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4936,7 +4936,7 @@ func (i *ServerImpl) DeleteSiteSiteSmallCell(ctx echo.Context, target externalRe
 	// Response
 	extension100, err := i.gnmiDeleteSiteSiteSmallCell(gnmiCtx, "/aether/v4.0.0/{target}/site/site/{id}/small-cell/{small-cell-id}", target, id, smallCellId)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -4948,7 +4948,7 @@ func (i *ServerImpl) DeleteSiteSiteSmallCell(ctx echo.Context, target externalRe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteSiteSiteSmallCell")
+	log.Debugf("DeleteSiteSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4972,7 +4972,7 @@ func (i *ServerImpl) GetSiteSiteSmallCell(ctx echo.Context, target externalRef0.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetSiteSiteSmallCell")
+	log.Debugf("GetSiteSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -4993,7 +4993,7 @@ func (i *ServerImpl) PostSiteSiteSmallCell(ctx echo.Context, target externalRef0
 	}
 	extension100, err := i.gnmiPostSiteSiteSmallCell(gnmiCtx, body, "/aether/v4.0.0/{target}/site/site/{id}/small-cell/{small-cell-id}", target, id, smallCellId)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5005,7 +5005,7 @@ func (i *ServerImpl) PostSiteSiteSmallCell(ctx echo.Context, target externalRef0
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostSiteSiteSmallCell")
+	log.Debugf("PostSiteSiteSmallCell")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5021,7 +5021,7 @@ func (i *ServerImpl) DeleteTemplate(ctx echo.Context, target externalRef0.Target
 	// Response
 	extension100, err := i.gnmiDeleteTemplate(gnmiCtx, "/aether/v4.0.0/{target}/template", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5033,7 +5033,7 @@ func (i *ServerImpl) DeleteTemplate(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTemplate")
+	log.Debugf("DeleteTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5057,7 +5057,7 @@ func (i *ServerImpl) GetTemplate(ctx echo.Context, target externalRef0.Target) e
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTemplate")
+	log.Debugf("GetTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5078,7 +5078,7 @@ func (i *ServerImpl) PostTemplate(ctx echo.Context, target externalRef0.Target)
 	}
 	extension100, err := i.gnmiPostTemplate(gnmiCtx, body, "/aether/v4.0.0/{target}/template", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5090,7 +5090,7 @@ func (i *ServerImpl) PostTemplate(ctx echo.Context, target externalRef0.Target)
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTemplate")
+	log.Debugf("PostTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5106,7 +5106,7 @@ func (i *ServerImpl) DeleteTemplateTemplate(ctx echo.Context, target externalRef
 	// Response
 	extension100, err := i.gnmiDeleteTemplateTemplate(gnmiCtx, "/aether/v4.0.0/{target}/template/template/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5118,7 +5118,7 @@ func (i *ServerImpl) DeleteTemplateTemplate(ctx echo.Context, target externalRef
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTemplateTemplate")
+	log.Debugf("DeleteTemplateTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5142,7 +5142,7 @@ func (i *ServerImpl) GetTemplateTemplate(ctx echo.Context, target externalRef0.T
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTemplateTemplate")
+	log.Debugf("GetTemplateTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5163,7 +5163,7 @@ func (i *ServerImpl) PostTemplateTemplate(ctx echo.Context, target externalRef0.
 	}
 	extension100, err := i.gnmiPostTemplateTemplate(gnmiCtx, body, "/aether/v4.0.0/{target}/template/template/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5175,7 +5175,7 @@ func (i *ServerImpl) PostTemplateTemplate(ctx echo.Context, target externalRef0.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTemplateTemplate")
+	log.Debugf("PostTemplateTemplate")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5191,7 +5191,7 @@ func (i *ServerImpl) DeleteTemplateTemplateSlice(ctx echo.Context, target extern
 	// Response
 	extension100, err := i.gnmiDeleteTemplateTemplateSlice(gnmiCtx, "/aether/v4.0.0/{target}/template/template/{id}/slice", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5203,7 +5203,7 @@ func (i *ServerImpl) DeleteTemplateTemplateSlice(ctx echo.Context, target extern
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTemplateTemplateSlice")
+	log.Debugf("DeleteTemplateTemplateSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5227,7 +5227,7 @@ func (i *ServerImpl) GetTemplateTemplateSlice(ctx echo.Context, target externalR
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTemplateTemplateSlice")
+	log.Debugf("GetTemplateTemplateSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5248,7 +5248,7 @@ func (i *ServerImpl) PostTemplateTemplateSlice(ctx echo.Context, target external
 	}
 	extension100, err := i.gnmiPostTemplateTemplateSlice(gnmiCtx, body, "/aether/v4.0.0/{target}/template/template/{id}/slice", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5260,7 +5260,7 @@ func (i *ServerImpl) PostTemplateTemplateSlice(ctx echo.Context, target external
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTemplateTemplateSlice")
+	log.Debugf("PostTemplateTemplateSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5276,7 +5276,7 @@ func (i *ServerImpl) DeleteTemplateTemplateSliceMbr(ctx echo.Context, target ext
 	// Response
 	extension100, err := i.gnmiDeleteTemplateTemplateSliceMbr(gnmiCtx, "/aether/v4.0.0/{target}/template/template/{id}/slice/mbr", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5288,7 +5288,7 @@ func (i *ServerImpl) DeleteTemplateTemplateSliceMbr(ctx echo.Context, target ext
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTemplateTemplateSliceMbr")
+	log.Debugf("DeleteTemplateTemplateSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5312,7 +5312,7 @@ func (i *ServerImpl) GetTemplateTemplateSliceMbr(ctx echo.Context, target extern
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTemplateTemplateSliceMbr")
+	log.Debugf("GetTemplateTemplateSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5333,7 +5333,7 @@ func (i *ServerImpl) PostTemplateTemplateSliceMbr(ctx echo.Context, target exter
 	}
 	extension100, err := i.gnmiPostTemplateTemplateSliceMbr(gnmiCtx, body, "/aether/v4.0.0/{target}/template/template/{id}/slice/mbr", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5345,7 +5345,7 @@ func (i *ServerImpl) PostTemplateTemplateSliceMbr(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTemplateTemplateSliceMbr")
+	log.Debugf("PostTemplateTemplateSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5361,7 +5361,7 @@ func (i *ServerImpl) DeleteTrafficClass(ctx echo.Context, target externalRef0.Ta
 	// Response
 	extension100, err := i.gnmiDeleteTrafficClass(gnmiCtx, "/aether/v4.0.0/{target}/traffic-class", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5373,7 +5373,7 @@ func (i *ServerImpl) DeleteTrafficClass(ctx echo.Context, target externalRef0.Ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTrafficClass")
+	log.Debugf("DeleteTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5397,7 +5397,7 @@ func (i *ServerImpl) GetTrafficClass(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTrafficClass")
+	log.Debugf("GetTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5418,7 +5418,7 @@ func (i *ServerImpl) PostTrafficClass(ctx echo.Context, target externalRef0.Targ
 	}
 	extension100, err := i.gnmiPostTrafficClass(gnmiCtx, body, "/aether/v4.0.0/{target}/traffic-class", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5430,7 +5430,7 @@ func (i *ServerImpl) PostTrafficClass(ctx echo.Context, target externalRef0.Targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTrafficClass")
+	log.Debugf("PostTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5446,7 +5446,7 @@ func (i *ServerImpl) DeleteTrafficClassTrafficClass(ctx echo.Context, target ext
 	// Response
 	extension100, err := i.gnmiDeleteTrafficClassTrafficClass(gnmiCtx, "/aether/v4.0.0/{target}/traffic-class/traffic-class/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5458,7 +5458,7 @@ func (i *ServerImpl) DeleteTrafficClassTrafficClass(ctx echo.Context, target ext
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteTrafficClassTrafficClass")
+	log.Debugf("DeleteTrafficClassTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5482,7 +5482,7 @@ func (i *ServerImpl) GetTrafficClassTrafficClass(ctx echo.Context, target extern
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetTrafficClassTrafficClass")
+	log.Debugf("GetTrafficClassTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5503,7 +5503,7 @@ func (i *ServerImpl) PostTrafficClassTrafficClass(ctx echo.Context, target exter
 	}
 	extension100, err := i.gnmiPostTrafficClassTrafficClass(gnmiCtx, body, "/aether/v4.0.0/{target}/traffic-class/traffic-class/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5515,7 +5515,7 @@ func (i *ServerImpl) PostTrafficClassTrafficClass(ctx echo.Context, target exter
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostTrafficClassTrafficClass")
+	log.Debugf("PostTrafficClassTrafficClass")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5531,7 +5531,7 @@ func (i *ServerImpl) DeleteUpf(ctx echo.Context, target externalRef0.Target) err
 	// Response
 	extension100, err := i.gnmiDeleteUpf(gnmiCtx, "/aether/v4.0.0/{target}/upf", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5543,7 +5543,7 @@ func (i *ServerImpl) DeleteUpf(ctx echo.Context, target externalRef0.Target) err
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteUpf")
+	log.Debugf("DeleteUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5567,7 +5567,7 @@ func (i *ServerImpl) GetUpf(ctx echo.Context, target externalRef0.Target) error
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetUpf")
+	log.Debugf("GetUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5588,7 +5588,7 @@ func (i *ServerImpl) PostUpf(ctx echo.Context, target externalRef0.Target) error
 	}
 	extension100, err := i.gnmiPostUpf(gnmiCtx, body, "/aether/v4.0.0/{target}/upf", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5600,7 +5600,7 @@ func (i *ServerImpl) PostUpf(ctx echo.Context, target externalRef0.Target) error
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostUpf")
+	log.Debugf("PostUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5616,7 +5616,7 @@ func (i *ServerImpl) DeleteUpfUpf(ctx echo.Context, target externalRef0.Target,
 	// Response
 	extension100, err := i.gnmiDeleteUpfUpf(gnmiCtx, "/aether/v4.0.0/{target}/upf/upf/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5628,7 +5628,7 @@ func (i *ServerImpl) DeleteUpfUpf(ctx echo.Context, target externalRef0.Target,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteUpfUpf")
+	log.Debugf("DeleteUpfUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5652,7 +5652,7 @@ func (i *ServerImpl) GetUpfUpf(ctx echo.Context, target externalRef0.Target, id
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetUpfUpf")
+	log.Debugf("GetUpfUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5673,7 +5673,7 @@ func (i *ServerImpl) PostUpfUpf(ctx echo.Context, target externalRef0.Target, id
 	}
 	extension100, err := i.gnmiPostUpfUpf(gnmiCtx, body, "/aether/v4.0.0/{target}/upf/upf/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5685,7 +5685,7 @@ func (i *ServerImpl) PostUpfUpf(ctx echo.Context, target externalRef0.Target, id
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostUpfUpf")
+	log.Debugf("PostUpfUpf")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5701,7 +5701,7 @@ func (i *ServerImpl) DeleteVcs(ctx echo.Context, target externalRef0.Target) err
 	// Response
 	extension100, err := i.gnmiDeleteVcs(gnmiCtx, "/aether/v4.0.0/{target}/vcs", target)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5713,7 +5713,7 @@ func (i *ServerImpl) DeleteVcs(ctx echo.Context, target externalRef0.Target) err
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcs")
+	log.Debugf("DeleteVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5737,7 +5737,7 @@ func (i *ServerImpl) GetVcs(ctx echo.Context, target externalRef0.Target) error
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcs")
+	log.Debugf("GetVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5758,7 +5758,7 @@ func (i *ServerImpl) PostVcs(ctx echo.Context, target externalRef0.Target) error
 	}
 	extension100, err := i.gnmiPostVcs(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs", target)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5770,7 +5770,7 @@ func (i *ServerImpl) PostVcs(ctx echo.Context, target externalRef0.Target) error
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcs")
+	log.Debugf("PostVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5786,7 +5786,7 @@ func (i *ServerImpl) DeleteVcsVcs(ctx echo.Context, target externalRef0.Target,
 	// Response
 	extension100, err := i.gnmiDeleteVcsVcs(gnmiCtx, "/aether/v4.0.0/{target}/vcs/vcs/{id}", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5798,7 +5798,7 @@ func (i *ServerImpl) DeleteVcsVcs(ctx echo.Context, target externalRef0.Target,
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcsVcs")
+	log.Debugf("DeleteVcsVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5822,7 +5822,7 @@ func (i *ServerImpl) GetVcsVcs(ctx echo.Context, target externalRef0.Target, id
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcsVcs")
+	log.Debugf("GetVcsVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5843,7 +5843,7 @@ func (i *ServerImpl) PostVcsVcs(ctx echo.Context, target externalRef0.Target, id
 	}
 	extension100, err := i.gnmiPostVcsVcs(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs/vcs/{id}", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5855,7 +5855,7 @@ func (i *ServerImpl) PostVcsVcs(ctx echo.Context, target externalRef0.Target, id
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcsVcs")
+	log.Debugf("PostVcsVcs")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5871,7 +5871,7 @@ func (i *ServerImpl) DeleteVcsVcsDeviceGroup(ctx echo.Context, target externalRe
 	// Response
 	extension100, err := i.gnmiDeleteVcsVcsDeviceGroup(gnmiCtx, "/aether/v4.0.0/{target}/vcs/vcs/{id}/device-group/{device-group}", target, id, deviceGroup)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5883,7 +5883,7 @@ func (i *ServerImpl) DeleteVcsVcsDeviceGroup(ctx echo.Context, target externalRe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcsVcsDeviceGroup")
+	log.Debugf("DeleteVcsVcsDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5907,7 +5907,7 @@ func (i *ServerImpl) GetVcsVcsDeviceGroup(ctx echo.Context, target externalRef0.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcsVcsDeviceGroup")
+	log.Debugf("GetVcsVcsDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5928,7 +5928,7 @@ func (i *ServerImpl) PostVcsVcsDeviceGroup(ctx echo.Context, target externalRef0
 	}
 	extension100, err := i.gnmiPostVcsVcsDeviceGroup(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs/vcs/{id}/device-group/{device-group}", target, id, deviceGroup)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -5940,7 +5940,7 @@ func (i *ServerImpl) PostVcsVcsDeviceGroup(ctx echo.Context, target externalRef0
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcsVcsDeviceGroup")
+	log.Debugf("PostVcsVcsDeviceGroup")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5956,7 +5956,7 @@ func (i *ServerImpl) DeleteVcsVcsFilter(ctx echo.Context, target externalRef0.Ta
 	// Response
 	extension100, err := i.gnmiDeleteVcsVcsFilter(gnmiCtx, "/aether/v4.0.0/{target}/vcs/vcs/{id}/filter/{application}", target, id, application)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -5968,7 +5968,7 @@ func (i *ServerImpl) DeleteVcsVcsFilter(ctx echo.Context, target externalRef0.Ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcsVcsFilter")
+	log.Debugf("DeleteVcsVcsFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -5992,7 +5992,7 @@ func (i *ServerImpl) GetVcsVcsFilter(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcsVcsFilter")
+	log.Debugf("GetVcsVcsFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6013,7 +6013,7 @@ func (i *ServerImpl) PostVcsVcsFilter(ctx echo.Context, target externalRef0.Targ
 	}
 	extension100, err := i.gnmiPostVcsVcsFilter(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs/vcs/{id}/filter/{application}", target, id, application)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6025,7 +6025,7 @@ func (i *ServerImpl) PostVcsVcsFilter(ctx echo.Context, target externalRef0.Targ
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcsVcsFilter")
+	log.Debugf("PostVcsVcsFilter")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6041,7 +6041,7 @@ func (i *ServerImpl) DeleteVcsVcsSlice(ctx echo.Context, target externalRef0.Tar
 	// Response
 	extension100, err := i.gnmiDeleteVcsVcsSlice(gnmiCtx, "/aether/v4.0.0/{target}/vcs/vcs/{id}/slice", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6053,7 +6053,7 @@ func (i *ServerImpl) DeleteVcsVcsSlice(ctx echo.Context, target externalRef0.Tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcsVcsSlice")
+	log.Debugf("DeleteVcsVcsSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6077,7 +6077,7 @@ func (i *ServerImpl) GetVcsVcsSlice(ctx echo.Context, target externalRef0.Target
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcsVcsSlice")
+	log.Debugf("GetVcsVcsSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6098,7 +6098,7 @@ func (i *ServerImpl) PostVcsVcsSlice(ctx echo.Context, target externalRef0.Targe
 	}
 	extension100, err := i.gnmiPostVcsVcsSlice(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs/vcs/{id}/slice", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6110,7 +6110,7 @@ func (i *ServerImpl) PostVcsVcsSlice(ctx echo.Context, target externalRef0.Targe
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcsVcsSlice")
+	log.Debugf("PostVcsVcsSlice")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6126,7 +6126,7 @@ func (i *ServerImpl) DeleteVcsVcsSliceMbr(ctx echo.Context, target externalRef0.
 	// Response
 	extension100, err := i.gnmiDeleteVcsVcsSliceMbr(gnmiCtx, "/aether/v4.0.0/{target}/vcs/vcs/{id}/slice/mbr", target, id)
 	if err == nil {
-		log.Infof("Delete succeded %s", *extension100)
+		log.Debugf("Delete succeded %s", *extension100)
 		return ctx.JSON(http.StatusOK, extension100)
 	}
 
@@ -6138,7 +6138,7 @@ func (i *ServerImpl) DeleteVcsVcsSliceMbr(ctx echo.Context, target externalRef0.
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("DeleteVcsVcsSliceMbr")
+	log.Debugf("DeleteVcsVcsSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6162,7 +6162,7 @@ func (i *ServerImpl) GetVcsVcsSliceMbr(ctx echo.Context, target externalRef0.Tar
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("GetVcsVcsSliceMbr")
+	log.Debugf("GetVcsVcsSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -6183,7 +6183,7 @@ func (i *ServerImpl) PostVcsVcsSliceMbr(ctx echo.Context, target externalRef0.Ta
 	}
 	extension100, err := i.gnmiPostVcsVcsSliceMbr(gnmiCtx, body, "/aether/v4.0.0/{target}/vcs/vcs/{id}/slice/mbr", target, id)
 	if err == nil {
-		log.Infof("Post succeded %s", *extension100)
+		log.Debugf("Post succeded %s", *extension100)
 		return ctx.JSON(http.StatusCreated, extension100)
 	}
 
@@ -6195,7 +6195,7 @@ func (i *ServerImpl) PostVcsVcsSliceMbr(ctx echo.Context, target externalRef0.Ta
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
-	log.Infof("PostVcsVcsSliceMbr")
+	log.Debugf("PostVcsVcsSliceMbr")
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -79,7 +79,7 @@ func (i *AppGtwy) GetDevices(ctx echo.Context, target externalRef0.Target, enter
 		listDeviceResponse = append(listDeviceResponse, newDev)
 	}
 
-	log.Infof("GetAppGtwyDevices: returned %d devices", len(listDeviceResponse))
+	log.Debugf("GetAppGtwyDevices: returned %d devices", len(listDeviceResponse))
 	return ctx.JSON(http.StatusOK, listDeviceResponse)
 }
 
@@ -140,7 +140,7 @@ func (i *AppGtwy) gnmiGetEnterprisesEnterpriseSite(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
 	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
@@ -6,18 +6,23 @@
 package manager
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	aether_2_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_2_0_0/server"
 	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
 	app_gtwy "github.com/onosproject/aether-roc-api/pkg/app_gtwy/server"
+	"github.com/onosproject/aether-roc-api/pkg/metrics"
+	"github.com/onosproject/aether-roc-api/pkg/middleware/fieldmaskmw"
 	"github.com/onosproject/aether-roc-api/pkg/southbound"
 	toplevel "github.com/onosproject/aether-roc-api/pkg/toplevel/server"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
 	"github.com/onosproject/onos-lib-go/pkg/grpc/retry"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"net/http"
 	"time"
 )
 
@@ -30,12 +35,21 @@ type Manager struct {
 	echoRouter    *echo.Echo
 	openapis      map[string]interface{}
 	authorization bool
+	gnmiConn      *grpc.ClientConn
+	topLevel      *toplevel.TopLevelServer
+	stopWebhooks  context.CancelFunc
 }
 
 // NewManager -
+// The topLevel server carries the settings for the top level API, and has its clients filled in here
 func NewManager(gnmiEndpoint string, analyticsEndpoint string, allowCorsOrigins []string,
-	validateResponses bool, authorization bool, gnmiTimeout time.Duration, opts ...grpc.DialOption) (*Manager, error) {
+	validateResponses bool, authorization bool, gnmiTimeout time.Duration,
+	topLevel *toplevel.TopLevelServer, opts ...grpc.DialOption) (*Manager, error) {
 	mgr = Manager{authorization: authorization}
+	if err := toplevel.ValidateSpecs(); err != nil {
+		log.Error("Unable to validate OpenAPI specs", err)
+		return nil, err
+	}
 	optsWithRetry := []grpc.DialOption{
 		grpc.WithStreamInterceptor(retry.RetryingStreamClientInterceptor(retry.WithInterval(100 * time.Millisecond))),
 	}
@@ -46,7 +60,12 @@ func NewManager(gnmiEndpoint string, analyticsEndpoint string, allowCorsOrigins
 		return nil, err
 	}
 
-	gnmiClient := new(southbound.GNMIProvisioner)
+	mgr.gnmiConn = gnmiConn
+
+	var gnmiClient southbound.GnmiClient = new(southbound.GNMIProvisioner)
+	if topLevel.GnmiMaxInFlight > 0 {
+		gnmiClient = southbound.NewLimitedGnmiClient(gnmiClient, topLevel.GnmiMaxInFlight, southbound.DefaultSlotWait)
+	}
 	err = gnmiClient.Init(gnmiConn)
 	if err != nil {
 		log.Error("Unable to setup GNMI provisioner", err)
@@ -80,21 +99,39 @@ func NewManager(gnmiEndpoint string, analyticsEndpoint string, allowCorsOrigins
 		AnalyticsClient: analyticsClient,
 	}
 	mgr.openapis["AetherAppGtwy"] = aetherAppGtwyAPIImpl
-	topLevelAPIImpl := &toplevel.TopLevelServer{
-		GnmiClient:    gnmiClient,
-		GnmiTimeout:   gnmiTimeout,
-		ConfigClient:  transactionServiceClient,
-		Authorization: authorization,
-	}
+	topLevelAPIImpl := topLevel
+	topLevelAPIImpl.GnmiClient = gnmiClient
+	topLevelAPIImpl.GnmiTimeout = gnmiTimeout
+	topLevelAPIImpl.ConfigClient = transactionServiceClient
+	topLevelAPIImpl.AdminClient = admin.NewConfigAdminServiceClient(gnmiConn)
+	topLevelAPIImpl.Authorization = authorization
+	topLevelAPIImpl.GnmiEndpoint = gnmiEndpoint
+	topLevelAPIImpl.LatencyStats = metrics.NewLatencyStats(metrics.DefaultLatencyWindow, metrics.DefaultLatencySamples)
+	topLevelAPIImpl.Metrics = metrics.NewPrometheusMetrics(prometheus.DefaultRegisterer)
 	mgr.openapis["TopLevel"] = topLevelAPIImpl
+	mgr.topLevel = topLevelAPIImpl
+	if err = topLevelAPIImpl.ParsePathPrefix(); err != nil {
+		return nil, err
+	}
 
 	mgr.echoRouter = echo.New()
-	if len(allowCorsOrigins) > 0 {
-		mgr.echoRouter.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-			AllowOrigins: allowCorsOrigins,
-			AllowHeaders: []string{echo.HeaderAccessControlAllowOrigin, echo.HeaderContentType, echo.HeaderAuthorization},
-		}))
+	ipExtractor, err := toplevel.ClientIPExtractor(topLevelAPIImpl.TrustedProxies)
+	if err != nil {
+		return nil, err
 	}
+	mgr.echoRouter.IPExtractor = ipExtractor
+	mgr.echoRouter.Use(toplevel.RequestIDMiddleware())
+	mgr.echoRouter.Use(toplevel.AccessLogMiddleware())
+	mgr.echoRouter.Use(toplevel.CORSMiddleware(allowCorsOrigins))
+	mgr.echoRouter.Use(topLevelAPIImpl.HandlerTimeoutMiddleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.LatencyStats.Middleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.Metrics.Middleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.TracingMiddleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.RateLimitMiddleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.UsernameMiddleware())
+	mgr.echoRouter.Use(topLevelAPIImpl.RBACMiddleware())
+	mgr.echoRouter.Use(fieldmaskmw.FieldMask())
+	mgr.echoRouter.GET("/metrics", metrics.Handler())
 	mgr.echoRouter.File("/", "assets/index.html")
 	mgr.echoRouter.Static("/", "assets")
 	if err := aether_2_0_0.RegisterHandlers(mgr.echoRouter, aether20APIImpl, validateResponses); err != nil {
@@ -113,11 +150,49 @@ func NewManager(gnmiEndpoint string, analyticsEndpoint string, allowCorsOrigins
 	return &mgr, nil
 }
 
-// Run starts the northbound services.
-func (m *Manager) Run(port uint) {
-	log.Infof("Starting Manager on port %d", port)
+// Run starts the northbound services, over https when tlsConfig is given. It returns when they stop,
+// which after Shutdown is without error
+func (m *Manager) Run(port uint, tlsConfig *tls.Config) error {
+	log.Infof("Starting Manager on port %d tls=%v", port, tlsConfig != nil)
 
-	m.echoRouter.Logger.Fatal(m.echoRouter.Start(fmt.Sprintf(":%d", port)))
+	if m.topLevel != nil && len(m.topLevel.WebhookURLs) > 0 {
+		webhooksCtx, cancel := context.WithCancel(context.Background())
+		m.stopWebhooks = cancel
+		go m.topLevel.RunWebhooks(webhooksCtx)
+	}
 
+	var err error
+	if tlsConfig != nil {
+		// Echo wraps the listener of its TLSServer with the TLSConfig
+		m.echoRouter.TLSServer.Addr = fmt.Sprintf(":%d", port)
+		m.echoRouter.TLSServer.TLSConfig = tlsConfig
+		err = m.echoRouter.StartServer(m.echoRouter.TLSServer)
+	} else {
+		err = m.echoRouter.Start(fmt.Sprintf(":%d", port))
+	}
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
 	log.Warn("Manager Stopping")
+	return nil
+}
+
+// Shutdown stops the webhooks and accepting connections, and waits, until ctx is done, for the requests
+// in flight to finish, so that a change being made in onos-config is not cut off. Then the connection to
+// onos-config is closed
+func (m *Manager) Shutdown(ctx context.Context) error {
+	log.Info("Shutting down Manager")
+	if m.stopWebhooks != nil {
+		m.stopWebhooks()
+	}
+	err := m.echoRouter.Shutdown(ctx)
+	if err != nil {
+		log.Warnf("Requests still in flight at shutdown %v", err)
+	}
+	if m.gnmiConn != nil {
+		if closeErr := m.gnmiConn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
 }
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+// Package metrics keeps in-memory statistics about the requests handled by the API,
+// and exports request and gNMI call metrics to Prometheus
+package metrics
+
+import (
+	"github.com/labstack/echo/v4"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLatencyWindow - how far back request latencies are remembered
+	DefaultLatencyWindow = 5 * time.Minute
+	// DefaultLatencySamples - the most request latencies remembered per endpoint
+	DefaultLatencySamples = 1000
+)
+
+// EndpointLatency - latency percentiles of one endpoint, in milliseconds
+type EndpointLatency struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50-ms"`
+	P95   float64 `json:"p95-ms"`
+	P99   float64 `json:"p99-ms"`
+}
+
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// LatencyStats - a sliding window of request latencies for each endpoint
+type LatencyStats struct {
+	mu         sync.Mutex
+	window     time.Duration
+	maxSamples int
+	endpoints  map[string][]latencySample
+	now        func() time.Time
+}
+
+// NewLatencyStats - create a LatencyStats that remembers up to maxSamples per endpoint
+// for no longer than window
+func NewLatencyStats(window time.Duration, maxSamples int) *LatencyStats {
+	return &LatencyStats{
+		window:     window,
+		maxSamples: maxSamples,
+		endpoints:  make(map[string][]latencySample),
+		now:        time.Now,
+	}
+}
+
+// Observe - record the latency of one request to endpoint
+func (l *LatencyStats) Observe(endpoint string, duration time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	samples := append(l.endpoints[endpoint], latencySample{at: l.now(), duration: duration})
+	if len(samples) > l.maxSamples {
+		samples = samples[len(samples)-l.maxSamples:]
+	}
+	l.endpoints[endpoint] = samples
+}
+
+// Percentiles - the p50/p95/p99 latency of each endpoint over the window.
+// Endpoints with no requests in the window are left out
+func (l *LatencyStats) Percentiles() map[string]EndpointLatency {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cutoff := l.now().Add(-l.window)
+	stats := make(map[string]EndpointLatency)
+	for endpoint, samples := range l.endpoints {
+		// Samples are in time order, so drop the expired ones from the front
+		first := sort.Search(len(samples), func(i int) bool {
+			return samples[i].at.After(cutoff)
+		})
+		samples = samples[first:]
+		if len(samples) == 0 {
+			delete(l.endpoints, endpoint)
+			continue
+		}
+		l.endpoints[endpoint] = samples
+
+		durations := make([]time.Duration, len(samples))
+		for i, s := range samples {
+			durations[i] = s.duration
+		}
+		sort.Slice(durations, func(a, b int) bool {
+			return durations[a] < durations[b]
+		})
+		stats[endpoint] = EndpointLatency{
+			Count: len(durations),
+			P50:   percentile(durations, 50),
+			P95:   percentile(durations, 95),
+			P99:   percentile(durations, 99),
+		}
+	}
+	return stats
+}
+
+// percentile by the nearest-rank method, in milliseconds. sorted must not be empty
+func percentile(sorted []time.Duration, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return float64(sorted[rank-1]) / float64(time.Millisecond)
+}
+
+// Middleware - records the latency of every request against its method and route
+func (l *LatencyStats) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			l.Observe(ctx.Request().Method+" "+ctx.Path(), time.Since(start))
+			return err
+		}
+	}
+}
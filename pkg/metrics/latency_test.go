@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package metrics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_LatencyStats(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	stats := NewLatencyStats(time.Minute, 100)
+	stats.now = func() time.Time { return now }
+
+	stats.Observe("GET /old", time.Second)
+	now = now.Add(2 * time.Minute)
+	for i := 1; i <= 200; i++ {
+		stats.Observe("GET /targets", time.Duration(i)*time.Millisecond)
+	}
+
+	percentiles := stats.Percentiles()
+	assert.Len(t, percentiles, 1)
+	targets := percentiles["GET /targets"]
+	// Only the last 100 samples are kept - 101ms to 200ms
+	assert.Equal(t, 100, targets.Count)
+	assert.Equal(t, 150.0, targets.P50)
+	assert.Equal(t, 195.0, targets.P95)
+	assert.Equal(t, 199.0, targets.P99)
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package metrics
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const metricsNamespace = "aether_roc_api"
+
+// gnmiDurationBuckets - from a few milliseconds for a Get of a leaf, to tens of seconds for a large Set
+var gnmiDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics - the request and gNMI metrics exported on /metrics
+type PrometheusMetrics struct {
+	RequestDuration *prometheus.HistogramVec
+	GnmiCalls       *prometheus.CounterVec
+	GnmiDuration    *prometheus.HistogramVec
+	handlerNames    sync.Map
+}
+
+// NewPrometheusMetrics - creates the metrics and registers them with reg. If they are already
+// registered, as when more than one server is created in the same process, the existing ones are used
+func NewPrometheusMetrics(reg prometheus.Registerer) *PrometheusMetrics {
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the requests handled, by handler and status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "code"})
+	gnmiCalls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "gnmi_calls_total",
+		Help:      "Calls made to onos-config, by call and gRPC code",
+	}, []string{"call", "code"})
+	gnmiDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "gnmi_call_duration_seconds",
+		Help:      "Round trip time of the gNMI calls to onos-config, by call",
+		Buckets:   gnmiDurationBuckets,
+	}, []string{"call"})
+
+	return &PrometheusMetrics{
+		RequestDuration: register(reg, requestDuration).(*prometheus.HistogramVec),
+		GnmiCalls:       register(reg, gnmiCalls).(*prometheus.CounterVec),
+		GnmiDuration:    register(reg, gnmiDuration).(*prometheus.HistogramVec),
+	}
+}
+
+func register(reg prometheus.Registerer, c prometheus.Collector) prometheus.Collector {
+	if err := reg.Register(c); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector
+		}
+		panic(err)
+	}
+	return c
+}
+
+// Handler - serves the metrics of the default registry
+func Handler() echo.HandlerFunc {
+	return echo.WrapHandler(promhttp.Handler())
+}
+
+// ObserveGnmiCall - counts a call to onos-config against the gRPC code of its error. Does nothing when p is nil
+func (p *PrometheusMetrics) ObserveGnmiCall(call string, err error) {
+	if p == nil {
+		return
+	}
+	p.GnmiCalls.WithLabelValues(call, status.Code(err).String()).Inc()
+}
+
+// ObserveGnmiDuration - records the round trip time of one call to onos-config. Does nothing when p is nil
+func (p *PrometheusMetrics) ObserveGnmiDuration(call string, duration time.Duration) {
+	if p == nil {
+		return
+	}
+	p.GnmiDuration.WithLabelValues(call).Observe(duration.Seconds())
+}
+
+// Middleware - records the duration of every request against its handler and status code
+func (p *PrometheusMetrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			code := ctx.Response().Status
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				code = httpErr.Code
+			} else if err != nil {
+				code = http.StatusInternalServerError
+			}
+			p.RequestDuration.WithLabelValues(p.handlerName(ctx), strconv.Itoa(code)).
+				Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// handlerName - the short name of the function registered for the route, e.g. GetTargets
+func (p *PrometheusMetrics) handlerName(ctx echo.Context) string {
+	key := ctx.Request().Method + " " + ctx.Path()
+	if name, ok := p.handlerNames.Load(key); ok {
+		return name.(string)
+	}
+	name := ctx.Path()
+	for _, route := range ctx.Echo().Routes() {
+		if route.Method == ctx.Request().Method && route.Path == ctx.Path() {
+			name = strings.TrimSuffix(route.Name[strings.LastIndex(route.Name, ".")+1:], "-fm")
+			break
+		}
+	}
+	p.handlerNames.Store(key, name)
+	return name
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package metrics
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func getTargets(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, []string{})
+}
+
+func getMissing(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotFound)
+}
+
+func Test_NewPrometheusMetrics_Idempotent(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	first := NewPrometheusMetrics(reg)
+	second := NewPrometheusMetrics(reg)
+	assert.Same(t, first.RequestDuration, second.RequestDuration)
+	assert.Same(t, first.GnmiCalls, second.GnmiCalls)
+	assert.Same(t, first.GnmiDuration, second.GnmiDuration)
+}
+
+func Test_PrometheusMetrics_Middleware(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	p := NewPrometheusMetrics(reg)
+	e := echo.New()
+	e.Use(p.Middleware())
+	e.GET("/targets", getTargets)
+	e.GET("/missing", getMissing)
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/targets", nil))
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	families, err := reg.Gather()
+	assert.NoError(t, err)
+	observed := make(map[string]uint64)
+	for _, family := range families {
+		if family.GetName() != "aether_roc_api_request_duration_seconds" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			observed[labels["handler"]+" "+labels["code"]] = m.GetHistogram().GetSampleCount()
+		}
+	}
+	assert.Equal(t, map[string]uint64{"getTargets 200": 1, "getMissing 404": 1}, observed)
+}
+
+func Test_PrometheusMetrics_ObserveGnmiCall(t *testing.T) {
+	p := NewPrometheusMetrics(prometheus.NewRegistry())
+	p.ObserveGnmiCall("Get", nil)
+	p.ObserveGnmiCall("Get", nil)
+	p.ObserveGnmiCall("Set", status.Error(codes.Unavailable, "down"))
+	p.ObserveGnmiCall("Set", fmt.Errorf("not a status"))
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(p.GnmiCalls.WithLabelValues("Get", "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(p.GnmiCalls.WithLabelValues("Set", "Unavailable")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(p.GnmiCalls.WithLabelValues("Set", "Unknown")))
+
+	var nilMetrics *PrometheusMetrics
+	nilMetrics.ObserveGnmiCall("Get", nil)
+}
+
+func Test_PrometheusMetrics_ObserveGnmiDuration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	p := NewPrometheusMetrics(reg)
+	p.ObserveGnmiDuration("Get", 3*time.Millisecond)
+	p.ObserveGnmiDuration("Get", 200*time.Millisecond)
+	p.ObserveGnmiDuration("Set", 4*time.Second)
+
+	families, err := reg.Gather()
+	assert.NoError(t, err)
+	counts := make(map[string]uint64)
+	for _, family := range families {
+		if family.GetName() != "aether_roc_api_gnmi_call_duration_seconds" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			counts[m.GetLabel()[0].GetValue()] = m.GetHistogram().GetSampleCount()
+			if m.GetLabel()[0].GetValue() == "Set" {
+				// Multi-second calls fall in their own bucket rather than the last
+				for _, b := range m.GetHistogram().GetBucket() {
+					if b.GetUpperBound() == 2.5 {
+						assert.Equal(t, uint64(0), b.GetCumulativeCount())
+					}
+					if b.GetUpperBound() == 5 {
+						assert.Equal(t, uint64(1), b.GetCumulativeCount())
+					}
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]uint64{"Get": 2, "Set": 1}, counts)
+
+	var nilMetrics *PrometheusMetrics
+	nilMetrics.ObserveGnmiDuration("Get", time.Second)
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package fieldmaskmw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var log = logging.GetLogger("middleware", "fieldmaskmw")
+
+// FieldMaskParam - the query parameter carrying the field mask
+const FieldMaskParam = "fieldMask"
+
+type (
+	// FieldMaskConfig defines the config for FieldMask middleware.
+	FieldMaskConfig struct {
+		// Skipper defines a function to skip middleware.
+		Skipper middleware.Skipper
+	}
+)
+
+var (
+	// DefaultFieldMaskConfig is the default FieldMaskConfig middleware config.
+	DefaultFieldMaskConfig = FieldMaskConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+)
+
+// FieldMask returns a FieldMask middleware.
+// On GET requests with a ?fieldMask=a.b,c.d parameter the JSON response is projected
+// down to only the named (nested) fields. Event streams, websockets and encoded (e.g. gzipped)
+// responses are passed through as they are.
+func FieldMask() echo.MiddlewareFunc {
+	return FieldMaskWithConfig(DefaultFieldMaskConfig)
+}
+
+// FieldMaskWithConfig returns a FieldMask middleware with config.
+// See: `FieldMask()`.
+func FieldMaskWithConfig(config FieldMaskConfig) echo.MiddlewareFunc {
+	// Defaults
+	if config.Skipper == nil {
+		config.Skipper = DefaultFieldMaskConfig.Skipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if config.Skipper(ctx) || ctx.Request().Method != http.MethodGet || isStream(ctx.Request()) {
+				return next(ctx)
+			}
+			paths := utils.ParseFieldMask(ctx.QueryParam(FieldMaskParam))
+			if len(paths) == 0 {
+				return next(ctx)
+			}
+
+			// Capture the response so that it can be masked before it is sent
+			originalWriter := ctx.Response().Writer
+			bw := &bufferedWriter{
+				writer: originalWriter,
+				header: originalWriter.Header(),
+				status: http.StatusOK,
+			}
+			ctx.Response().Writer = bw
+			err := next(ctx)
+			ctx.Response().Writer = originalWriter
+			if err != nil || bw.passThrough {
+				return err
+			}
+
+			body := bw.body.Bytes()
+			if bw.status == http.StatusOK && bw.header.Get(echo.HeaderContentEncoding) == "" &&
+				strings.HasPrefix(bw.header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+				var doc interface{}
+				if err = json.Unmarshal(body, &doc); err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError,
+						fmt.Sprintf("unable to apply field mask. %v", err))
+				}
+				masked, invalid := utils.ApplyFieldMask(doc, paths)
+				if len(invalid) > 0 {
+					// Nothing has been sent yet, so the error can replace the response
+					ctx.Response().Committed = false
+					return echo.NewHTTPError(http.StatusBadRequest,
+						fmt.Sprintf("invalid field mask paths: %s", strings.Join(invalid, ", ")))
+				}
+				if body, err = json.Marshal(masked); err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, err)
+				}
+				log.Debugf("Applied field mask %v to %s", paths, ctx.Request().URL.Path)
+			}
+			bw.header.Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+			originalWriter.WriteHeader(bw.status)
+			_, err = originalWriter.Write(body)
+			return err
+		}
+	}
+}
+
+// isStream - whether the request is for an event stream or a websocket, which stay open and
+// cannot be buffered
+func isStream(req *http.Request) bool {
+	return strings.EqualFold(req.Header.Get(echo.HeaderUpgrade), "websocket") ||
+		strings.Contains(req.Header.Get(echo.HeaderAccept), "text/event-stream")
+}
+
+// bufferedWriter holds on to the response until the handler has finished. A handler that
+// flushes or hijacks is streaming, so from then on it writes straight through, unmasked
+type bufferedWriter struct {
+	writer      http.ResponseWriter
+	header      http.Header
+	status      int
+	body        bytes.Buffer
+	passThrough bool
+}
+
+func (w *bufferedWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedWriter) WriteHeader(statusCode int) {
+	if w.passThrough {
+		w.writer.WriteHeader(statusCode)
+		return
+	}
+	w.status = statusCode
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.passThrough {
+		return w.writer.Write(b)
+	}
+	return w.body.Write(b)
+}
+
+// Flush sends what has been buffered so far, and everything after it as it is written
+func (w *bufferedWriter) Flush() {
+	if !w.passThrough {
+		w.passThrough = true
+		w.writer.WriteHeader(w.status)
+		if _, err := w.writer.Write(w.body.Bytes()); err != nil {
+			log.Warnf("Unable to write response %v", err)
+		}
+		w.body.Reset()
+	}
+	if flusher, ok := w.writer.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack hands the connection over to the handler, as for a websocket
+func (w *bufferedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.writer.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer does not support hijacking")
+	}
+	w.passThrough = true
+	return hijacker.Hijack()
+}
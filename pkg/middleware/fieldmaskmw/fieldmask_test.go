@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package fieldmaskmw
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"github.com/labstack/echo/v4"
+	"gotest.tools/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"id":          "acme",
+		"description": "ACME Corp",
+		"site": []interface{}{
+			map[string]interface{}{"id": "s1", "description": "site 1"},
+		},
+	})
+}
+
+func Test_FieldMask(t *testing.T) {
+	e := echo.New()
+	e.GET("/test", testHandler, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/test?fieldMask=id,site.id", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"id":"acme","site":[{"id":"s1"}]}`, rec.Body.String())
+}
+
+func Test_FieldMask_Missing(t *testing.T) {
+	e := echo.New()
+	e.GET("/test", testHandler, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/test?fieldMask=id,site.nothere,other", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"id":"acme"}`, rec.Body.String())
+}
+
+func Test_FieldMask_Invalid(t *testing.T) {
+	e := echo.New()
+	e.GET("/test", testHandler, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/test?fieldMask=id,site..id,other.", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, `{"message":"invalid field mask paths: other., site..id"}`+"\n", rec.Body.String())
+}
+
+func Test_FieldMask_NoMask(t *testing.T) {
+	e := echo.New()
+	e.GET("/test", testHandler, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"description":"ACME Corp","id":"acme","site":[{"description":"site 1","id":"s1"}]}`+"\n", rec.Body.String())
+}
+
+func streamHandler(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+	c.Response().WriteHeader(http.StatusOK)
+	for _, event := range []string{"data: one\n\n", "data: two\n\n"} {
+		if _, err := c.Response().Write([]byte(event)); err != nil {
+			return err
+		}
+		c.Response().Flush()
+	}
+	return nil
+}
+
+func Test_FieldMask_Flush(t *testing.T) {
+	e := echo.New()
+	e.GET("/stream", streamHandler, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/stream?fieldMask=id", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Assert(t, rec.Flushed)
+	assert.Equal(t, "data: one\n\ndata: two\n\n", rec.Body.String())
+}
+
+func Test_FieldMask_EventStream(t *testing.T) {
+	e := echo.New()
+	e.GET("/test", func(c echo.Context) error {
+		// Not buffered, so the handler has the original writer
+		_, buffered := c.Response().Writer.(*bufferedWriter)
+		assert.Assert(t, !buffered)
+		return testHandler(c)
+	}, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/test?fieldMask=id", nil)
+	req.Header.Set(echo.HeaderAccept, "text/event-stream")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"description":"ACME Corp","id":"acme","site":[{"description":"site 1","id":"s1"}]}`+"\n", rec.Body.String())
+}
+
+// hijackRecorder - a recorder whose connection can be taken over
+type hijackRecorder struct {
+	*httptest.ResponseRecorder
+	hijacked bool
+}
+
+func (r *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	r.hijacked = true
+	return nil, nil, nil
+}
+
+func Test_FieldMask_Websocket(t *testing.T) {
+	e := echo.New()
+	e.GET("/ws", func(c echo.Context) error {
+		_, buffered := c.Response().Writer.(*bufferedWriter)
+		assert.Assert(t, !buffered)
+		_, _, err := c.Response().Hijack()
+		return err
+	}, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/ws?fieldMask=id", nil)
+	req.Header.Set(echo.HeaderUpgrade, "websocket")
+	rec := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+	e.ServeHTTP(rec, req)
+	assert.Assert(t, rec.hijacked)
+}
+
+func Test_FieldMask_Hijack(t *testing.T) {
+	e := echo.New()
+	e.GET("/ws", func(c echo.Context) error {
+		_, _, err := c.Response().Hijack()
+		return err
+	}, FieldMask())
+
+	// Without the Upgrade header the response is buffered, but hijacking still reaches the connection
+	req := httptest.NewRequest(http.MethodGet, "/ws?fieldMask=id", nil)
+	rec := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+	e.ServeHTTP(rec, req)
+	assert.Assert(t, rec.hijacked)
+}
+
+func Test_FieldMask_ContentEncoding(t *testing.T) {
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	_, err := gz.Write([]byte(`{"id":"acme","description":"ACME Corp"}`))
+	assert.NilError(t, err)
+	assert.NilError(t, gz.Close())
+
+	e := echo.New()
+	e.GET("/spec", func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentEncoding, "gzip")
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, gzipped.Bytes())
+	}, FieldMask())
+
+	req := httptest.NewRequest(http.MethodGet, "/spec?fieldMask=id", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, gzipped.Bytes(), rec.Body.Bytes())
+}
@@ -19,4 +19,7 @@ type GnmiClient interface {
 	Init(gnmiConn *grpc.ClientConn) error
 	Get(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error)
 	Set(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error)
+	Capabilities(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error)
+	SubscribeOnce(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error)
+	Subscribe(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"google.golang.org/grpc"
+	"io"
 )
 
 var log = logging.GetLogger("southbound")
@@ -35,3 +36,50 @@ func (p *GNMIProvisioner) Get(ctx context.Context, request *gnmi.GetRequest) (*g
 func (p *GNMIProvisioner) Set(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
 	return p.gnmi.Set(ctx, request)
 }
+
+// Capabilities passes a gNMI CapabilityRequest to the server which synchronously replies with a CapabilityResponse
+func (p *GNMIProvisioner) Capabilities(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	return p.gnmi.Capabilities(ctx, request)
+}
+
+// SubscribeOnce makes a subscription, which should be in ONCE mode, and returns the notifications
+// sent up to its sync response, after which the server closes it
+func (p *GNMIProvisioner) SubscribeOnce(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // Closes the stream, if the server has not
+	stream, err := p.gnmi.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = stream.Send(request); err != nil {
+		return nil, err
+	}
+	notifications := make([]*gnmi.Notification, 0)
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return notifications, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if response.GetSyncResponse() {
+			return notifications, nil
+		}
+		if notification := response.GetUpdate(); notification != nil {
+			notifications = append(notifications, notification)
+		}
+	}
+}
+
+// Subscribe opens a subscription, in any mode, and sends it the request. The caller receives from the
+// stream, may send it polls, and closes it by cancelling ctx
+func (p *GNMIProvisioner) Subscribe(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error) {
+	stream, err := p.gnmi.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = stream.Send(request); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package southbound
+
+import (
+	"context"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// DefaultSlotWait - how long a gNMI call waits for one of the others to finish before giving up
+const DefaultSlotWait = 2 * time.Second
+
+// LimitedGnmiClient - a GnmiClient that lets no more than a fixed number of Get, Set and Subscribe calls be
+// in flight at once, so that a burst of requests does not overwhelm onos-config. A call that cannot
+// start within the slot wait fails with Unavailable, which is a 503 to the REST client
+type LimitedGnmiClient struct {
+	client   GnmiClient
+	slots    chan struct{}
+	slotWait time.Duration
+}
+
+// NewLimitedGnmiClient - wraps client so that at most maxInFlight Get, Set and Subscribe calls are made at once
+func NewLimitedGnmiClient(client GnmiClient, maxInFlight int, slotWait time.Duration) *LimitedGnmiClient {
+	return &LimitedGnmiClient{
+		client:   client,
+		slots:    make(chan struct{}, maxInFlight),
+		slotWait: slotWait,
+	}
+}
+
+// acquire - take a slot, waiting up to slotWait for one to be free
+func (l *LimitedGnmiClient) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	timer := time.NewTimer(l.slotWait)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return status.Errorf(codes.Unavailable, "too many gNMI calls in flight (%d). Try again later", cap(l.slots))
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (l *LimitedGnmiClient) release() {
+	<-l.slots
+}
+
+// Init initializes the wrapped client
+func (l *LimitedGnmiClient) Init(gnmiConn *grpc.ClientConn) error {
+	return l.client.Init(gnmiConn)
+}
+
+// Get passes the GetRequest on once there is a free slot
+func (l *LimitedGnmiClient) Get(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	if err := l.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer l.release()
+	return l.client.Get(ctx, request)
+}
+
+// Set passes the SetRequest on once there is a free slot
+func (l *LimitedGnmiClient) Set(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	if err := l.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer l.release()
+	return l.client.Set(ctx, request)
+}
+
+// Capabilities are not limited, as they are cheap for onos-config and used by the probes
+func (l *LimitedGnmiClient) Capabilities(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	return l.client.Capabilities(ctx, request)
+}
+
+// SubscribeOnce passes the SubscribeRequest on once there is a free slot, which is held until it is done
+func (l *LimitedGnmiClient) SubscribeOnce(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error) {
+	if err := l.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer l.release()
+	return l.client.SubscribeOnce(ctx, request)
+}
+
+// Subscribe is not limited, as the subscription is held open for as long as the client wants it, and
+// would keep the slot from Get and Set. The streams open are limited by the caller instead
+func (l *LimitedGnmiClient) Subscribe(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error) {
+	return l.client.Subscribe(ctx, request)
+}
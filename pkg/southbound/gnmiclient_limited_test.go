@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package southbound
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_LimitedGnmiClient_cap(t *testing.T) {
+	const maxInFlight = 3
+	var inFlight, most int32
+	call := func() {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&most)
+			if n <= m || atomic.CompareAndSwapInt32(&most, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			call()
+			return &gnmi.GetResponse{}, nil
+		}).AnyTimes()
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			call()
+			return &gnmi.SetResponse{}, nil
+		}).AnyTimes()
+	client := NewLimitedGnmiClient(mockClient, maxInFlight, 10*time.Second)
+
+	var wg sync.WaitGroup
+	for n := 0; n < 50; n++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.Get(context.Background(), &gnmi.GetRequest{})
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.Set(context.Background(), &gnmi.SetRequest{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, most, int32(maxInFlight))
+	assert.Equal(t, int32(maxInFlight), most, "the calls should have used all of the slots")
+}
+
+func Test_LimitedGnmiClient_unavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := NewMockGnmiClient(ctrl)
+	release := make(chan struct{})
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			<-release
+			return &gnmi.GetResponse{}, nil
+		})
+	client := NewLimitedGnmiClient(mockClient, 1, 10*time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := client.Get(context.Background(), &gnmi.GetRequest{})
+		assert.NoError(t, err)
+	}()
+	// Wait for the first call to take the only slot
+	for len(client.slots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := client.Get(context.Background(), &gnmi.GetRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	close(release)
+	<-done
+}
@@ -78,3 +78,48 @@ func (mr *MockGnmiClientMockRecorder) Set(ctx, request interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockGnmiClient)(nil).Set), ctx, request)
 }
+
+// Capabilities mocks base method
+func (m *MockGnmiClient) Capabilities(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities", ctx, request)
+	ret0, _ := ret[0].(*gnmi.CapabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capabilities indicates an expected call of Capabilities
+func (mr *MockGnmiClientMockRecorder) Capabilities(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockGnmiClient)(nil).Capabilities), ctx, request)
+}
+
+// SubscribeOnce mocks base method
+func (m *MockGnmiClient) SubscribeOnce(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeOnce", ctx, request)
+	ret0, _ := ret[0].([]*gnmi.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeOnce indicates an expected call of SubscribeOnce
+func (mr *MockGnmiClientMockRecorder) SubscribeOnce(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOnce", reflect.TypeOf((*MockGnmiClient)(nil).SubscribeOnce), ctx, request)
+}
+
+// Subscribe mocks base method
+func (m *MockGnmiClient) Subscribe(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, request)
+	ret0, _ := ret[0].(gnmi.GNMI_SubscribeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockGnmiClientMockRecorder) Subscribe(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockGnmiClient)(nil).Subscribe), ctx, request)
+}
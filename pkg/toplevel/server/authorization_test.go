@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+func Test_authorizeOperation(t *testing.T) {
+	server := &TopLevelServer{}
+	admin := []string{adminGroup}
+	acmeUser := []string{"acme", roleWrite}
+	reader := []string{"acme", roleRead}
+
+	allowed, _ := server.authorizeOperation(admin, "POST", "/sdcore/synchronize/:service", "/sdcore/synchronize/sdcore-adapter-v4")
+	assert.True(t, allowed)
+
+	allowed, reason := server.authorizeOperation(acmeUser, "POST", "/sdcore/synchronize/:service", "/sdcore/synchronize/sdcore-adapter-v4")
+	assert.False(t, allowed)
+	assert.Equal(t, "requires one of [admin AetherROCAdmin]", reason)
+
+	allowed, _ = server.authorizeOperation(acmeUser, "DELETE", "/aether/v4.0.0/connectivity-service-v4/enterprise/enterprise/:id",
+		"/aether/v4.0.0/connectivity-service-v4/enterprise/enterprise/acme")
+	assert.True(t, allowed)
+
+	allowed, reason = server.authorizeOperation(acmeUser, "delete", "/aether/v2.0.0/connectivity-service-v2/enterprises/enterprise/:id/site",
+		"/aether/v2.0.0/connectivity-service-v2/enterprises/enterprise/starbucks/site")
+	assert.False(t, allowed)
+	assert.Equal(t, "not a member of enterprise starbucks", reason)
+
+	allowed, _ = server.authorizeOperation(acmeUser, "GET", "/targets", "/targets")
+	assert.True(t, allowed)
+
+	// Reading is not enough to change anything, even in the user's own enterprise
+	allowed, _ = server.authorizeOperation(reader, "GET", "/aether-roc-api", "/aether-roc-api")
+	assert.True(t, allowed)
+	allowed, reason = server.authorizeOperation(reader, "PATCH", "/aether-roc-api", "/aether-roc-api")
+	assert.False(t, allowed)
+	assert.Equal(t, "requires one of [write admin AetherROCAdmin]", reason)
+
+	// As in the RoleRequirements of the server
+	server.RoleRequirements = RoleRequirements{http.MethodPatch: {roleRead}}
+	allowed, _ = server.authorizeOperation(reader, "PATCH", "/aether-roc-api", "/aether-roc-api")
+	assert.True(t, allowed)
+}
+
+func Test_operationRoute(t *testing.T) {
+	e := echo.New()
+	e.POST("/sdcore/synchronize/:service", func(ctx echo.Context) error { return nil })
+	e.PATCH("/aether-roc-api", func(ctx echo.Context) error { return nil })
+
+	assert.Equal(t, "/sdcore/synchronize/:service", operationRoute(e, "post", "/sdcore/synchronize/sdcore-adapter-v4"))
+	assert.Equal(t, "/aether-roc-api", operationRoute(e, "PATCH", "/aether-roc-api"))
+}
+
+func Test_UsernameMiddleware(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := newTestJWKS(t, key)
+	defer jwks.Close()
+	server := &TopLevelServer{Authorization: true, JWKSURL: jwks.URL}
+
+	var username string
+	var md metadata.MD
+	e := echo.New()
+	e.Use(server.UsernameMiddleware())
+	e.GET("/targets", func(ctx echo.Context) error {
+		username = requestUser(ctx)
+		gnmiCtx, cancel := utils.NewGnmiContext(ctx, time.Second)
+		defer cancel()
+		md, _ = metadata.FromOutgoingContext(gnmiCtx)
+		return nil
+	})
+	get := func(token string) {
+		req := httptest.NewRequest(http.MethodGet, "/targets", nil)
+		if token != "" {
+			req.Header.Set(authorization, "Bearer "+token)
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	get(signToken(t, key, jwt.MapClaims{"preferred_username": "alice", "sub": "1234", "exp": exp}))
+	assert.Equal(t, "alice", username)
+	assert.Equal(t, []string{"alice"}, md.Get(utils.ContextUsername))
+
+	get(signToken(t, key, jwt.MapClaims{"sub": "1234", "exp": exp}))
+	assert.Equal(t, "1234", username)
+
+	// Not valid - the user is not trusted
+	get(signToken(t, key, jwt.MapClaims{"preferred_username": "alice", "exp": time.Now().Add(-time.Hour).Unix()}))
+	assert.Equal(t, "anonymous", username)
+	assert.Empty(t, md.Get(utils.ContextUsername))
+
+	get("")
+	assert.Equal(t, "anonymous", username)
+}
+
+func Test_parseAuthClaims_withoutJWKS(t *testing.T) {
+	// Verified by onos-lib-go, with an HS256 key shared with the issuer
+	assert.NoError(t, os.Setenv(auth.SharedSecretKey, "secret"))
+	defer os.Unsetenv(auth.SharedSecretKey)
+	server := &TopLevelServer{Authorization: true, JWTAudience: "aether-roc-api"}
+	parse := func(claims jwt.MapClaims) error {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		req := httptest.NewRequest(http.MethodGet, "/targets", nil)
+		req.Header.Set(authorization, "Bearer "+token)
+		_, _, err = server.parseAuthClaims(echo.New().NewContext(req, httptest.NewRecorder()))
+		return err
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	assert.NoError(t, parse(jwt.MapClaims{"sub": "alice", "aud": "aether-roc-api", "exp": exp}))
+	for name, claims := range map[string]jwt.MapClaims{
+		"wrong audience": {"sub": "alice", "aud": "other", "exp": exp},
+		"no audience":    {"sub": "alice", "exp": exp},
+		"no exp":         {"sub": "alice", "aud": "aether-roc-api"},
+		"expired":        {"sub": "alice", "aud": "aether-roc-api", "exp": time.Now().Add(-time.Hour).Unix()},
+	} {
+		httpErr, ok := parse(claims).(*echo.HTTPError)
+		assert.True(t, ok, name)
+		assert.Equal(t, http.StatusUnauthorized, httpErr.Code, name)
+	}
+}
+
+func Test_clientCertAuthorization(t *testing.T) {
+	server := &TopLevelServer{
+		Authorization: true,
+		ClientCertRoles: map[string][]string{
+			"sdcore-adapter":                 {"read"},
+			"spiffe://aether/sdcore-adapter": {adminGroup, "read"},
+		},
+	}
+	spiffe, err := url.Parse("spiffe://aether/sdcore-adapter")
+	assert.NoError(t, err)
+	withCert := func(req *http.Request, cert *x509.Certificate) *http.Request {
+		req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		return req
+	}
+	adapter := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "sdcore-adapter"},
+		DNSNames: []string{"sdcore-adapter.aether.svc"},
+		URIs:     []*url.URL{spiffe},
+	}
+
+	var username string
+	var identities []string
+	e := echo.New()
+	e.Use(server.UsernameMiddleware())
+	e.GET("/admin", func(ctx echo.Context) error {
+		username = requestUser(ctx)
+		identities = utils.RequestClientIdentities(ctx)
+		return server.checkAuthorization(ctx, adminGroup)
+	})
+	get := func(req *http.Request) int {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, get(withCert(httptest.NewRequest(http.MethodGet, "/admin", nil), adapter)))
+	assert.Equal(t, "sdcore-adapter", username)
+	assert.Equal(t, []string{"sdcore-adapter", "sdcore-adapter.aether.svc", "spiffe://aether/sdcore-adapter"}, identities)
+
+	// The common name alone has only read
+	reader := &x509.Certificate{Subject: pkix.Name{CommonName: "sdcore-adapter"}}
+	assert.Equal(t, http.StatusForbidden, get(withCert(httptest.NewRequest(http.MethodGet, "/admin", nil), reader)))
+
+	// Given but not verified - the server was not asked to verify it
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{adapter}}
+	assert.Equal(t, http.StatusUnauthorized, get(req))
+	assert.Equal(t, "anonymous", username)
+	assert.Empty(t, identities)
+
+	assert.Equal(t, http.StatusUnauthorized, get(httptest.NewRequest(http.MethodGet, "/admin", nil)))
+}
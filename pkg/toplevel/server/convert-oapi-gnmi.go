@@ -27,6 +27,8 @@ type GnmiPatchBody struct {
 	Updates       []*gnmi.Update
 	Deletes       []*gnmi.Path
 	DefaultTarget string
+	// Prefix - the prefix of the body, already joined to the paths of Updates and Deletes
+	Prefix        *gnmi.Path
 	Ext100Name    *string
 	Ext101Version *string
 	Ext102Type    *string
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/golang-jwt/jwt"
+	"gopkg.in/square/go-jose.v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksMinRefresh - the keys are fetched again for an unknown key ID at most this often,
+	// so that tokens with made up key IDs cannot make us hammer the ID provider
+	jwksMinRefresh = time.Minute
+	jwksTimeout    = 10 * time.Second
+)
+
+// jwksCache - the public keys of the ID provider, fetched from its JWKS URL and kept by key ID.
+// With a ttl the keys are fetched again in the background once they are 3/4 of it old, while those
+// known are still used. The keys are fetched without holding c.mu, and at most one fetch is made at
+// a time - requests for an unknown key ID wait on it. A fetch that fails keeps the last keys fetched
+type jwksCache struct {
+	url       string
+	ttl       time.Duration
+	client    *http.Client
+	mu        sync.Mutex
+	keys      map[string]interface{}
+	fetched   time.Time // the last fetch tried
+	refreshed time.Time // the last fetch that gave keys
+	inflight  *jwksFetch
+	now       func() time.Time
+}
+
+// jwksFetch - a fetch of the keys that requests can wait on
+type jwksFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// newJWKSCache - a cache of the keys at url. A ttl of 0 keeps them until an unknown key ID is seen
+func newJWKSCache(url string, ttl time.Duration) *jwksCache {
+	return &jwksCache{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksTimeout},
+		keys:   make(map[string]interface{}),
+		now:    time.Now,
+	}
+}
+
+// key - the public key with keyID, fetching the keys again if it is not known
+func (c *jwksCache) key(keyID string) (interface{}, error) {
+	c.mu.Lock()
+	if c.ttl > 0 && len(c.keys) > 0 && c.now().Sub(c.refreshed) >= c.ttl*3/4 &&
+		c.inflight == nil && c.now().Sub(c.fetched) >= jwksMinRefresh {
+		c.startFetch()
+	}
+	if key, ok := c.keys[keyID]; ok {
+		c.mu.Unlock()
+		return key, nil
+	}
+	call := c.inflight
+	if call == nil {
+		if c.now().Sub(c.fetched) < jwksMinRefresh {
+			c.mu.Unlock()
+			return nil, fmt.Errorf("unknown key ID %s", keyID)
+		}
+		call = c.startFetch()
+	}
+	c.mu.Unlock()
+
+	<-call.done
+	if call.err != nil {
+		return nil, fmt.Errorf("unable to fetch keys from %s %v", c.url, call.err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key, ok := c.keys[keyID]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key ID %s", keyID)
+}
+
+// startFetch - fetch the keys in the background, with c.mu held. The keys are swapped in under c.mu
+// once fetched, or if the fetch fails the last keys are kept
+func (c *jwksCache) startFetch() *jwksFetch {
+	call := &jwksFetch{done: make(chan struct{})}
+	c.inflight = call
+	c.fetched = c.now()
+	go func() {
+		keys, err := c.fetch()
+		c.mu.Lock()
+		c.inflight = nil
+		if err != nil {
+			if len(c.keys) > 0 {
+				log.Warnf("Unable to refresh keys from %s, keeping those from %v. %v", c.url, c.refreshed, err)
+			}
+		} else {
+			c.keys = keys
+			c.refreshed = c.now()
+		}
+		call.err = err
+		c.mu.Unlock()
+		close(call.done)
+	}()
+	return call
+}
+
+// fetch - the signing keys at the JWKS URL, by key ID
+func (c *jwksCache) fetch() (map[string]interface{}, error) {
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s", resp.Status)
+	}
+	var keySet jose.JSONWebKeySet
+	if err = json.NewDecoder(resp.Body).Decode(&keySet); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, key := range keySet.Keys {
+		if key.Use == "" || key.Use == "sig" {
+			keys[key.KeyID] = key.Key
+		}
+	}
+	return keys, nil
+}
+
+// parse - verify the signature of the token against the ID provider's keys, that it has not
+// expired and, if audience is given, that it was issued for us
+func (c *jwksCache) parse(tokenString string, audience string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		// Only public key algorithms - an HS token signed with the public key must not pass
+		alg := token.Method.Alg()
+		if !strings.HasPrefix(alg, "RS") && !strings.HasPrefix(alg, "PS") && !strings.HasPrefix(alg, "ES") {
+			return nil, fmt.Errorf("unexpected signing algorithm %s", alg)
+		}
+		keyID, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("token header has no 'kid' (key ID)")
+		}
+		return c.key(keyID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyExpiresAt(c.now().Unix(), true) {
+		return nil, fmt.Errorf("token has no expiry or has expired")
+	}
+	if audience != "" && !claims.VerifyAudience(audience, true) {
+		return nil, fmt.Errorf("token is not for audience %s", audience)
+	}
+	return claims, nil
+}
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/square/go-jose.v2"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testKeyID = "test-key"
+
+func newTestJWKS(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: testKeyID, Algorithm: "RS256", Use: "sig"},
+		}}))
+	}))
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func Test_checkAuthorization_JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := newTestJWKS(t, key)
+	defer jwks.Close()
+
+	server := &TopLevelServer{JWKSURL: jwks.URL, JWTAudience: "aether-roc-api"}
+	claims := func(groups []string, exp time.Time, aud string) jwt.MapClaims {
+		return jwt.MapClaims{"name": "alice", "groups": groups, "exp": exp.Unix(), "aud": aud}
+	}
+	hour := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		token string
+		code  int
+	}{
+		{"valid", signToken(t, key, claims([]string{adminGroup}, hour, "aether-roc-api")), 0},
+		{"not admin", signToken(t, key, claims([]string{"acme"}, hour, "aether-roc-api")), http.StatusForbidden},
+		{"expired", signToken(t, key, claims([]string{adminGroup}, time.Now().Add(-time.Hour), "aether-roc-api")), http.StatusUnauthorized},
+		{"wrong audience", signToken(t, key, claims([]string{adminGroup}, hour, "other")), http.StatusUnauthorized},
+		{"bad signature", signToken(t, otherKey, claims([]string{adminGroup}, hour, "aether-roc-api")), http.StatusUnauthorized},
+		{"no expiry", signToken(t, key, jwt.MapClaims{"groups": []string{adminGroup}, "aud": "aether-roc-api"}), http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/sdcore/synchronize/sdcore-adapter-v4", nil)
+			req.Header.Set(authorization, "Bearer "+tt.token)
+			err := server.checkAuthorization(echo.New().NewContext(req, httptest.NewRecorder()), adminGroup)
+			if tt.code == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			httpErr, ok := err.(*echo.HTTPError)
+			assert.True(t, ok, err)
+			assert.Equal(t, tt.code, httpErr.Code)
+		})
+	}
+}
+
+func Test_jwksCache_HSToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := newTestJWKS(t, key)
+	defer jwks.Close()
+
+	// An HMAC token must not be accepted, whatever it is signed with
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString([]byte("secret"))
+	assert.NoError(t, err)
+
+	_, err = newJWKSCache(jwks.URL, 0).parse(signed, "")
+	assert.Error(t, err)
+}
+
+func Test_jwksCache_refresh(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	fetches := 0
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		assert.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: testKeyID},
+		}}))
+	}))
+	defer jwks.Close()
+
+	cache := newJWKSCache(jwks.URL, 0)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetches, "the keys are cached")
+
+	// Unknown key IDs do not cause a fetch more than once a minute
+	_, err = cache.key("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, 1, fetches)
+}
+
+func Test_jwksCache_ttl(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	var fetches, failing int32
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: testKeyID},
+		}}))
+	}))
+	defer jwks.Close()
+
+	now := time.Now()
+	cache := newJWKSCache(jwks.URL, time.Hour)
+	cache.now = func() time.Time { return now }
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// Before the TTL is near, the keys are not fetched
+	now = now.Add(30 * time.Minute)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// Near the TTL they are fetched in the background, while the cached key is used
+	now = now.Add(20 * time.Minute)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return cache.inflight == nil && cache.refreshed.Equal(now)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+
+	// Once expired and the ID provider is down, the last keys are kept
+	atomic.StoreInt32(&failing, 1)
+	now = now.Add(2 * time.Hour)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return cache.inflight == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&fetches))
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+}
+
+func Test_jwksCache_knownKeyDoesNotWait(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	release := make(chan struct{})
+	var fetches int32
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&fetches, 1) > 1 {
+			<-release
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: testKeyID},
+		}}))
+	}))
+	defer jwks.Close()
+	defer close(release)
+
+	now := time.Now()
+	cache := newJWKSCache(jwks.URL, time.Hour)
+	cache.now = func() time.Time { return now }
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+
+	// An unknown key ID waits on a slow fetch, while a known one is still served
+	now = now.Add(2 * time.Hour)
+	unknown := make(chan error, 1)
+	go func() {
+		_, err := cache.key("rotated")
+		unknown <- err
+	}()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&fetches) == 2 }, 5*time.Second, 10*time.Millisecond)
+	_, err = cache.key(testKeyID)
+	assert.NoError(t, err)
+	select {
+	case <-unknown:
+		t.Fatal("the unknown key ID did not wait on the fetch")
+	default:
+	}
+	release <- struct{}{}
+	assert.Error(t, <-unknown)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches), "the requests share one fetch")
+}
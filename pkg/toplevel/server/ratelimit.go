@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+	// rateLimiterIdle - clients not seen for this long are forgotten, and start again with a full bucket
+	rateLimiterIdle = 10 * time.Minute
+)
+
+// RateLimiterStore - holds the token bucket of each client. Allow takes a token from the bucket of key,
+// or says how long until there will be one
+type RateLimiterStore interface {
+	Allow(key string) (bool, time.Duration)
+}
+
+// MemoryRateLimiterStore - a RateLimiterStore of this process, for when there is one replica
+type MemoryRateLimiterStore struct {
+	limit     rate.Limit
+	burst     int
+	mu        sync.Mutex
+	limiters  map[string]*clientLimiter
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewMemoryRateLimiterStore - buckets of burst tokens, refilled at perSecond
+func NewMemoryRateLimiterStore(perSecond float64, burst int) *MemoryRateLimiterStore {
+	if burst < 1 {
+		burst = 1
+	}
+	return &MemoryRateLimiterStore{
+		limit:    rate.Limit(perSecond),
+		burst:    burst,
+		limiters: make(map[string]*clientLimiter),
+		now:      time.Now,
+	}
+}
+
+// Allow -
+func (s *MemoryRateLimiterStore) Allow(key string) (bool, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if now.Sub(s.lastPrune) > rateLimiterIdle {
+		for k, l := range s.limiters {
+			if now.Sub(l.lastSeen) > rateLimiterIdle {
+				delete(s.limiters, k)
+			}
+		}
+		s.lastPrune = now
+	}
+	l, ok := s.limiters[key]
+	if !ok {
+		l = &clientLimiter{limiter: rate.NewLimiter(s.limit, s.burst)}
+		s.limiters[key] = l
+	}
+	l.lastSeen = now
+	reservation := l.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+func (i *TopLevelServer) rateLimiterStore() RateLimiterStore {
+	i.rateLimiterOnce.Do(func() {
+		if i.RateLimiterStore == nil {
+			i.RateLimiterStore = NewMemoryRateLimiterStore(i.RateLimit, i.RateBurst)
+		}
+	})
+	return i.RateLimiterStore
+}
+
+// RateLimitMiddleware - when RateLimit is set, limits each client IP to RateLimit requests per
+// second with bursts of RateBurst. Over the limit the client gets 429 and when to try again
+func (i *TopLevelServer) RateLimitMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if i.RateLimit <= 0 {
+				return next(ctx)
+			}
+			allowed, retryAfter := i.rateLimiterStore().Allow(ctx.RealIP())
+			if !allowed {
+				ctx.Response().Header().Set(headerRetryAfter,
+					strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				return echo.NewHTTPError(http.StatusTooManyRequests,
+					fmt.Sprintf("too many requests from %s", ctx.RealIP()))
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// ClientIPExtractor - the client IP is the address of the connection, unless that is one of
+// trustedProxies (IPs or CIDRs), when it is taken from X-Forwarded-For
+func ClientIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy %s is not an IP or CIDR", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
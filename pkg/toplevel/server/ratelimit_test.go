@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_MemoryRateLimiterStore(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryRateLimiterStore(1, 2)
+	store.now = func() time.Time { return now }
+
+	for n := 0; n < 2; n++ {
+		allowed, _ := store.Allow("10.0.0.1")
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter := store.Allow("10.0.0.1")
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Other clients have their own bucket
+	allowed, _ = store.Allow("10.0.0.2")
+	assert.True(t, allowed)
+
+	now = now.Add(time.Second)
+	allowed, _ = store.Allow("10.0.0.1")
+	assert.True(t, allowed)
+
+	// Idle clients are forgotten
+	now = now.Add(2 * rateLimiterIdle)
+	_, _ = store.Allow("10.0.0.3")
+	assert.Equal(t, 1, len(store.limiters))
+}
+
+func Test_RateLimitMiddleware(t *testing.T) {
+	server := &TopLevelServer{RateLimit: 0.5, RateBurst: 1}
+	handler := server.RateLimitMiddleware()(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+	assert.NoError(t, handler(e.NewContext(req, httptest.NewRecorder())))
+
+	rec := httptest.NewRecorder()
+	err := handler(e.NewContext(req, rec))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+	assert.Equal(t, "2", rec.Header().Get(headerRetryAfter))
+
+	// Not limited when no RateLimit is set
+	unlimited := (&TopLevelServer{}).RateLimitMiddleware()(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	for n := 0; n < 10; n++ {
+		assert.NoError(t, unlimited(e.NewContext(req, httptest.NewRecorder())))
+	}
+}
+
+func Test_ClientIPExtractor(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+	req.RemoteAddr = "10.1.2.3:4567"
+	req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.9")
+
+	direct, err := ClientIPExtractor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.2.3", direct(req))
+
+	behindProxy, err := ClientIPExtractor([]string{"10.1.0.0/16"})
+	assert.NoError(t, err)
+	assert.Equal(t, "203.0.113.9", behindProxy(req))
+
+	otherProxy, err := ClientIPExtractor([]string{"10.9.9.9"})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.2.3", otherProxy(req))
+
+	_, err = ClientIPExtractor([]string{"proxy.example.com"})
+	assert.Error(t, err)
+}
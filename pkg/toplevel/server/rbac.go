@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/ghodss/yaml"
+	"github.com/labstack/echo/v4"
+	"io/ioutil"
+	"net/http"
+)
+
+// The roles of the default RoleRequirements
+const (
+	roleRead  = "read"
+	roleWrite = "write"
+	roleAdmin = "admin"
+)
+
+// RoleRequirements - the roles (any one of) that a caller must have, keyed by "METHOD /route" as the
+// route is registered e.g. "POST /sdcore/synchronize/:service", or by just "METHOD" for the operations
+// with no entry of their own. An empty list means no token is needed. Operations with no entry
+// at all are not restricted
+type RoleRequirements map[string][]string
+
+// DefaultRoleRequirements - reading needs the read role, changing needs write or admin.
+// Members of AetherROCAdmin may do anything
+var DefaultRoleRequirements = RoleRequirements{
+	http.MethodGet:    {roleRead, roleWrite, roleAdmin, adminGroup},
+	http.MethodPatch:  {roleWrite, roleAdmin, adminGroup},
+	http.MethodPost:   {roleWrite, roleAdmin, adminGroup},
+	http.MethodPut:    {roleWrite, roleAdmin, adminGroup},
+	http.MethodDelete: {roleWrite, roleAdmin, adminGroup},
+
+	"POST /sdcore/synchronize/:service": {roleAdmin, adminGroup},
+	"POST /transactions/:id/rollback":   {roleAdmin, adminGroup},
+	"POST /transactions/:id/cancel":     {roleAdmin, adminGroup},
+	"GET /latency-stats":                {roleAdmin, adminGroup},
+	"GET /log-level":                    {roleAdmin, adminGroup},
+	"PUT /log-level":                    {roleAdmin, adminGroup},
+	"GET /config":                       {roleAdmin, adminGroup},
+	// Checking what may be done changes nothing, so it is a read
+	"POST /authorize-check": {roleRead, roleWrite, roleAdmin, adminGroup},
+
+	// Probes, metrics, the GUI and the specs are open
+	"GET /healthz":                         {},
+	"GET /ready":                           {},
+	"GET /metrics":                         {},
+	"GET /":                                {},
+	"GET /*":                               {},
+	"GET /aether-top-level-openapi3.yaml":  {},
+	"GET /aether-2.0.0-openapi3.yaml":      {},
+	"GET /aether-4.0.0-openapi3.yaml":      {},
+	"GET /aether-app-gtwy-openapi3.yaml":   {},
+	"GET /specs":                           {},
+	"HEAD /aether-top-level-openapi3.yaml": {},
+	"HEAD /aether-2.0.0-openapi3.yaml":     {},
+	"HEAD /aether-4.0.0-openapi3.yaml":     {},
+	"HEAD /aether-app-gtwy-openapi3.yaml":  {},
+	"HEAD /specs":                          {},
+}
+
+// LoadRoleRequirements - read RoleRequirements from a YAML or JSON file
+func LoadRoleRequirements(path string) (RoleRequirements, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read role map %s %v", path, err)
+	}
+	roles := make(RoleRequirements)
+	if err = yaml.Unmarshal(data, &roles); err != nil {
+		return nil, fmt.Errorf("unable to parse role map %s %v", path, err)
+	}
+	return roles, nil
+}
+
+// required - the roles needed for the operation, and whether it is restricted at all
+func (r RoleRequirements) required(method string, route string) ([]string, bool) {
+	if roles, ok := r[method+" "+route]; ok {
+		return roles, len(roles) > 0
+	}
+	roles, ok := r[method]
+	return roles, ok && len(roles) > 0
+}
+
+func (i *TopLevelServer) roleRequirements() RoleRequirements {
+	if i.RoleRequirements != nil {
+		return i.RoleRequirements
+	}
+	return DefaultRoleRequirements
+}
+
+// RBACMiddleware - when Authorization is on, checks the roles of the caller's token against the
+// RoleRequirements of the operation. 401 without a valid token, 403 without one of the roles
+func (i *TopLevelServer) RBACMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !i.Authorization {
+				return next(ctx)
+			}
+			roles, restricted := i.roleRequirements().required(ctx.Request().Method, ctx.Path())
+			if !restricted {
+				return next(ctx)
+			}
+			if err := i.checkAuthorization(ctx, roles...); err != nil {
+				return err
+			}
+			return next(ctx)
+		}
+	}
+}
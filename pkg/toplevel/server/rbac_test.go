@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_RBACMiddleware(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := newTestJWKS(t, key)
+	defer jwks.Close()
+	server := &TopLevelServer{Authorization: true, JWKSURL: jwks.URL}
+
+	e := echo.New()
+	e.Use(server.RBACMiddleware())
+	ok := func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }
+	e.GET("/targets", ok)
+	e.PATCH("/aether-roc-api", ok)
+	e.POST("/sdcore/synchronize/:service", ok)
+	e.POST("/transactions/:id/rollback", ok)
+	e.POST("/transactions/:id/cancel", ok)
+	e.GET("/healthz", ok)
+	e.GET("/specs", ok)
+	e.HEAD("/specs", ok)
+	e.HEAD("/aether-app-gtwy-openapi3.yaml", ok)
+
+	call := func(method string, path string, roles ...string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if roles != nil {
+			req.Header.Set(authorization, "Bearer "+signToken(t, key, jwt.MapClaims{
+				"sub": "alice", "roles": roles, "exp": time.Now().Add(time.Hour).Unix()}))
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/targets", roleRead))
+	assert.Equal(t, http.StatusForbidden, call(http.MethodPatch, "/aether-roc-api", roleRead))
+	assert.Equal(t, http.StatusOK, call(http.MethodPatch, "/aether-roc-api", roleWrite))
+	assert.Equal(t, http.StatusForbidden, call(http.MethodPost, "/sdcore/synchronize/sdcore-adapter-v4", roleWrite))
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/sdcore/synchronize/sdcore-adapter-v4", roleAdmin))
+	assert.Equal(t, http.StatusForbidden, call(http.MethodPost, "/transactions/tx-1/rollback", roleWrite))
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/transactions/tx-1/rollback", roleAdmin))
+	assert.Equal(t, http.StatusForbidden, call(http.MethodPost, "/transactions/tx-1/cancel", roleWrite))
+	assert.Equal(t, http.StatusOK, call(http.MethodPost, "/transactions/tx-1/cancel", roleAdmin))
+	assert.Equal(t, http.StatusUnauthorized, call(http.MethodGet, "/targets"))
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/healthz"))
+	// The specs are open, like the probes
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/specs"))
+	assert.Equal(t, http.StatusOK, call(http.MethodHead, "/specs"))
+	assert.Equal(t, http.StatusOK, call(http.MethodHead, "/aether-app-gtwy-openapi3.yaml"))
+
+	// A custom map replaces the defaults
+	server.RoleRequirements = RoleRequirements{"PATCH /aether-roc-api": {"editor"}}
+	assert.Equal(t, http.StatusOK, call(http.MethodPatch, "/aether-roc-api", "editor"))
+	assert.Equal(t, http.StatusForbidden, call(http.MethodPatch, "/aether-roc-api", roleWrite))
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/targets"))
+}
+
+func Test_LoadRoleRequirements(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rbac")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "roles.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`
+GET: [viewer]
+"POST /sdcore/synchronize/:service": [ops]
+`), 0600))
+
+	roles, err := LoadRoleRequirements(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleRequirements{
+		"GET":                               {"viewer"},
+		"POST /sdcore/synchronize/:service": {"ops"},
+	}, roles)
+
+	_, err = LoadRoleRequirements(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
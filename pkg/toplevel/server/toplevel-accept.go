@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	mimeJSON = "application/json"
+	mimeYAML = "application/yaml"
+	mimeXML  = "application/xml"
+	mimeHTML = "text/html"
+	mimeAny  = "*/*"
+	// mimeProtobuf - the spec as a google.protobuf.Struct
+	mimeProtobuf = "application/x-protobuf"
+)
+
+// specTypes - the types the specs can be sent as. */* gets YAML
+var specTypes = map[string]string{
+	mimeJSON:     mimeJSON,
+	mimeYAML:     mimeYAML,
+	mimeXML:      mimeXML,
+	mimeHTML:     mimeHTML,
+	mimeProtobuf: mimeProtobuf,
+	mimeAny:      mimeYAML,
+}
+
+type acceptedType struct {
+	mediaType string
+	q         float64
+	order     int
+}
+
+// parseAccept - the media types of an Accept header, highest q-value first. Where the q-values
+// are the same JSON comes first, then any type before */*, then the order of the header.
+// Types with q=0 are left out
+func parseAccept(header string) []acceptedType {
+	accepted := make([]acceptedType, 0)
+	for order, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+		if mediaType == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range params[1:] {
+			name, value := param, ""
+			if idx := strings.Index(param, "="); idx >= 0 {
+				name, value = param[:idx], param[idx+1:]
+			}
+			if strings.TrimSpace(name) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				// A malformed weight is treated as the lowest acceptable
+				parsed = 0.001
+			}
+			q = parsed
+		}
+		if q == 0 {
+			continue
+		}
+		accepted = append(accepted, acceptedType{mediaType: mediaType, q: q, order: order})
+	}
+	sort.SliceStable(accepted, func(a, b int) bool {
+		if accepted[a].q != accepted[b].q {
+			return accepted[a].q > accepted[b].q
+		}
+		if (accepted[a].mediaType == mimeJSON) != (accepted[b].mediaType == mimeJSON) {
+			return accepted[a].mediaType == mimeJSON
+		}
+		if (accepted[a].mediaType == mimeAny) != (accepted[b].mediaType == mimeAny) {
+			return accepted[b].mediaType == mimeAny
+		}
+		return accepted[a].order < accepted[b].order
+	})
+	return accepted
+}
+
+// negotiateSpecType - the highest weighted type in the Accept header that the spec can be sent as.
+// Empty if there is none
+func negotiateSpecType(header string) string {
+	for _, accepted := range parseAccept(header) {
+		if specType, ok := specTypes[accepted.mediaType]; ok {
+			return specType
+		}
+	}
+	return ""
+}
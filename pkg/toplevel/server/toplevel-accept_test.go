@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_negotiateSpecType(t *testing.T) {
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"application/json", mimeJSON},
+		{"application/yaml", mimeYAML},
+		{"*/*", mimeYAML},
+		{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", mimeHTML},
+		{"application/xml;q=0.9, application/json;q=0.8", mimeXML},
+		{"application/json;q=0.8, application/xml;q=0.9", mimeXML},
+		{"application/yaml;q=0.5, application/json;q=0.5", mimeJSON},
+		{"application/yaml, application/json", mimeJSON},
+		{"*/*, application/xml", mimeXML},
+		{"application/json; charset=utf-8; q=0.2, application/yaml;q=0.3", mimeYAML},
+		{"application/json;q=0, application/yaml;q=0.1", mimeYAML},
+		{"application/pdf;q=1, application/yaml;q=0.1", mimeYAML},
+		{"APPLICATION/JSON", mimeJSON},
+		{"application/json;q=0", ""},
+		{"application/pdf", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, negotiateSpecType(tt.accept), tt.accept)
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"net/http"
+	"time"
+)
+
+// accessLog - one line per request. Giving the "access" logger a sink with encoding: json in
+// logging.yaml makes each line a JSON object for log aggregation
+var accessLog = logging.GetLogger("access")
+
+// AccessLogMiddleware - logs the method, path, status, duration, client IP, user and ID of each request
+// once it has been handled. Put it before UsernameMiddleware, which fills in the user on the way in
+func AccessLogMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			accessLog.Infow("access", accessLogFields(ctx, err, time.Since(start))...)
+			return err
+		}
+	}
+}
+
+// accessLogFields - the keys and values of the access log line. The status of an error is that of
+// the response it will become, as the error handler only writes it after the middleware returns
+func accessLogFields(ctx echo.Context, err error, duration time.Duration) []interface{} {
+	status := ctx.Response().Status
+	if err != nil {
+		status = http.StatusInternalServerError
+		if httpErr, ok := err.(*echo.HTTPError); ok {
+			status = httpErr.Code
+		}
+	}
+	return []interface{}{
+		"method", ctx.Request().Method,
+		"path", ctx.Request().URL.Path,
+		"status", status,
+		"durationMs", float64(duration.Microseconds()) / 1000,
+		"clientIp", ctx.RealIP(),
+		"user", requestUser(ctx),
+		"requestId", utils.RequestID(ctx),
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func accessLogFieldMap(fields []interface{}) map[string]interface{} {
+	fieldMap := make(map[string]interface{})
+	for i := 0; i+1 < len(fields); i += 2 {
+		fieldMap[fields[i].(string)] = fields[i+1]
+	}
+	return fieldMap
+}
+
+func Test_accessLogFields(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/targets?x=1", nil)
+	req.RemoteAddr = "10.0.0.7:41234"
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.Set(utils.ContextUsername, "alice")
+	assert.NoError(t, ctx.NoContent(http.StatusOK))
+
+	fields := accessLogFieldMap(accessLogFields(ctx, nil, 1500*time.Microsecond))
+	assert.Equal(t, http.MethodGet, fields["method"])
+	assert.Equal(t, "/targets", fields["path"])
+	assert.Equal(t, http.StatusOK, fields["status"])
+	assert.Equal(t, 1.5, fields["durationMs"])
+	assert.Equal(t, "10.0.0.7", fields["clientIp"])
+	assert.Equal(t, "alice", fields["user"])
+}
+
+func Test_accessLogFields_error(t *testing.T) {
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodPatch, "/aether-roc-api", nil), httptest.NewRecorder())
+
+	fields := accessLogFieldMap(accessLogFields(ctx, echo.NewHTTPError(http.StatusForbidden, "no"), time.Millisecond))
+	assert.Equal(t, http.StatusForbidden, fields["status"])
+	assert.Equal(t, "anonymous", fields["user"])
+
+	fields = accessLogFieldMap(accessLogFields(ctx, assert.AnError, time.Millisecond))
+	assert.Equal(t, http.StatusInternalServerError, fields["status"])
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditEvent - a change made through the API: who made it, when, to what, and the transaction it made
+type AuditEvent struct {
+	Time      time.Time `json:"time"`
+	User      string    `json:"user"`
+	Operation string    `json:"operation"`
+	RequestID string    `json:"requestId,omitempty"`
+	// Target - the target of the request, or for synchronize the sdcore service
+	Target string `json:"target,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Changes - each path the gNMI Set deleted, replaced or updated
+	Changes       []AuditChange `json:"changes,omitempty"`
+	TransactionID string        `json:"transactionId,omitempty"`
+	// RolledBack - for a rollback, the transaction rolled back
+	RolledBack string `json:"rolledBack,omitempty"`
+}
+
+// AuditChange - one path changed by a gNMI Set. Op is delete, replace or update
+type AuditChange struct {
+	Op     string `json:"op"`
+	Target string `json:"target,omitempty"`
+	Path   string `json:"path"`
+}
+
+// AuditSink - where the AuditEvents go. It is separate from the access log, which may be sampled or
+// dropped, so a sink should keep every event
+type AuditSink interface {
+	Audit(event AuditEvent) error
+}
+
+// JSONAuditSink - an AuditSink writing each event as a line of JSON
+type JSONAuditSink struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewJSONAuditSink - a JSONAuditSink writing to writer e.g. os.Stdout or an append-only file
+func NewJSONAuditSink(writer io.Writer) *JSONAuditSink {
+	return &JSONAuditSink{writer: writer}
+}
+
+// Audit - write the event, a whole line at a time
+func (s *JSONAuditSink) Audit(event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.writer.Write(append(line, '\n'))
+	return err
+}
+
+// auditSink - AuditSink, or with Authorization on and no AuditSink, JSON to stdout. nil when changes
+// are not audited
+func (i *TopLevelServer) auditSink() AuditSink {
+	if i.AuditSink != nil {
+		return i.AuditSink
+	}
+	if !i.Authorization {
+		return nil
+	}
+	i.auditSinkOnce.Do(func() {
+		i.defaultAuditSink = NewJSONAuditSink(os.Stdout)
+	})
+	return i.defaultAuditSink
+}
+
+// auditChangesKey - the key of the auditChanges of a request's gNMI context
+type auditChangesKey struct{}
+
+// auditChanges - the paths changed by the gNMI Sets of a request
+type auditChanges struct {
+	mu      sync.Mutex
+	changes []AuditChange
+}
+
+// withAuditChanges - a context in which the gNMI Sets record what they change, for the audit event
+func withAuditChanges(ctx context.Context) (context.Context, *auditChanges) {
+	changes := &auditChanges{}
+	return context.WithValue(ctx, auditChangesKey{}, changes), changes
+}
+
+// recordAuditChanges - add the paths of the Set to the auditChanges of the context, if it has any
+func recordAuditChanges(ctx context.Context, gnmiSet *gnmi.SetRequest) {
+	changes, ok := ctx.Value(auditChangesKey{}).(*auditChanges)
+	if !ok {
+		return
+	}
+	changes.mu.Lock()
+	defer changes.mu.Unlock()
+	// The paths are recorded whole, with the prefix of the Set joined to them as gNMI does
+	prefixElems := gnmiSet.GetPrefix().GetElem()
+	add := func(op string, path *gnmi.Path) {
+		elems := append(append(make([]*gnmi.PathElem, 0, len(prefixElems)+len(path.GetElem())),
+			prefixElems...), path.GetElem()...)
+		pathStr, err := ygot.PathToString(&gnmi.Path{Elem: elems})
+		if err != nil {
+			pathStr = path.String()
+		}
+		changes.changes = append(changes.changes, AuditChange{Op: op, Target: path.GetTarget(), Path: pathStr})
+	}
+	for _, path := range gnmiSet.GetDelete() {
+		add("delete", path)
+	}
+	for _, u := range gnmiSet.GetReplace() {
+		add("replace", u.GetPath())
+	}
+	for _, u := range gnmiSet.GetUpdate() {
+		add("update", u.GetPath())
+	}
+}
+
+// audit - send the AuditEvent of a change, with what its gNMI Sets recorded in changes (which may be
+// nil), to the auditSink. A sink that fails is logged, as the change has already been made
+func (i *TopLevelServer) audit(ctx echo.Context, changes *auditChanges, event AuditEvent) {
+	sink := i.auditSink()
+	if sink == nil {
+		return
+	}
+	event.Time = time.Now().UTC()
+	event.User = requestUser(ctx)
+	event.Operation = ctx.Request().Method + " " + ctx.Path()
+	event.RequestID = utils.RequestID(ctx)
+	if changes != nil {
+		changes.mu.Lock()
+		event.Changes = append(event.Changes, changes.changes...)
+		changes.mu.Unlock()
+	}
+	if err := sink.Audit(event); err != nil {
+		log.Errorf("Unable to audit %s by %s. %v", event.Operation, requester(ctx), err)
+	}
+}
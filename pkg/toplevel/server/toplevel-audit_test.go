@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type recordingAuditSink struct {
+	events []AuditEvent
+}
+
+func (s *recordingAuditSink) Audit(event AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func Test_auditSink(t *testing.T) {
+	assert.Assert(t, (&TopLevelServer{}).auditSink() == nil)
+	server := &TopLevelServer{Authorization: true}
+	assert.Assert(t, server.auditSink() != nil)
+	assert.Assert(t, server.auditSink() == server.auditSink())
+	sink := &recordingAuditSink{}
+	assert.Assert(t, (&TopLevelServer{AuditSink: sink}).auditSink() == sink)
+}
+
+func Test_JSONAuditSink(t *testing.T) {
+	var b bytes.Buffer
+	sink := NewJSONAuditSink(&b)
+	assert.NilError(t, sink.Audit(AuditEvent{User: "alice", Operation: "DELETE /aether-roc-api", TransactionID: "tx-1"}))
+	assert.NilError(t, sink.Audit(AuditEvent{User: "bob", Operation: "POST /transactions/:id/rollback"}))
+
+	lines := bytes.Split(bytes.TrimSpace(b.Bytes()), []byte("\n"))
+	assert.Equal(t, 2, len(lines))
+	var event AuditEvent
+	assert.NilError(t, json.Unmarshal(lines[0], &event))
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "tx-1", event.TransactionID)
+}
+
+func Test_DeleteAetherRocAPI_audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&gnmi.GetResponse{Notification: []*gnmi.Notification{{
+		Update: []*gnmi.Update{{Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"id":"s1"}`)}}}},
+	}}}, nil)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(&gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+		Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id: 100, Msg: []byte("tx-12"),
+		}},
+	}}}, nil)
+	sink := &recordingAuditSink{}
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, AuditSink: sink}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s1]", nil)
+	ctx := e.NewContext(req, httptest.NewRecorder())
+	ctx.SetPath("/aether-roc-api")
+	ctx.Set(utils.ContextUsername, "alice")
+	assert.NilError(t, server.DeleteAetherRocAPI(ctx))
+
+	assert.Equal(t, 1, len(sink.events))
+	event := sink.events[0]
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "DELETE /aether-roc-api", event.Operation)
+	assert.Equal(t, "connectivity-service-v4", event.Target)
+	assert.Equal(t, "/site/site[id=s1]", event.Path)
+	assert.Equal(t, "tx-12", event.TransactionID)
+	assert.DeepEqual(t, []AuditChange{{Op: "delete", Target: "connectivity-service-v4", Path: "/site/site[id=s1]"}}, event.Changes)
+	assert.Assert(t, !event.Time.IsZero())
+}
+
+func Test_recordAuditChanges(t *testing.T) {
+	set := &gnmi.SetRequest{
+		Delete:  []*gnmi.Path{{Target: "t1", Elem: []*gnmi.PathElem{{Name: "site"}}}},
+		Replace: []*gnmi.Update{{Path: &gnmi.Path{Target: "t1", Elem: []*gnmi.PathElem{{Name: "site"}, {Name: "id"}}}}},
+		Update:  []*gnmi.Update{{Path: &gnmi.Path{Target: "t2", Elem: []*gnmi.PathElem{{Name: "upf"}}}}},
+	}
+	// Nothing is recorded without auditChanges
+	recordAuditChanges(context.Background(), set)
+
+	ctx, changes := withAuditChanges(context.Background())
+	recordAuditChanges(ctx, set)
+	assert.DeepEqual(t, []AuditChange{
+		{Op: "delete", Target: "t1", Path: "/site"},
+		{Op: "replace", Target: "t1", Path: "/site/id"},
+		{Op: "update", Target: "t2", Path: "/upf"},
+	}, changes.changes)
+}
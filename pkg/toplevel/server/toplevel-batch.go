@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"net/http"
+)
+
+// PatchAetherRocAPIBatch - set each of a list of paths to its value in a single transaction, so that
+// they are all applied or none are. If onos-config reports that only some were applied, the
+// transaction is rolled back and a BatchFailure is returned with 500
+func (i *TopLevelServer) PatchAetherRocAPIBatch(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	body, err := utils.ReadRequestBodyLimited(ctx.Request().Body, i.maxBodyBytes())
+	if err != nil {
+		return err
+	}
+	updates, err := batchUpdates(body)
+	if err != nil {
+		return err
+	}
+	targets := make([]configapi.TargetID, 0, len(updates))
+	for _, u := range updates {
+		targets = append(targets, configapi.TargetID(u.GetPath().GetTarget()))
+	}
+	if err = i.checkIfMatch(ctx, gnmiCtx, targets...); err != nil {
+		return err
+	}
+
+	auditCtx, changes := withAuditChanges(gnmiCtx)
+	transactionInfo, unapplied, err := i.gnmiPatchAetherRocAPIBatch(auditCtx, updates)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	// Audited even when partly applied, as the transaction was made before it was rolled back
+	i.audit(ctx, changes, AuditEvent{TransactionID: string(transactionInfo.ID)})
+	if len(unapplied) > 0 {
+		failure := i.rollBackPartialBatch(gnmiCtx, transactionInfo, unapplied)
+		log.Warnw("PatchAetherRocAPIBatch partly applied", "transaction", failure.TransactionId,
+			"unapplied", unapplied, "rolledBack", failure.RolledBack, "by", requester(ctx))
+		return ctx.JSON(http.StatusInternalServerError, failure)
+	}
+	if transactionInfo.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(transactionInfo.Index))
+	}
+	log.Debugf("PatchAetherRocAPIBatch %d updates by %s", len(updates), requester(ctx))
+	return ctx.JSON(http.StatusOK, newTransactionInfo(transactionInfo))
+}
+
+// gnmiPatchAetherRocAPIBatch makes the updates in one gNMI Set, once the list entries they are made
+// under are known to exist. The ID and index (revision) of the resulting transaction are returned,
+// with the paths of the updates that the response shows were not applied
+func (i *TopLevelServer) gnmiPatchAetherRocAPIBatch(ctx context.Context, updates []*gnmi.Update) (
+	*configapi.TransactionInfo, []string, error) {
+	if err := i.validateParentsExist(ctx, updates); err != nil {
+		return nil, nil, err
+	}
+	gnmiSet, err := utils.NewGnmiSetRequest(updates, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	gnmiSetResponse, err := i.gnmiSetResponse(ctx, gnmiSet)
+	if err != nil {
+		return nil, nil, err
+	}
+	transactionInfo, err := utils.ExtractTransactionInfo(gnmiSetResponse)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transactionInfo, unappliedUpdates(updates, gnmiSetResponse), nil
+}
+
+// unappliedUpdates - the target and path of each update without an UPDATE or REPLACE result in the
+// response. A response with no results at all is taken as applying everything, as gNMI Sets are atomic
+func unappliedUpdates(updates []*gnmi.Update, gnmiSetResponse *gnmi.SetResponse) []string {
+	if len(gnmiSetResponse.GetResponse()) == 0 {
+		return nil
+	}
+	// A result without a target answers the update of its path on any target
+	applied := make(map[string]bool)
+	for _, result := range gnmiSetResponse.GetResponse() {
+		if op := result.GetOp(); op != gnmi.UpdateResult_UPDATE && op != gnmi.UpdateResult_REPLACE {
+			continue
+		}
+		target := result.GetPath().GetTarget()
+		if target == "" {
+			target = gnmiSetResponse.GetPrefix().GetTarget()
+		}
+		applied[target+":"+entryPath(result.GetPath().GetElem())] = true
+	}
+	unapplied := make([]string, 0)
+	for _, u := range updates {
+		path := entryPath(u.GetPath().GetElem())
+		if !applied[u.GetPath().GetTarget()+":"+path] && !applied[":"+path] {
+			unapplied = append(unapplied, u.GetPath().GetTarget()+":"+path)
+		}
+	}
+	return unapplied
+}
+
+// rollBackPartialBatch - roll back the transaction of a batch that was only partly applied. Whether it
+// was rolled back is in the BatchFailure, as the configuration is left inconsistent if it was not
+func (i *TopLevelServer) rollBackPartialBatch(ctx context.Context, transactionInfo *configapi.TransactionInfo,
+	unapplied []string) *types.BatchFailure {
+	failure := &types.BatchFailure{
+		TransactionId: string(transactionInfo.ID),
+		Unapplied:     unapplied,
+	}
+	if i.AdminClient == nil || i.ConfigClient == nil {
+		failure.Message = "the batch was only partly applied, and cannot be rolled back without onos-config's admin API"
+		return failure
+	}
+	// The transaction info of older onos-config has only the ID
+	index := transactionInfo.Index
+	if index == 0 {
+		transactions, err := i.grpcGetTransactionsRaw(ctx, nil)
+		if err != nil {
+			failure.Message = fmt.Sprintf("the batch was only partly applied, and its transaction was not found to roll back. %v", err)
+			return failure
+		}
+		transaction := findTransaction(transactions, string(transactionInfo.ID))
+		if transaction == nil {
+			failure.Message = "the batch was only partly applied, and its transaction was not found to roll back"
+			return failure
+		}
+		index = transaction.Index
+	}
+	resp, err := i.grpcRollbackTransaction(ctx, index)
+	if err != nil {
+		failure.Message = fmt.Sprintf("the batch was only partly applied, and rolling it back failed. %v", err)
+		return failure
+	}
+	rollbackID := string(resp.ID)
+	failure.RolledBack = true
+	failure.RollbackTransactionId = &rollbackID
+	failure.Message = "the batch was only partly applied, and has been rolled back"
+	return failure
+}
+
+// batchUpdates decodes a BatchUpdates body in to gNMI updates. Anything other than a non-empty list
+// of leaf values, each with a target and a valid path, is a 400
+func batchUpdates(body []byte) ([]*gnmi.Update, error) {
+	var batch types.BatchUpdates
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+	if err := dec.Decode(&batch); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unable to unmarshal JSON as types.BatchUpdates: %v", err))
+	}
+	if len(batch) == 0 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "the batch has no updates")
+	}
+
+	updates := make([]*gnmi.Update, 0, len(batch))
+	for idx, entry := range batch {
+		if entry.Target == "" {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("update %d has no target", idx))
+		}
+		gnmiPath, err := ygot.StringToStructuredPath(entry.Path)
+		if err == nil && !validBatchPath(gnmiPath) {
+			err = fmt.Errorf("empty element")
+		}
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("update %d has an invalid path %s %v", idx, entry.Path, err))
+		}
+		gnmiPath.Target = entry.Target
+		typedValue, err := batchValue(entry.Value)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("update %d of %s has an invalid value. %v", idx, entry.Path, err))
+		}
+		updates = append(updates, &gnmi.Update{Path: gnmiPath, Val: typedValue})
+	}
+	return updates, nil
+}
+
+// validBatchPath - whether a path has elements, all with names. StringToStructuredPath allows
+// some malformed paths, such as "site[", through as empty elements
+func validBatchPath(path *gnmi.Path) bool {
+	if len(path.GetElem()) == 0 {
+		return false
+	}
+	for _, elem := range path.GetElem() {
+		if elem.GetName() == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// batchValue - the gNMI value of a leaf. Whole numbers are unsigned unless negative, as most of the
+// numeric leaves of the Aether models are
+func batchValue(value interface{}) (*gnmi.TypedValue, error) {
+	switch v := value.(type) {
+	case string:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: v}}, nil
+	case bool:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: v}}, nil
+	case json.Number:
+		if intVal, err := v.Int64(); err == nil {
+			if intVal < 0 {
+				return &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: intVal}}, nil
+			}
+			return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(intVal)}}, nil
+		}
+		floatVal, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_FloatVal{FloatVal: float32(floatVal)}}, nil
+	case nil:
+		return nil, fmt.Errorf("value cannot be empty")
+	}
+	return nil, fmt.Errorf("only strings, numbers and booleans can be set. Got %T", value)
+}
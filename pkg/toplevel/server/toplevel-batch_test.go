@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_PatchAetherRocAPIBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			// All of the updates are in the one Set
+			assert.Len(t, request.Update, 3)
+			assert.Equal(t, "connectivity-service-v4", request.Update[0].Path.Target)
+			assert.Equal(t, "Site 1", request.Update[0].Val.GetStringVal())
+			assert.Equal(t, uint64(5), request.Update[1].Val.GetUintVal())
+			assert.Equal(t, true, request.Update[2].Val.GetBoolVal())
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		})
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api/batch", strings.NewReader(`[
+{"target":"connectivity-service-v4","path":"/site/site[id=s1]/display-name","value":"Site 1"},
+{"target":"connectivity-service-v4","path":"/site/site[id=s1]/small-cell[small-cell-id=c1]/tac","value":5},
+{"target":"connectivity-service-v4","path":"/site/site[id=s1]/small-cell[small-cell-id=c1]/enable","value":true}]`))
+	rec := httptest.NewRecorder()
+	assert.NoError(t, server.PatchAetherRocAPIBatch(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"transactionId":"tx-10"`)
+}
+
+func Test_PatchAetherRocAPIBatch_partial(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			// Only the first of the updates has a result
+			return &gnmi.SetResponse{
+				Response: []*gnmi.UpdateResult{{Path: request.Update[0].Path, Op: gnmi.UpdateResult_UPDATE}},
+				Extension: []*gnmi_ext.Extension{{
+					Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+						Id: 100, Msg: []byte("tx-10"),
+					}},
+				}},
+			}, nil
+		}).Times(2)
+	body := `[
+{"target":"connectivity-service-v4","path":"/site/site[id=s1]/display-name","value":"Site 1"},
+{"target":"connectivity-service-v4","path":"/site/site[id=s1]/description","value":"The first site"}]`
+	patch := func(server *TopLevelServer) externalRef0.BatchFailure {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api/batch", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		assert.NoError(t, server.PatchAetherRocAPIBatch(echo.New().NewContext(req, rec)))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		var failure externalRef0.BatchFailure
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &failure))
+		return failure
+	}
+
+	adminClient := &fakeAdminClient{}
+	failure := patch(&TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, AdminClient: adminClient,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{changeTransaction("tx-10", 10, "/a")}}})
+	assert.True(t, failure.RolledBack, failure.Message)
+	assert.Equal(t, "tx-9", *failure.RollbackTransactionId)
+	assert.Equal(t, "tx-10", failure.TransactionId)
+	assert.Equal(t, []string{"connectivity-service-v4:/site/site[id=s1]/description"}, failure.Unapplied)
+	assert.Equal(t, []configapi.Index{10}, adminClient.rolledBack)
+
+	// Without the admin API it is reported, but left as it is
+	failure = patch(&TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute})
+	assert.False(t, failure.RolledBack)
+	assert.Nil(t, failure.RollbackTransactionId)
+	assert.Contains(t, failure.Message, "cannot be rolled back")
+}
+
+func Test_unappliedUpdates(t *testing.T) {
+	updates, err := batchUpdates([]byte(`[
+{"target":"t1","path":"/site/site[id=s1]/display-name","value":"Site 1"},
+{"target":"t2","path":"/site/site[id=s1]/display-name","value":"Site 1"}]`))
+	assert.NoError(t, err)
+
+	// No results is atomic
+	assert.Empty(t, unappliedUpdates(updates, &gnmi.SetResponse{}))
+	// The target of a result may be in the prefix
+	assert.Equal(t, []string{"t2:/site/site[id=s1]/display-name"}, unappliedUpdates(updates, &gnmi.SetResponse{
+		Prefix:   &gnmi.Path{Target: "t1"},
+		Response: []*gnmi.UpdateResult{{Path: &gnmi.Path{Elem: updates[0].Path.Elem}, Op: gnmi.UpdateResult_UPDATE}},
+	}))
+	// A failed result does not count
+	assert.Len(t, unappliedUpdates(updates, &gnmi.SetResponse{
+		Response: []*gnmi.UpdateResult{{Path: updates[0].Path, Op: gnmi.UpdateResult_INVALID}},
+	}), 2)
+}
+
+func Test_PatchAetherRocAPIBatch_invalid(t *testing.T) {
+	// None of these get as far as onos-config
+	server := &TopLevelServer{GnmiTimeout: time.Minute}
+	for _, body := range []string{
+		`[]`,
+		`{}`,
+		`[{"path":"/site/site[id=s1]/display-name","value":"Site 1"}]`,
+		`[{"target":"connectivity-service-v4","path":"site[","value":"Site 1"}]`,
+		`[{"target":"connectivity-service-v4","path":"/site/site a","value":"Site 1"}]`,
+		`[{"target":"connectivity-service-v4","path":"/","value":"Site 1"}]`,
+		`[{"target":"connectivity-service-v4","path":"/site/site[id=s1]","value":{"display-name":"Site 1"}}]`,
+		`[{"target":"connectivity-service-v4","path":"/site/site[id=s1]/display-name"}]`,
+		`[{"target":"connectivity-service-v4","path":"/site/site[id=s1]/display-name","value":"Site 1","extra":1}]`,
+	} {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api/batch", strings.NewReader(body))
+		err := server.PatchAetherRocAPIBatch(echo.New().NewContext(req, httptest.NewRecorder()))
+		httpErr, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok, body) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code, body)
+		}
+	}
+}
+
+func Test_batchValue(t *testing.T) {
+	updates, err := batchUpdates([]byte(`[{"target":"t","path":"/a","value":-3},{"target":"t","path":"/b","value":1.5}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-3), updates[0].Val.GetIntVal())
+	assert.Equal(t, float32(1.5), updates[1].Val.GetFloatVal())
+}
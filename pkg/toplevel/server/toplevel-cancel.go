@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"net/http"
+)
+
+// PostTransactionCancel - cancel a transaction that is still pending. One that has been committed, applied
+// or has failed cannot be. onos-config's TransactionService has no call to abort a transaction, so a
+// pending one is answered with 501 and its status, rather than being left to go through unnoticed
+func (i *TopLevelServer) PostTransactionCancel(ctx echo.Context, id string) error {
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "transaction id cannot be empty")
+	}
+	if i.ConfigClient == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "cancel is not available without onos-config's admin API")
+	}
+
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+	transactions, err := i.grpcGetTransactionsRaw(gnmiCtx, nil)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	transaction := findTransaction(transactions, id)
+	if transaction == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("transaction %s not found", id))
+	}
+	state := transaction.Status.State
+	if !transactionPending(state) {
+		return echo.NewHTTPError(http.StatusConflict,
+			fmt.Sprintf("transaction %s has already completed. It is %s", transaction.ID, state))
+	}
+
+	log.Debugf("PostTransactionCancel %s by %s", transaction.ID, requester(ctx))
+	return echo.NewHTTPError(http.StatusNotImplemented,
+		fmt.Sprintf("onos-config cannot abort transaction %s. It is still %s", transaction.ID, state))
+}
+
+// transactionPending - whether a transaction in the state has not yet been committed
+func transactionPending(state configapi.TransactionStatus_State) bool {
+	return state == configapi.TransactionStatus_PENDING || state == configapi.TransactionStatus_VALIDATED
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func postCancel(server *TopLevelServer, id string) error {
+	req := httptest.NewRequest(http.MethodPost, "/transactions/"+id+"/cancel", nil)
+	return server.PostTransactionCancel(echo.New().NewContext(req, httptest.NewRecorder()), id)
+}
+
+func Test_PostTransactionCancel(t *testing.T) {
+	withState := func(transaction *configapi.Transaction, state configapi.TransactionStatus_State) *configapi.Transaction {
+		transaction.Status.State = state
+		return transaction
+	}
+	server := &TopLevelServer{
+		GnmiTimeout: time.Minute,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			withState(changeTransaction("tx-1", 1, "/a"), configapi.TransactionStatus_APPLIED),
+			withState(changeTransaction("tx-2", 2, "/a"), configapi.TransactionStatus_FAILED),
+			withState(changeTransaction("tx-3", 3, "/a"), configapi.TransactionStatus_PENDING),
+		}},
+	}
+	httpCode := func(err error) int {
+		if httpErr, ok := err.(*echo.HTTPError); ok {
+			return httpErr.Code
+		}
+		return 0
+	}
+
+	assert.Equal(t, http.StatusNotFound, httpCode(postCancel(server, "tx-7")))
+	for _, id := range []string{"tx-1", "2"} {
+		err := postCancel(server, id)
+		assert.Equal(t, http.StatusConflict, httpCode(err), id)
+		assert.Contains(t, err.Error(), "already completed")
+	}
+	err := postCancel(server, "tx-3")
+	assert.Equal(t, http.StatusNotImplemented, httpCode(err))
+	assert.Contains(t, err.Error(), "still PENDING")
+
+	assert.Equal(t, http.StatusServiceUnavailable, httpCode(postCancel(&TopLevelServer{}, "tx-3")))
+}
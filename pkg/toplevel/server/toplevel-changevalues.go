@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// parallelValuesMin - the fewest values of a change that are converted by a pool of workers. Fewer
+// are quicker to convert than to hand out
+const parallelValuesMin = 512
+
+// changeValueJob - a value of a change to convert, and where its PathTarget goes
+type changeValueJob struct {
+	target int
+	index  int
+	path   string
+	value  *configapi.PathValue
+}
+
+// changeTargets - the values of a change transaction by target, both sorted (by target and by path)
+// so the result is the same for the same change, as the maps of the change are not ordered. A large
+// change has its values converted by a pool of a worker per CPU, each writing to its own place in the result
+func changeTargets(change *configapi.ChangeTransaction) externalRef0.ChangeTransaction {
+	return changeTargetsWith(change, runtime.GOMAXPROCS(0))
+}
+
+// changeTargetsWith - changeTargets with at most workers converting the values. 1 converts them serially
+func changeTargetsWith(change *configapi.ChangeTransaction, workers int) externalRef0.ChangeTransaction {
+	changeTransactions := make(externalRef0.ChangeTransaction, 0, len(change.GetValues()))
+	targetIDs := make([]string, 0, len(change.GetValues()))
+	for targetID := range change.GetValues() {
+		targetIDs = append(targetIDs, string(targetID))
+	}
+	sort.Strings(targetIDs)
+
+	jobs := make([]changeValueJob, 0)
+	for idx, targetID := range targetIDs {
+		values := change.GetValues()[configapi.TargetID(targetID)].GetValues()
+		paths := make([]string, 0, len(values))
+		for path := range values {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		pValues := make(externalRef0.PathValues, len(paths))
+		for index, path := range paths {
+			jobs = append(jobs, changeValueJob{target: idx, index: index, path: path, value: values[path]})
+		}
+		tID := targetID
+		changeTransactions = append(changeTransactions, externalRef0.ChangeTarget{
+			TargetName: &tID,
+			PathValues: &pValues,
+		})
+	}
+
+	convert := func(job changeValueJob) {
+		(*changeTransactions[job.target].PathValues)[job.index] = pathTarget(job.path, job.value)
+	}
+	if workers <= 1 || len(jobs) < parallelValuesMin {
+		for _, job := range jobs {
+			convert(job)
+		}
+		return changeTransactions
+	}
+	// Each worker converts a contiguous share of the values, so nothing is handed out one at a time
+	var wg sync.WaitGroup
+	share := (len(jobs) + workers - 1) / workers
+	for start := 0; start < len(jobs); start += share {
+		end := start + share
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+		wg.Add(1)
+		go func(jobs []changeValueJob) {
+			defer wg.Done()
+			for _, job := range jobs {
+				convert(job)
+			}
+		}(jobs[start:end])
+	}
+	wg.Wait()
+	return changeTransactions
+}
+
+// pathTarget - the PathTarget of a value of a change, at the key path of the values of its target
+func pathTarget(key string, pValue *configapi.PathValue) externalRef0.PathTarget {
+	path := pValue.GetPath()
+	bytes := pValue.GetValue().Bytes
+	valueType := pValue.GetValue().Type.String()
+	var typeOpts []externalRef0.TypeOpts
+	for _, tOpts := range pValue.GetValue().TypeOpts {
+		typeOpts = append(typeOpts, (externalRef0.TypeOpts)(tOpts))
+	}
+	pDeleted := pValue.GetDeleted()
+
+	typedValue := new(externalRef0.TypedValue)
+	typedValue.Bytes = (*externalRef0.Bytes)(&bytes)
+	typedValue.TypeOpts = &typeOpts
+	typedValue.Type = (*externalRef0.ValueType)(&valueType)
+
+	pathValue := new(externalRef0.PathValue)
+	pathValue.Path = (*externalRef0.Path)(&path)
+	pathValue.Value = typedValue
+	pathValue.Deleted = (*externalRef0.Deleted)(&pDeleted)
+
+	return externalRef0.PathTarget{
+		Path:      &key,
+		PathValue: pathValue,
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// largeChange - a synthetic change of values for each of targets targets
+func largeChange(targets int, values int) *configapi.ChangeTransaction {
+	change := &configapi.ChangeTransaction{Values: make(map[configapi.TargetID]*configapi.PathValues)}
+	for t := 0; t < targets; t++ {
+		pathValues := &configapi.PathValues{Values: make(map[string]*configapi.PathValue)}
+		for v := 0; v < values; v++ {
+			path := fmt.Sprintf("/site/site[id=s%d]/display-name", v)
+			pathValues.Values[path] = &configapi.PathValue{
+				Path:  path,
+				Value: configapi.TypedValue{Bytes: []byte(fmt.Sprintf("Site %d", v)), Type: configapi.ValueType_STRING},
+			}
+		}
+		change.Values[configapi.TargetID(fmt.Sprintf("target-%d", t))] = pathValues
+	}
+	return change
+}
+
+func Test_changeTargets(t *testing.T) {
+	change := largeChange(3, 2000)
+	serial := changeTargetsWith(change, 1)
+	assert.Len(t, serial, 3)
+	for idx, target := range serial {
+		assert.Equal(t, fmt.Sprintf("target-%d", idx), *target.TargetName)
+		values := *target.PathValues
+		assert.Len(t, values, 2000)
+		for v := 1; v < len(values); v++ {
+			assert.Less(t, *values[v-1].Path, *values[v].Path)
+		}
+		assert.Equal(t, externalRef0.Path(*values[0].Path), *values[0].PathValue.Path)
+	}
+	// The workers give the same, in the same order
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, serial, changeTargetsWith(change, 8))
+	}
+	assert.Empty(t, changeTargets(&configapi.ChangeTransaction{}))
+}
+
+func Benchmark_changeTargets(b *testing.B) {
+	change := largeChange(4, 5000)
+	b.Run("serial", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			changeTargetsWith(change, 1)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			changeTargets(change)
+		}
+	})
+}
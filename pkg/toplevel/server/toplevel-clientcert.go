@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"net/http"
+)
+
+// clientCertIdentities - the common name and the DNS, email and URI SANs of the verified client
+// certificate of the request, in that order. Empty if it has none, or it was not verified
+func clientCertIdentities(req *http.Request) []string {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	leaf := req.TLS.VerifiedChains[0][0]
+	identities := make([]string, 0, 1+len(leaf.DNSNames)+len(leaf.EmailAddresses)+len(leaf.URIs))
+	if leaf.Subject.CommonName != "" {
+		identities = append(identities, leaf.Subject.CommonName)
+	}
+	identities = append(identities, leaf.DNSNames...)
+	identities = append(identities, leaf.EmailAddresses...)
+	for _, uri := range leaf.URIs {
+		identities = append(identities, uri.String())
+	}
+	return identities
+}
+
+// clientCertClaims - the user of the request's verified client certificate, being its first identity,
+// and the groups ClientCertRoles gives any of its identities. False if there is no such certificate
+func (i *TopLevelServer) clientCertClaims(req *http.Request) (string, []string, bool) {
+	identities := clientCertIdentities(req)
+	if len(identities) == 0 {
+		return "", nil, false
+	}
+	groups := make([]string, 0)
+	for _, identity := range identities {
+		for _, group := range i.ClientCertRoles[identity] {
+			if !contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return identities[0], groups, true
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// GetConfig - the effective settings of the server, for support to confirm how it was started.
+// The TLS configs are only said to be given, and webhook URLs have their credentials redacted.
+// Even with a role map that allows more, only admins may see it
+func (i *TopLevelServer) GetConfig(ctx echo.Context) error {
+	if i.Authorization {
+		if err := i.checkAuthorization(ctx, roleAdmin, adminGroup); err != nil {
+			return err
+		}
+	}
+	syncScheme := i.SyncScheme
+	if syncScheme == "" {
+		syncScheme = defaultSyncScheme
+	}
+	syncPort := i.SyncPort
+	if syncPort == 0 {
+		syncPort = defaultSyncPort
+	}
+	webhookURLs := make([]string, 0, len(i.WebhookURLs))
+	for _, webhookURL := range i.WebhookURLs {
+		webhookURLs = append(webhookURLs, redactURL(webhookURL))
+	}
+	clientCertRoles := externalRef0.ServerConfig_ClientCertRoles(i.ClientCertRoles)
+	targetAliases := externalRef0.ServerConfig_TargetAliases(i.TargetAliases)
+	syncTLS := i.SyncTLSConfig != nil
+	bodyContentTypes := i.bodyContentTypes()
+	config := externalRef0.ServerConfig{
+		Authorization:        i.Authorization,
+		BodyContentTypes:     &bodyContentTypes,
+		ClientCertRoles:      &clientCertRoles,
+		DefaultTarget:        &i.DefaultTarget,
+		GnmiEndpoint:         &i.GnmiEndpoint,
+		GnmiMaxInFlight:      &i.GnmiMaxInFlight,
+		GnmiRetries:          &i.GnmiRetries,
+		GnmiTimeout:          i.GnmiTimeout.String(),
+		JwtAudience:          &i.JWTAudience,
+		JwksUrl:              &i.JWKSURL,
+		MaxBodyBytes:         i.maxBodyBytes(),
+		MaxStreams:           &i.MaxStreams,
+		PathPrefix:           &i.PathPrefix,
+		RateBurst:            &i.RateBurst,
+		RateLimit:            &i.RateLimit,
+		RedactPaths:          &i.RedactPaths,
+		SkipTargetCheck:      &i.SkipTargetCheck,
+		SyncPort:             int(syncPort),
+		SyncScheme:           &syncScheme,
+		SyncTls:              &syncTLS,
+		TargetAliases:        &targetAliases,
+		TargetsCacheTTL:      i.TargetsCacheTTL.String(),
+		TransactionsReadRate: &i.TransactionsReadRate,
+		TrustedProxies:       &i.TrustedProxies,
+		ValidateRequests:     &i.ValidateRequests,
+		WebhookUrls:          &webhookURLs,
+	}
+	gnmiTimeoutMax := i.GnmiTimeoutMax.String()
+	config.GnmiTimeoutMax = &gnmiTimeoutMax
+	handlerTimeout := i.HandlerTimeout.String()
+	config.HandlerTimeout = &handlerTimeout
+	transactionsCacheTTL := i.TransactionsCacheTTL.String()
+	config.TransactionsCacheTTL = &transactionsCacheTTL
+	jwksCacheTTL := i.JWKSCacheTTL.String()
+	config.JwksCacheTTL = &jwksCacheTTL
+	syncTimeout := i.syncTimeout().String()
+	config.SyncTimeout = &syncTimeout
+
+	log.Debugf("GetConfig by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, config)
+}
+
+// redactURL - the URL with its password and the values of its query shown as ****, as they may be
+// tokens. A URL that cannot be parsed is redacted whole
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redactedValue
+	}
+	if u.RawQuery != "" {
+		query := u.Query()
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, url.QueryEscape(key)+"="+redactedValue)
+		}
+		sort.Strings(keys)
+		u.RawQuery = strings.Join(keys, "&")
+	}
+	if _, ok := u.User.Password(); ok {
+		// Put back by hand, as url would escape the redacted value
+		user := url.User(u.User.Username()).String()
+		u.User = nil
+		return strings.Replace(u.String(), "//", "//"+user+":"+redactedValue+"@", 1)
+	}
+	return u.String()
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// DefaultBodyContentTypes - the Content-Types of the bodies of PATCH and PUT at /aether-roc-api
+// when BodyContentTypes is not given: JSON, JSON Merge Patch and YAML
+var DefaultBodyContentTypes = []string{
+	echo.MIMEApplicationJSON,
+	mimeMergePatch,
+	mimeYAML,
+	"application/x-yaml",
+	"text/yaml",
+}
+
+// bodyContentTypes - BodyContentTypes, or DefaultBodyContentTypes when there are none
+func (i *TopLevelServer) bodyContentTypes() []string {
+	if len(i.BodyContentTypes) == 0 {
+		return DefaultBodyContentTypes
+	}
+	return i.BodyContentTypes
+}
+
+// contentTypeMiddleware - rejects a request body whose Content-Type is not one of allowed with 415,
+// before it is read, rather than failing on it as JSON. A body without a Content-Type is taken to be
+// JSON, as it was before there was a check
+func contentTypeMiddleware(allowed []string) echo.MiddlewareFunc {
+	allowedTypes := make(map[string]bool, len(allowed))
+	for _, contentType := range allowed {
+		allowedTypes[strings.ToLower(contentType)] = true
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			contentType := ctx.Request().Header.Get(echo.HeaderContentType)
+			if contentType == "" {
+				return next(ctx)
+			}
+			mediaType, _, err := mime.ParseMediaType(contentType)
+			if err != nil || !allowedTypes[mediaType] {
+				return echo.NewHTTPError(http.StatusUnsupportedMediaType,
+					fmt.Sprintf("Content-Type %s is not supported. Only %s are", contentType, strings.Join(allowed, ", ")))
+			}
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_contentTypeMiddleware(t *testing.T) {
+	handler := contentTypeMiddleware([]string{echo.MIMEApplicationJSON, "application/YAML"})(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	for contentType, code := range map[string]int{
+		"":                                  http.StatusOK,
+		"application/json":                  http.StatusOK,
+		"application/json; charset=utf-8":   http.StatusOK,
+		"application/yaml":                  http.StatusOK,
+		"application/x-www-form-urlencoded": http.StatusUnsupportedMediaType,
+		"text/plain":                        http.StatusUnsupportedMediaType,
+		"not a type;;":                      http.StatusUnsupportedMediaType,
+	} {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader("{}"))
+		if contentType != "" {
+			req.Header.Set(echo.HeaderContentType, contentType)
+		}
+		rec := httptest.NewRecorder()
+		err := handler(echo.New().NewContext(req, rec))
+		if code == http.StatusOK {
+			assert.NoError(t, err, contentType)
+			continue
+		}
+		httpErr, ok := err.(*echo.HTTPError)
+		assert.True(t, ok, contentType)
+		assert.Equal(t, code, httpErr.Code, contentType)
+	}
+}
+
+func Test_PatchAetherRocAPI_contentType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// The body is rejected before anything is sent to onos-config
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}))
+	for _, method := range []string{http.MethodPatch, http.MethodPut} {
+		req := httptest.NewRequest(method, "/aether-roc-api?path=/site", strings.NewReader("default-target=t1"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code, rec.Body.String())
+	}
+
+	// Only the types configured are allowed
+	e = echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute,
+		BodyContentTypes: []string{echo.MIMEApplicationJSON}}))
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader("default-target: t1"))
+	req.Header.Set(echo.HeaderContentType, mimeYAML)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"net/http"
+)
+
+// corsAllowMethods - what the GUI calls. DELETE is for the model APIs
+var corsAllowMethods = []string{http.MethodGet, http.MethodPatch, http.MethodPost, http.MethodDelete, http.MethodOptions}
+
+// corsAllowHeaders - the headers the GUI may send, including those for conditional requests
+var corsAllowHeaders = []string{echo.HeaderAuthorization, echo.HeaderContentType, echo.HeaderAccept,
+	headerIfMatch, headerIfNoneMatch, utils.HeaderGnmiTimeout, echo.HeaderXRequestID}
+
+// CORSMiddleware - lets browser pages from allowOrigins call the API, answering their preflight
+// OPTIONS requests (as for a PATCH to /aether-roc-api) before they reach any other middleware.
+// Must be used first. With no origins nothing is added, so browsers keep to the same origin
+func CORSMiddleware(allowOrigins []string) echo.MiddlewareFunc {
+	if len(allowOrigins) == 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:  allowOrigins,
+		AllowMethods:  corsAllowMethods,
+		AllowHeaders:  corsAllowHeaders,
+		ExposeHeaders: []string{headerETag, echo.HeaderXRequestID},
+	})
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newCORSRouter(t *testing.T, allowOrigins []string) *echo.Echo {
+	e := echo.New()
+	server := &TopLevelServer{Authorization: true}
+	e.Use(CORSMiddleware(allowOrigins))
+	e.Use(server.RBACMiddleware())
+	assert.NoError(t, RegisterHandlers(e, server))
+	return e
+}
+
+func Test_CORSMiddleware_preflightPatch(t *testing.T) {
+	e := newCORSRouter(t, []string{"https://roc-gui.example.com"})
+
+	req := httptest.NewRequest(http.MethodOptions, "/aether-roc-api", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://roc-gui.example.com")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+	req.Header.Set(echo.HeaderAccessControlRequestHeaders, "authorization,content-type,if-match")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// Answered without a token, before RBAC
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://roc-gui.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowMethods), http.MethodPatch)
+	assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowHeaders), echo.HeaderAuthorization)
+	assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowHeaders), headerIfMatch)
+}
+
+func Test_CORSMiddleware_otherOrigin(t *testing.T) {
+	e := newCORSRouter(t, []string{"https://roc-gui.example.com"})
+
+	req := httptest.NewRequest(http.MethodOptions, "/aether-roc-api", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://elsewhere.example.com")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
+
+func Test_CORSMiddleware_noOrigins(t *testing.T) {
+	e := newCORSRouter(t, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://roc-gui.example.com")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"io/ioutil"
+	"mime"
+	"net/http"
+)
+
+// queryTarget - the target query param of the request, or DefaultTarget when it has none
+func (i *TopLevelServer) queryTarget(ctx echo.Context) string {
+	if target := ctx.QueryParam("target"); target != "" {
+		return target
+	}
+	return i.DefaultTarget
+}
+
+// defaultTargetMiddleware - gives a PatchBody without a default-target the defaultTarget, before it
+// is validated. A default-target in the body is kept. Nothing is changed when defaultTarget is empty
+func defaultTargetMiddleware(defaultTarget string, maxBodyBytes int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if defaultTarget == "" {
+				return next(ctx)
+			}
+			req := ctx.Request()
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+			if err != nil || (mediaType != echo.MIMEApplicationJSON && mediaType != mimeMergePatch) {
+				return next(ctx)
+			}
+			body, err := utils.ReadRequestBodyLimited(req.Body, maxBodyBytes)
+			if err != nil {
+				return err
+			}
+			var patchBody map[string]json.RawMessage
+			if err = json.Unmarshal(body, &patchBody); err == nil {
+				var target string
+				if raw, ok := patchBody["default-target"]; !ok || json.Unmarshal(raw, &target) != nil || target == "" {
+					if patchBody["default-target"], err = json.Marshal(defaultTarget); err != nil {
+						return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+					}
+					if body, err = json.Marshal(patchBody); err != nil {
+						return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+					}
+				}
+			}
+			// A body that is not a JSON object is left for the validation to reject
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+			return next(ctx)
+		}
+	}
+}
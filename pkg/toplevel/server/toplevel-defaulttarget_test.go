@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_DefaultTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var targets []string
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			targets = append(targets, request.Path[0].Target)
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{}`)}},
+			}}}}}, nil
+		}).Times(2)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			targets = append(targets, request.Update[0].Path.Target)
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		}).Times(2)
+	serve := func(server *TopLevelServer, method string, url string, body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		assert.NoError(t, RegisterHandlers(e, server))
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	withDefault := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, DefaultTarget: "default-target-1",
+		SkipTargetCheck: true}
+	updates := `"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}`
+
+	rec := serve(withDefault, http.MethodGet, "/aether-roc-api?path=/site", "")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	// The explicit target wins
+	rec = serve(withDefault, http.MethodGet, "/aether-roc-api?target=explicit&path=/site", "")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = serve(withDefault, http.MethodPatch, "/aether-roc-api", `{`+updates+`}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = serve(withDefault, http.MethodPatch, "/aether-roc-api", `{"default-target":"explicit",`+updates+`}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{"default-target-1", "explicit", "default-target-1", "explicit"}, targets)
+
+	// Without a default the target must be given
+	withoutDefault := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true}
+	rec = serve(withoutDefault, http.MethodGet, "/aether-roc-api?path=/site", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(withoutDefault, http.MethodDelete, "/aether-roc-api?path=/site", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(withoutDefault, http.MethodPatch, "/aether-roc-api", `{`+updates+`}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
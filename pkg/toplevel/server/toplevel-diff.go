@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetAetherRocAPIDiff - the paths of a target added, removed and modified between two revisions.
+// onos-config only keeps the current configuration, so both are rebuilt from the transaction history
+func (i *TopLevelServer) GetAetherRocAPIDiff(ctx echo.Context) error {
+	target := ctx.QueryParam("target")
+	if target == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "target cannot be empty")
+	}
+	from, err := revisionParam(ctx, "from")
+	if err != nil {
+		return err
+	}
+	to, err := revisionParam(ctx, "to")
+	if err != nil {
+		return err
+	}
+	if i.ConfigClient == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "revisions are not available without onos-config's admin API")
+	}
+
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+	transactions, err := i.grpcGetTransactionsRaw(gnmiCtx, nil)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	var current configapi.Index
+	for _, t := range transactions {
+		if t.Index > current {
+			current = t.Index
+		}
+	}
+	for _, revision := range []configapi.Index{from, to} {
+		if revision > current {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("revision %d is later than the current revision %d", revision, current))
+		}
+	}
+
+	log.Debugf("GetAetherRocAPIDiff %s %d..%d by %s", target, from, to, requester(ctx))
+	diff := diffConfigs(configAtRevision(transactions, target, from), configAtRevision(transactions, target, to))
+	i.redactor().diff(diff)
+	diff.Target = target
+	diff.From = int64(from)
+	diff.To = int64(to)
+	return ctx.JSON(http.StatusOK, diff)
+}
+
+// revisionParam - a required revision query parameter
+func revisionParam(ctx echo.Context, name string) (configapi.Index, error) {
+	param := ctx.QueryParam(name)
+	if param == "" {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s revision is missing", name))
+	}
+	revision, err := strconv.ParseUint(param, 10, 63)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s revision %s is not valid %v", name, param, err))
+	}
+	return configapi.Index(revision), nil
+}
+
+// configAtRevision - the value of each path of the target after the transactions up to and including
+// revision. Only committed transactions count, and a rollback undoes the transaction it names, which
+// onos-config only allows for the latest, so it is as if that transaction had never been made
+func configAtRevision(transactions []*configapi.Transaction, target string, revision configapi.Index) map[string]string {
+	committed := make([]*configapi.Transaction, 0, len(transactions))
+	rolledBack := make(map[configapi.Index]bool)
+	for _, t := range transactions {
+		if t.Index > revision || (t.Status.State != configapi.TransactionStatus_COMMITTED &&
+			t.Status.State != configapi.TransactionStatus_APPLIED) {
+			continue
+		}
+		if rollback := t.GetRollback(); rollback != nil {
+			rolledBack[rollback.RollbackIndex] = true
+			continue
+		}
+		committed = append(committed, t)
+	}
+	sort.Slice(committed, func(a, b int) bool {
+		return committed[a].Index < committed[b].Index
+	})
+
+	config := make(map[string]string)
+	for _, t := range committed {
+		if rolledBack[t.Index] || t.GetChange() == nil {
+			continue
+		}
+		pathValues, ok := t.GetChange().Values[configapi.TargetID(target)]
+		if !ok {
+			continue
+		}
+		// Deletes first, so that a path deleted and set again in the same transaction is kept
+		for _, pathValue := range pathValues.GetValues() {
+			if pathValue.Deleted {
+				for path := range config {
+					if pathIsUnder(path, pathValue.Path) {
+						delete(config, path)
+					}
+				}
+			}
+		}
+		for _, pathValue := range pathValues.GetValues() {
+			if !pathValue.Deleted {
+				config[pathValue.Path] = pathValue.Value.ValueToString()
+			}
+		}
+	}
+	return config
+}
+
+// pathIsUnder - whether path is parent or a path beneath it, either an element or a list entry
+func pathIsUnder(path string, parent string) bool {
+	parent = strings.TrimSuffix(parent, "/")
+	return path == parent || strings.HasPrefix(path, parent+"/") || strings.HasPrefix(path, parent+"[") || parent == ""
+}
+
+// diffConfigs - the paths added, removed and modified going from one configuration to another, in path order
+func diffConfigs(from map[string]string, to map[string]string) *externalRef0.ConfigDiff {
+	diff := &externalRef0.ConfigDiff{
+		Added:    make([]externalRef0.ChangeValue, 0),
+		Removed:  make([]externalRef0.ChangeValue, 0),
+		Modified: make([]externalRef0.ChangeValue, 0),
+	}
+	for path, value := range to {
+		value := value
+		fromValue, ok := from[path]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, externalRef0.ChangeValue{Path: path, Value: &value})
+		case fromValue != value:
+			diff.Modified = append(diff.Modified, externalRef0.ChangeValue{Path: path, Value: &value})
+		}
+	}
+	removed := true
+	for path, value := range from {
+		value := value
+		if _, ok := to[path]; !ok {
+			diff.Removed = append(diff.Removed, externalRef0.ChangeValue{Path: path, Value: &value, Removed: &removed})
+		}
+	}
+	for _, changes := range [][]externalRef0.ChangeValue{diff.Added, diff.Removed, diff.Modified} {
+		sort.Slice(changes, func(a, b int) bool {
+			return changes[a].Path < changes[b].Path
+		})
+	}
+	return diff
+}
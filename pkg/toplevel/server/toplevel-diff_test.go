@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func diffTestChange(index configapi.Index, state configapi.TransactionStatus_State, target string, values ...configapi.PathValue) *configapi.Transaction {
+	pathValues := make(map[string]*configapi.PathValue)
+	for i := range values {
+		pathValues[values[i].Path] = &values[i]
+	}
+	return &configapi.Transaction{
+		ID:    configapi.TransactionID(fmt.Sprintf("tx%d", index)),
+		Index: index,
+		Details: &configapi.Transaction_Change{Change: &configapi.ChangeTransaction{
+			Values: map[configapi.TargetID]*configapi.PathValues{configapi.TargetID(target): {Values: pathValues}},
+		}},
+		Status: configapi.TransactionStatus{State: state},
+	}
+}
+
+func diffTestValue(path string, value string) configapi.PathValue {
+	return configapi.PathValue{Path: path, Value: *configapi.NewTypedValueString(value)}
+}
+
+func diffTestTransactions() []*configapi.Transaction {
+	return []*configapi.Transaction{
+		diffTestChange(1, configapi.TransactionStatus_APPLIED, "t1",
+			diffTestValue("/site/site[id=s1]/display-name", "Site 1"),
+			diffTestValue("/site/site[id=s2]/display-name", "Site 2")),
+		diffTestChange(2, configapi.TransactionStatus_APPLIED, "t2",
+			diffTestValue("/site/site[id=s9]/display-name", "Other target")),
+		diffTestChange(3, configapi.TransactionStatus_APPLIED, "t1",
+			diffTestValue("/site/site[id=s1]/display-name", "Site One"),
+			configapi.PathValue{Path: "/site/site[id=s2]", Deleted: true},
+			diffTestValue("/site/site[id=s3]/display-name", "Site 3")),
+		diffTestChange(4, configapi.TransactionStatus_FAILED, "t1",
+			diffTestValue("/site/site[id=s4]/display-name", "Never made")),
+		diffTestChange(5, configapi.TransactionStatus_APPLIED, "t1",
+			diffTestValue("/site/site[id=s5]/display-name", "Rolled back")),
+		{
+			ID:      "tx6",
+			Index:   6,
+			Details: &configapi.Transaction_Rollback{Rollback: &configapi.RollbackTransaction{RollbackIndex: 5}},
+			Status:  configapi.TransactionStatus{State: configapi.TransactionStatus_APPLIED},
+		},
+	}
+}
+
+func Test_configAtRevision(t *testing.T) {
+	transactions := diffTestTransactions()
+	assert.Empty(t, configAtRevision(transactions, "t1", 0))
+	assert.Equal(t, map[string]string{
+		"/site/site[id=s1]/display-name": "Site 1",
+		"/site/site[id=s2]/display-name": "Site 2",
+	}, configAtRevision(transactions, "t1", 2))
+	assert.Equal(t, map[string]string{
+		"/site/site[id=s1]/display-name": "Site One",
+		"/site/site[id=s3]/display-name": "Site 3",
+		"/site/site[id=s5]/display-name": "Rolled back",
+	}, configAtRevision(transactions, "t1", 5))
+	assert.Equal(t, map[string]string{
+		"/site/site[id=s1]/display-name": "Site One",
+		"/site/site[id=s3]/display-name": "Site 3",
+	}, configAtRevision(transactions, "t1", 6))
+}
+
+func Test_GetAetherRocAPIDiff(t *testing.T) {
+	server := &TopLevelServer{
+		GnmiTimeout:  time.Minute,
+		ConfigClient: &fakeTransactionClient{transactions: diffTestTransactions()},
+	}
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/aether-roc-api/diff?target=t1&from=1&to=6", nil)
+	assert.NoError(t, server.GetAetherRocAPIDiff(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var diff externalRef0.ConfigDiff
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &diff))
+	assert.Equal(t, "t1", diff.Target)
+	assert.Equal(t, int64(1), diff.From)
+	assert.Equal(t, int64(6), diff.To)
+	if assert.Len(t, diff.Added, 1) {
+		assert.Equal(t, "/site/site[id=s3]/display-name", diff.Added[0].Path)
+		assert.Equal(t, "Site 3", *diff.Added[0].Value)
+	}
+	if assert.Len(t, diff.Removed, 1) {
+		assert.Equal(t, "/site/site[id=s2]/display-name", diff.Removed[0].Path)
+		assert.Equal(t, "Site 2", *diff.Removed[0].Value)
+		assert.True(t, *diff.Removed[0].Removed)
+	}
+	if assert.Len(t, diff.Modified, 1) {
+		assert.Equal(t, "/site/site[id=s1]/display-name", diff.Modified[0].Path)
+		assert.Equal(t, "Site One", *diff.Modified[0].Value)
+	}
+}
+
+func Test_GetAetherRocAPIDiff_badRequest(t *testing.T) {
+	server := &TopLevelServer{
+		GnmiTimeout:  time.Minute,
+		ConfigClient: &fakeTransactionClient{transactions: diffTestTransactions()},
+	}
+	for _, query := range []string{
+		"from=1&to=2",
+		"target=t1&to=2",
+		"target=t1&from=1",
+		"target=t1&from=one&to=2",
+		"target=t1&from=-1&to=2",
+		"target=t1&from=1&to=7",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/aether-roc-api/diff?"+query, nil)
+		err := server.GetAetherRocAPIDiff(echo.New().NewContext(req, httptest.NewRecorder()))
+		httpErr, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok, query) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code, query)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+// mimeEnvelope - asks for the lists as a Page rather than a bare array
+const mimeEnvelope = "application/vnd.roc.v2+json"
+
+// wantsEnvelope - whether the list is to be sent as a Page, by envelope=true or by accepting
+// mimeEnvelope. The bare list stays the default, for the clients from before the Page
+func wantsEnvelope(ctx echo.Context) (bool, error) {
+	if envelopeParam := ctx.QueryParam("envelope"); envelopeParam != "" {
+		envelope, err := strconv.ParseBool(envelopeParam)
+		if err != nil {
+			return false, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("envelope must be true or false. Got %s", envelopeParam))
+		}
+		if envelope {
+			return true, nil
+		}
+	}
+	for _, accepted := range parseAccept(ctx.Request().Header.Get(echo.HeaderAccept)) {
+		if accepted.mediaType == mimeEnvelope {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sendPage - send items, the slice (or pointer to it) of total items from offset, as a Page. It has
+// the media type that was accepted, so clients that asked for mimeEnvelope know they got it
+func sendPage(ctx echo.Context, items interface{}, total int, limit int, offset int) error {
+	page := externalRef0.Page{
+		Items:  make([]interface{}, 0),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	itemsValue := reflect.Indirect(reflect.ValueOf(items))
+	for idx := 0; itemsValue.Kind() == reflect.Slice && idx < itemsValue.Len(); idx++ {
+		page.Items = append(page.Items, itemsValue.Index(idx).Interface())
+	}
+	for _, accepted := range parseAccept(ctx.Request().Header.Get(echo.HeaderAccept)) {
+		if accepted.mediaType == mimeEnvelope {
+			ctx.Response().Header().Set(echo.HeaderContentType, mimeEnvelope)
+			break
+		}
+	}
+	return ctx.JSON(http.StatusOK, page)
+}
+
+// pageBounds - the start and end in a list of length items of the page from offset. A limit of
+// 0 means the rest of the list
+func pageBounds(length int, limit int, offset int) (int, int) {
+	if offset >= length {
+		return length, length
+	}
+	end := length
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return offset, end
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_wantsEnvelope(t *testing.T) {
+	for name, tc := range map[string]struct {
+		query    string
+		accept   string
+		envelope bool
+		invalid  bool
+	}{
+		"default":    {},
+		"param":      {query: "?envelope=true", envelope: true},
+		"param off":  {query: "?envelope=false"},
+		"accept":     {accept: "application/json;q=0.5, " + mimeEnvelope, envelope: true},
+		"not q=0":    {accept: mimeEnvelope + ";q=0"},
+		"bad param":  {query: "?envelope=yes", invalid: true},
+		"plain json": {accept: mimeJSON},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/targets"+tc.query, nil)
+		req.Header.Set(echo.HeaderAccept, tc.accept)
+		envelope, err := wantsEnvelope(echo.New().NewContext(req, httptest.NewRecorder()))
+		assert.Equal(t, tc.invalid, err != nil, name)
+		assert.Equal(t, tc.envelope, envelope, name)
+	}
+}
+
+func Test_GetTargets_envelope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&gnmi.GetResponse{
+		Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+			Path: &gnmi.Path{}, Val: targetsLeafList("t1", "t2", "t3"),
+		}}}},
+	}, nil).AnyTimes()
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	req := httptest.NewRequest(http.MethodGet, "/targets?limit=2&offset=1", nil)
+	req.Header.Set(echo.HeaderAccept, mimeEnvelope)
+	rec := httptest.NewRecorder()
+	assert.NoError(t, server.GetTargets(echo.New().NewContext(req, rec)))
+	assert.Equal(t, mimeEnvelope, rec.Header().Get(echo.HeaderContentType))
+	var page externalRef0.Page
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, 2, page.Limit)
+	assert.Equal(t, 1, page.Offset)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, "t2", page.Items[0].(map[string]interface{})["name"])
+
+	// The bare list is still the default
+	rec = httptest.NewRecorder()
+	assert.NoError(t, server.GetTargets(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets", nil), rec)))
+	var targets externalRef0.TargetsNames
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &targets))
+	assert.Len(t, targets, 3)
+}
+
+func Test_GetTransactions_envelope(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"),
+			changeTransaction("tx-2", 2, "/a"), changeTransaction("tx-3", 3, "/a")},
+	}}
+	getPage := func(query string) (*httptest.ResponseRecorder, externalRef0.Page) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		assert.NoError(t, server.GetTransactions(echo.New().NewContext(req, rec)))
+		var page externalRef0.Page
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		return rec, page
+	}
+
+	rec, page := getPage("envelope=true&limit=1&offset=1")
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, 1, page.Limit)
+	assert.Equal(t, 1, page.Offset)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "tx-2", page.Items[0].(map[string]interface{})["id"])
+	assert.NotEmpty(t, rec.Header().Get(headerNextCursor))
+
+	// The last page has no cursor
+	rec, page = getPage("envelope=true&limit=2&offset=1&fields=id")
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, map[string]interface{}{"id": "tx-3"}, page.Items[1])
+	assert.Empty(t, rec.Header().Get(headerNextCursor))
+
+	rec, page = getPage("envelope=true")
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, 0, page.Limit)
+	assert.Len(t, page.Items, 3)
+
+	// An offset alone keeps the bare list
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/transactions?offset=2", nil)
+	assert.NoError(t, server.GetTransactions(echo.New().NewContext(req, rec)))
+	var transactions externalRef0.TransactionList
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &transactions))
+	assert.Len(t, transactions, 1)
+	assert.Equal(t, "tx-3", transactions[0].Id)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// expectedValue - a leaf, with its target, and the value it must have for a patch to be made
+type expectedValue struct {
+	path  *gnmi.Path
+	value interface{}
+}
+
+// expectedValues - the gNMI paths of the expected values of a PatchBody, with the prefix of the body and,
+// when prefixed, the PathPrefix before them as the updates have. A leaf with no target is of the
+// default-target
+func (i *TopLevelServer) expectedValues(jsonObj *types.PatchBody, prefix *gnmi.Path, prefixed bool) ([]expectedValue, error) {
+	if jsonObj.Expected == nil {
+		return nil, nil
+	}
+	expected := make([]expectedValue, 0, len(*jsonObj.Expected))
+	for _, ev := range *jsonObj.Expected {
+		gnmiPath, err := ygot.StringToStructuredPath(ev.Path)
+		if err == nil && !validBatchPath(gnmiPath) {
+			err = fmt.Errorf("empty element")
+		}
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid expected path %s %v", ev.Path, err))
+		}
+		gnmiPath.Target = jsonObj.DefaultTarget
+		if ev.Target != nil && *ev.Target != "" {
+			gnmiPath.Target = *ev.Target
+		}
+		paths, err := withBodyPrefix(prefix, gnmiPath)
+		if err != nil {
+			return nil, err
+		}
+		if prefixed {
+			if paths, err = i.withPathPrefix(paths...); err != nil {
+				return nil, err
+			}
+		}
+		expected = append(expected, expectedValue{path: paths[0], value: ev.Value})
+	}
+	return expected, nil
+}
+
+// checkExpectedValues reads the current value of each leaf, and gives 412 for the first that does not
+// have its expected value. A leaf that is not set has the value null. The values are read just before
+// the Set, but not in the same transaction, so a change made in between is not seen
+func (i *TopLevelServer) checkExpectedValues(ctx context.Context, expected []expectedValue) error {
+	for _, ev := range expected {
+		gnmiGet := &gnmi.GetRequest{
+			Encoding: gnmi.Encoding_PROTO,
+			Path:     []*gnmi.Path{ev.path},
+		}
+		log.Debugf("gnmiGetRequest %s", gnmiGet.String())
+		gnmiVal, err := utils.GetResponseUpdate(i.gnmiGet(ctx, gnmiGet))
+		if status.Code(err) == codes.NotFound {
+			gnmiVal, err = nil, nil
+		}
+		if err != nil {
+			return err
+		}
+		current, err := decodeTypedValue(gnmiVal)
+		if err != nil {
+			return err
+		}
+		if comparableValue(current) != comparableValue(ev.value) {
+			pathStr, _ := ygot.PathToString(&gnmi.Path{Elem: i.withoutPathPrefix(ev.path.GetElem())})
+			return echo.NewHTTPError(http.StatusPreconditionFailed,
+				fmt.Sprintf("%s of %s is %s, not %s as expected", pathStr, ev.path.GetTarget(),
+					comparableValue(current), comparableValue(ev.value)))
+		}
+	}
+	return nil
+}
+
+// comparableValue - a value from gNMI or JSON as it is compared. A number from JSON, which is a float,
+// is printed as the gNMI integer would be, and lists element by element
+func comparableValue(v interface{}) string {
+	switch typed := v.(type) {
+	case nil:
+		return "null"
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32)
+	case []interface{}:
+		elems := make([]string, 0, len(typed))
+		for _, elem := range typed {
+			elems = append(elems, comparableValue(elem))
+		}
+		return "[" + strings.Join(elems, ",") + "]"
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_PatchAetherRocAPI_expected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			path := request.Path[0]
+			assert.Equal(t, "connectivity-service-v4", path.Target)
+			assert.Equal(t, "config", path.Elem[0].Name)
+			switch path.Elem[len(path.Elem)-1].Name {
+			case "display-name":
+				return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+					Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "Site 1"}},
+				}}}}}, nil
+			case "imsi-definition":
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 1000000}},
+			}}}}}, nil
+		},
+	).AnyTimes()
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(&gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+		Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id: 100, Msg: []byte("tx-12"),
+		}},
+	}}}, nil).Times(1)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true,
+		PathPrefix: "/config"}
+
+	patch := func(expected string) error {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+			`{"default-target":"connectivity-service-v4","expected":`+expected+`,
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 2","enterprise":"e1"}]}}}`))
+		return server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	}
+
+	// A leaf expected to have another value stops the patch, without a Set
+	err := patch(`[{"path":"/site/site[id=s1]/display-name","value":"Site 0"}]`)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+	assert.ErrorContains(t, err, "/site/site[id=s1]/display-name of connectivity-service-v4 is Site 1, not Site 0 as expected")
+
+	// Every expected value matches, including a leaf that is not set and a number from JSON
+	assert.NilError(t, patch(`[{"path":"/site/site[id=s1]/display-name","value":"Site 1"},
+{"path":"/site/site[id=s1]/imsi-definition","value":null},
+{"path":"/site/site[id=s1]/monitoring/edge-monitoring-prometheus-url","target":"connectivity-service-v4","value":1000000}]`))
+}
+
+func Test_comparableValue(t *testing.T) {
+	assert.Equal(t, "null", comparableValue(nil))
+	assert.Equal(t, "1000000", comparableValue(float64(1000000)))
+	assert.Equal(t, comparableValue(uint64(1000000)), comparableValue(float64(1000000)))
+	assert.Equal(t, "1.5", comparableValue(1.5))
+	assert.Equal(t, "true", comparableValue(true))
+	assert.Equal(t, "[a,2]", comparableValue([]interface{}{"a", float64(2)}))
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ghodss/yaml"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"mime"
+	"net/http"
+)
+
+// exportTypes - the types an export can be sent as, with the extension of its file name. */* gets YAML
+var exportTypes = map[string]string{
+	mimeJSON: mimeJSON,
+	mimeYAML: mimeYAML,
+	mimeAny:  mimeYAML,
+}
+
+var exportExtensions = map[string]string{
+	mimeJSON: "json",
+	mimeYAML: "yaml",
+}
+
+// negotiateExportType - the highest weighted type in the Accept header that an export can be sent as.
+// YAML when there is no Accept header. Empty if there is no match
+func negotiateExportType(header string) string {
+	if header == "" {
+		return mimeYAML
+	}
+	for _, accepted := range parseAccept(header) {
+		if exportType, ok := exportTypes[accepted.mediaType]; ok {
+			return exportType
+		}
+	}
+	return ""
+}
+
+// GetTargetExport - the full configuration of a target as a file to download, in YAML or JSON by the
+// Accept header
+func (i *TopLevelServer) GetTargetExport(ctx echo.Context, target string) error {
+	exportType := negotiateExportType(ctx.Request().Header.Get(echo.HeaderAccept))
+	if exportType == "" {
+		return echo.NewHTTPError(http.StatusNotAcceptable,
+			fmt.Sprintf("only application/yaml and application/json are supported. No match for %s",
+				ctx.Request().Header.Get(echo.HeaderAccept)))
+	}
+
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	config, err := i.gnmiGetTargetConfig(gnmiCtx, target)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	if fields, ok := config.(map[string]interface{}); config == nil || (ok && len(fields) == 0) {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("target %s has no configuration", target))
+	}
+	config = i.redactor().tree(config, nil)
+
+	body, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	if exportType == mimeYAML {
+		if body, err = yaml.JSONToYAML(body); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+	log.Debugf("GetTargetExport %s as %s by %s", target, exportType, requester(ctx))
+
+	// Quoted or encoded as the target needs, so a space or quote in it cannot break the header
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment",
+		map[string]string{"filename": target + "." + exportExtensions[exportType]}))
+	return ctx.Blob(http.StatusOK, exportType, body)
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_GetTargetExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			config := `{"site-4.0.0:site":{"site":[{"id":"s1","display-name":"Site 1"}]}}`
+			if request.Path[0].Target == "empty" {
+				config = `{}`
+			}
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(config)}},
+			}}}}}, nil
+		}).AnyTimes()
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}))
+	export := func(target string, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/targets/"+target+"/export", nil)
+		if accept != "" {
+			req.Header.Set(echo.HeaderAccept, accept)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := export("connectivity-service-v4", "")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, mimeYAML, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "attachment; filename=connectivity-service-v4.yaml", rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Contains(t, rec.Body.String(), "display-name: Site 1")
+
+	rec = export("connectivity-service-v4", "application/json")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, mimeJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "attachment; filename=connectivity-service-v4.json", rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Contains(t, rec.Body.String(), `"display-name": "Site 1"`)
+
+	// A target that is not a plain token is quoted
+	rec = export("site%201", "")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, `attachment; filename="site 1.yaml"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+	rec = export("connectivity-service-v4", "text/html")
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+
+	rec = export("empty", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"sync"
+	"time"
+)
+
+// fetchCache - the last result of a fetch from onos-config, kept for a TTL. While it is being fetched,
+// other requests wait for that fetch rather than each making their own. The fetch is not tied to the
+// request that started it, so that request being cancelled or timing out does not fail the others
+type fetchCache struct {
+	mu       sync.Mutex
+	value    interface{}
+	cached   bool
+	expires  time.Time
+	inflight *cacheFetch
+	now      func() time.Time
+}
+
+// cacheFetch - a fetch that requests can wait on
+type cacheFetch struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// get - the cached value, unless it has expired or refresh is set, when it is fetched again. The fetch
+// keeps the values of ctx e.g. its span and gNMI metadata, but has a timeout of its own instead of the
+// cancellation of ctx. Each request stops waiting when its own ctx is done
+func (c *fetchCache) get(ctx context.Context, ttl time.Duration, timeout time.Duration, refresh bool,
+	fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if c.now == nil {
+		c.now = time.Now
+	}
+	if !refresh && c.cached && c.now().Before(c.expires) {
+		value := c.value
+		c.mu.Unlock()
+		return value, nil
+	}
+	call := c.inflight
+	if call == nil {
+		call = &cacheFetch{done: make(chan struct{})}
+		c.inflight = call
+		go c.fetch(utils.DetachedContext(ctx), ttl, timeout, call, fetch)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *fetchCache) fetch(ctx context.Context, ttl time.Duration, timeout time.Duration, call *cacheFetch,
+	fetch func(context.Context) (interface{}, error)) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	call.value, call.err = fetch(ctx)
+	c.mu.Lock()
+	if call.err == nil {
+		c.value = call.value
+		c.cached = true
+		c.expires = c.now().Add(ttl)
+	}
+	c.inflight = nil
+	c.mu.Unlock()
+	close(call.done)
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fetchCacheKey struct{}
+
+func Test_fetchCache_firstCallerCancelled(t *testing.T) {
+	cache := &fetchCache{}
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		// The request's values are kept, but not its cancellation
+		assert.Equal(t, "request", ctx.Value(fetchCacheKey{}))
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return "targets", nil
+	}
+
+	first, cancelFirst := context.WithCancel(context.WithValue(context.Background(), fetchCacheKey{}, "request"))
+	firstErr := make(chan error)
+	go func() {
+		_, err := cache.get(first, time.Minute, time.Minute, false, fetch)
+		firstErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	waiter := make(chan interface{})
+	go func() {
+		value, err := cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+		assert.NoError(t, err)
+		waiter <- value
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancelFirst()
+	assert.Equal(t, context.Canceled, <-firstErr)
+	close(release)
+	assert.Equal(t, "targets", <-waiter)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	value, err := cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "targets", value)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+func Test_fetchCache_timeout(t *testing.T) {
+	cache := &fetchCache{}
+	_, err := cache.get(context.Background(), time.Minute, 10*time.Millisecond, false, func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"strings"
+)
+
+// projectTransactions - the transactions with only the fields named in the fields query parameter,
+// e.g. "id,status,created". The fields of meta may be named on their own. Unknown names are ignored
+func projectTransactions(transactions externalRef0.TransactionList, fields string) []map[string]interface{} {
+	names := utils.ParseFieldMask(fields)
+	projected := make([]map[string]interface{}, 0, len(transactions))
+	for idx := range transactions {
+		projected = append(projected, projectTransaction(&transactions[idx], names))
+	}
+	return projected
+}
+
+// projectTransaction - the named fields of a transaction, keyed as they are in its JSON
+func projectTransaction(t *externalRef0.Transaction, names []string) map[string]interface{} {
+	out := make(map[string]interface{})
+	meta := make(map[string]interface{})
+	set := func(m map[string]interface{}, key string, isNil bool, value interface{}) {
+		if !isNil {
+			m[key] = value
+		}
+	}
+	for _, name := range names {
+		switch strings.ToLower(name) {
+		case "id":
+			out["id"] = t.Id
+		case "index":
+			out["index"] = t.Index
+		case "details":
+			set(out, "details", t.Details == nil, t.Details)
+		case "links":
+			set(out, "links", t.Links == nil, t.Links)
+		case "status":
+			set(out, "status", t.Status == nil, t.Status)
+		case "strategy":
+			set(out, "strategy", t.Strategy == nil, t.Strategy)
+		case "username":
+			set(out, "username", t.Username == nil, t.Username)
+		case "requestid":
+			set(out, "requestId", t.RequestId == nil, t.RequestId)
+		case "meta":
+			out["meta"] = t.Meta
+		case "created":
+			set(meta, "created", t.Meta.Created == nil, t.Meta.Created)
+		case "updated":
+			set(meta, "updated", t.Meta.Updated == nil, t.Meta.Updated)
+		case "deleted":
+			set(meta, "deleted", t.Meta.Deleted == nil, t.Meta.Deleted)
+		case "key":
+			set(meta, "key", t.Meta.Key == nil, t.Meta.Key)
+		case "revision":
+			set(meta, "revision", t.Meta.Revision == nil, t.Meta.Revision)
+		case "version":
+			set(meta, "version", t.Meta.Version == nil, t.Meta.Version)
+		}
+	}
+	if _, whole := out["meta"]; !whole && len(meta) > 0 {
+		out["meta"] = meta
+	}
+	return out
+}
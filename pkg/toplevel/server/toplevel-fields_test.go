@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_projectTransactions(t *testing.T) {
+	created := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	username := "user1"
+	requestID := "req-1"
+	transaction := externalRef0.Transaction{
+		Id:        "tx-1",
+		Index:     1,
+		Username:  &username,
+		RequestId: &requestID,
+	}
+	transaction.Meta.Created = &created
+
+	projected := projectTransactions(externalRef0.TransactionList{transaction}, "id,created,nosuchfield")
+	asJSON, err := json.Marshal(projected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"tx-1","meta":{"created":"2022-03-04T05:06:07Z"}}]`, string(asJSON))
+
+	projected = projectTransactions(externalRef0.TransactionList{transaction}, "Index,username,status")
+	asJSON, err = json.Marshal(projected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"index":1,"username":"user1"}]`, string(asJSON))
+
+	projected = projectTransactions(externalRef0.TransactionList{transaction}, "id,requestId")
+	asJSON, err = json.Marshal(projected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"tx-1","requestId":"req-1"}]`, string(asJSON))
+
+	projected = projectTransactions(externalRef0.TransactionList{transaction}, "nosuchfield")
+	asJSON, err = json.Marshal(projected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{}]`, string(asJSON))
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The phases of a transaction, in the order they happen
+const (
+	phaseInitialize = "INITIALIZE"
+	phaseValidate   = "VALIDATE"
+	phaseCommit     = "COMMIT"
+	phaseApply      = "APPLY"
+	phaseAbort      = "ABORT"
+)
+
+// transactionFilter - restricts the transactions listed. Empty fields match everything
+type transactionFilter struct {
+	target string
+	phase  string
+	state  *configapi.TransactionStatus_State
+	// failed - only the transactions that failed, by their state or that of a phase
+	failed bool
+	// since - only transactions created at or after this time
+	since *time.Time
+	// limit - the most transactions to collect. The listing stops once there are this many. 0 is unlimited
+	limit int
+	// after - only transactions with an index after this one, as given by a cursor
+	after configapi.Index
+}
+
+// newTransactionFilter - a filter from the target, phase and state query parameters
+func newTransactionFilter(target string, phase string, state string) (*transactionFilter, error) {
+	filter := &transactionFilter{target: target}
+	if phase != "" {
+		switch strings.ToUpper(phase) {
+		case phaseInitialize, phaseValidate, phaseCommit, phaseApply, phaseAbort:
+			filter.phase = strings.ToUpper(phase)
+		default:
+			return nil, fmt.Errorf("unknown phase %s. Expected one of %s, %s, %s, %s, %s", phase,
+				phaseInitialize, phaseValidate, phaseCommit, phaseApply, phaseAbort)
+		}
+	}
+	if state != "" {
+		value, ok := configapi.TransactionStatus_State_value[strings.ToUpper(state)]
+		if !ok {
+			return nil, fmt.Errorf("unknown state %s", state)
+		}
+		s := configapi.TransactionStatus_State(value)
+		filter.state = &s
+	}
+	return filter, nil
+}
+
+// setBounds - the limit and since query parameters. since is an RFC 3339 time e.g. 2022-03-01T12:00:00Z
+func (f *transactionFilter) setBounds(limit string, since string) error {
+	if limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 1 {
+			return fmt.Errorf("limit %s is not a positive integer", limit)
+		}
+		f.limit = value
+	}
+	if since != "" {
+		value, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("since %s is not an RFC 3339 time %v", since, err)
+		}
+		f.since = &value
+	}
+	return nil
+}
+
+// setFailed - the failed query parameter
+func (f *transactionFilter) setFailed(failed string) error {
+	if failed == "" {
+		return nil
+	}
+	value, err := strconv.ParseBool(failed)
+	if err != nil {
+		return fmt.Errorf("failed must be true or false. Got %s", failed)
+	}
+	f.failed = value
+	return nil
+}
+
+// setCursor - resume the listing after the last transaction of the page that gave the cursor
+func (f *transactionFilter) setCursor(cursor string) error {
+	if cursor == "" {
+		return nil
+	}
+	index, err := decodeTransactionsCursor(cursor)
+	if err != nil {
+		return err
+	}
+	f.after = index
+	return nil
+}
+
+// transactionsCursor - an opaque cursor for the transactions after index. It carries a checksum so
+// that one that has been changed is rejected
+func transactionsCursor(index configapi.Index) string {
+	payload := strconv.FormatUint(uint64(index), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload + "." + cursorChecksum(payload)))
+}
+
+// decodeTransactionsCursor - the index a cursor from transactionsCursor resumes after
+func decodeTransactionsCursor(cursor string) (configapi.Index, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("cursor %s is not valid", cursor)
+	}
+	parts := strings.Split(string(decoded), ".")
+	if len(parts) != 2 || parts[1] != cursorChecksum(parts[0]) {
+		return 0, fmt.Errorf("cursor %s is not valid", cursor)
+	}
+	index, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cursor %s is not valid", cursor)
+	}
+	return configapi.Index(index), nil
+}
+
+func cursorChecksum(payload string) string {
+	sum := sha256.Sum256([]byte("transactions-cursor:" + payload))
+	return hex.EncodeToString(sum[:8])
+}
+
+// full - whether count transactions are as many as the limit
+func (f *transactionFilter) full(count int) bool {
+	return f != nil && f.limit > 0 && count >= f.limit
+}
+
+func (f *transactionFilter) matches(t *configapi.Transaction) bool {
+	if f == nil {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+	if f.target != "" {
+		if t.GetChange() == nil {
+			return false
+		}
+		if _, ok := t.GetChange().Values[configapi.TargetID(f.target)]; !ok {
+			return false
+		}
+	}
+	if f.phase != "" && currentPhase(t) != f.phase {
+		return false
+	}
+	if f.state != nil && t.Status.State != *f.state {
+		return false
+	}
+	if f.failed && !transactionFailed(t) {
+		return false
+	}
+	if f.since != nil && t.Created.Before(*f.since) {
+		return false
+	}
+	if f.after > 0 && t.Index <= f.after {
+		return false
+	}
+	return true
+}
+
+// transactionFailed - whether the transaction's state is FAILED, or one of its phases failed on the
+// way there e.g. an apply that failed on the target
+func transactionFailed(t *configapi.Transaction) bool {
+	phases := t.Status.Phases
+	return t.Status.State == configapi.TransactionStatus_FAILED ||
+		(phases.Initialize != nil && phases.Initialize.State == configapi.TransactionInitializePhase_FAILED) ||
+		(phases.Validate != nil && phases.Validate.State == configapi.TransactionValidatePhase_FAILED) ||
+		(phases.Apply != nil && phases.Apply.State == configapi.TransactionApplyPhase_FAILED)
+}
+
+// currentPhase - the latest phase the transaction has reached
+func currentPhase(t *configapi.Transaction) string {
+	phases := t.Status.Phases
+	switch {
+	case phases.Abort != nil:
+		return phaseAbort
+	case phases.Apply != nil:
+		return phaseApply
+	case phases.Commit != nil:
+		return phaseCommit
+	case phases.Validate != nil:
+		return phaseValidate
+	case phases.Initialize != nil:
+		return phaseInitialize
+	}
+	return ""
+}
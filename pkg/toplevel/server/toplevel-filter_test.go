@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/base64"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_transactionFilter(t *testing.T) {
+	applied := changeTransaction("tx-1", 1, "/a")
+	applied.Status.State = configapi.TransactionStatus_APPLIED
+	applied.Status.Phases.Initialize = &configapi.TransactionInitializePhase{}
+	applied.Status.Phases.Apply = &configapi.TransactionApplyPhase{}
+	pending := changeTransaction("tx-2", 2, "/a")
+	pending.Status.Phases.Initialize = &configapi.TransactionInitializePhase{}
+
+	filter, err := newTransactionFilter("connectivity-service-v4", "apply", "APPLIED")
+	assert.NoError(t, err)
+	assert.True(t, filter.matches(applied))
+	assert.False(t, filter.matches(pending))
+
+	filter, err = newTransactionFilter("", "INITIALIZE", "")
+	assert.NoError(t, err)
+	assert.False(t, filter.matches(applied))
+	assert.True(t, filter.matches(pending))
+
+	filter, err = newTransactionFilter("other-target", "", "")
+	assert.NoError(t, err)
+	assert.False(t, filter.matches(applied))
+
+	_, err = newTransactionFilter("", "DEPLOY", "")
+	assert.EqualError(t, err, "unknown phase DEPLOY. Expected one of INITIALIZE, VALIDATE, COMMIT, APPLY, ABORT")
+	_, err = newTransactionFilter("", "", "DONE")
+	assert.EqualError(t, err, "unknown state DONE")
+
+	var noFilter *transactionFilter
+	assert.True(t, noFilter.matches(pending))
+}
+
+func Test_transactionFilter_failed(t *testing.T) {
+	applied := changeTransaction("tx-1", 1, "/a")
+	applied.Status.State = configapi.TransactionStatus_APPLIED
+	failed := changeTransaction("tx-2", 2, "/a")
+	failed.Status.State = configapi.TransactionStatus_FAILED
+	applyFailed := changeTransaction("tx-3", 3, "/a")
+	applyFailed.Status.Phases.Apply = &configapi.TransactionApplyPhase{State: configapi.TransactionApplyPhase_FAILED}
+
+	filter, err := newTransactionFilter("", "", "")
+	assert.NoError(t, err)
+	assert.NoError(t, filter.setFailed("true"))
+	assert.False(t, filter.matches(applied))
+	assert.True(t, filter.matches(failed))
+	assert.True(t, filter.matches(applyFailed))
+
+	assert.NoError(t, filter.setFailed("false"))
+	assert.True(t, filter.matches(applied))
+	assert.EqualError(t, filter.setFailed("yes please"), "failed must be true or false. Got yes please")
+}
+
+func Test_transactionFilter_bounds(t *testing.T) {
+	early := changeTransaction("tx-1", 1, "/a")
+	early.Created = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := changeTransaction("tx-2", 2, "/a")
+	late.Created = time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	filter, err := newTransactionFilter("", "", "")
+	assert.NoError(t, err)
+	assert.NoError(t, filter.setBounds("2", "2022-02-01T00:00:00Z"))
+	assert.False(t, filter.matches(early))
+	assert.True(t, filter.matches(late))
+	assert.False(t, filter.full(1))
+	assert.True(t, filter.full(2))
+
+	assert.EqualError(t, filter.setBounds("0", ""), "limit 0 is not a positive integer")
+	assert.Error(t, filter.setBounds("", "yesterday"))
+
+	var noFilter *transactionFilter
+	assert.False(t, noFilter.full(1000))
+}
+
+func Test_transactionFilter_cursor(t *testing.T) {
+	first := changeTransaction("tx-1", 1, "/a")
+	second := changeTransaction("tx-2", 2, "/a")
+
+	cursor := transactionsCursor(1)
+	index, err := decodeTransactionsCursor(cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(1), index)
+
+	filter, err := newTransactionFilter("", "", "")
+	assert.NoError(t, err)
+	assert.NoError(t, filter.setCursor(cursor))
+	assert.False(t, filter.matches(first))
+	assert.True(t, filter.matches(second))
+
+	// A cursor for another index, without its checksum
+	tampered := base64.RawURLEncoding.EncodeToString([]byte("0." + strings.Split(mustDecode(t, cursor), ".")[1]))
+	for _, invalid := range []string{tampered, "not base64!", base64.RawURLEncoding.EncodeToString([]byte("1"))} {
+		assert.EqualError(t, filter.setCursor(invalid), "cursor "+invalid+" is not valid")
+	}
+}
+
+func mustDecode(t *testing.T, cursor string) string {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	assert.NoError(t, err)
+	return string(decoded)
+}
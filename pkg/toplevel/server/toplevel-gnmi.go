@@ -10,16 +10,309 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/labstack/echo/v4"
 	"github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
 )
 
 import (
 	"github.com/onosproject/aether-roc-api/pkg/utils"
 )
 
-// gnmiPatchAetherRocAPI patches an existing configuration with PatchBody.
-func (i *TopLevelServer) gnmiPatchAetherRocAPI(ctx context.Context, body []byte, dummy string) (*string, error) {
+// gnmiGet makes the gNMI Get, retrying up to GnmiRetries times if onos-config is briefly unavailable,
+// and once more with a longer timeout if it timed out. All the gNMI Gets, as from gnmiGetTargets, are counted here
+func (i *TopLevelServer) gnmiGet(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	var response *gnmi.GetResponse
+	get := func(ctx context.Context) error {
+		return utils.RetryGnmiCall(ctx, i.GnmiRetries, func() error {
+			getCtx, span := i.startClientSpan(ctx, "gnmi.Get")
+			defer span.End()
+			var err error
+			start := time.Now()
+			response, err = i.GnmiClient.Get(getCtx, request)
+			i.Metrics.ObserveGnmiDuration("Get", time.Since(start))
+			setSpanError(span, err)
+			return err
+		})
+	}
+	err := get(ctx)
+	// A large read may need longer than the timeout. It is tried once more with double the timeout,
+	// up to GnmiTimeoutMax
+	if timeout, ok := utils.GnmiContextTimeout(ctx); ok && utils.IsDeadlineExceeded(err) {
+		extended := 2 * timeout
+		if extended > i.gnmiTimeoutMax() {
+			extended = i.gnmiTimeoutMax()
+		}
+		if extended > timeout {
+			log.Warnf("gNMI Get exceeded its timeout of %v. Retrying once with a timeout of %v", timeout, extended)
+			extendedCtx, cancel := utils.ExtendGnmiContext(ctx, extended)
+			defer cancel()
+			err = get(extendedCtx)
+		}
+	}
+	i.Metrics.ObserveGnmiCall("Get", err)
+	return response, err
+}
+
+// gnmiSubscribeOnce makes a gNMI Subscribe in ONCE mode, retrying as gnmiGet does, and returns its
+// notifications
+func (i *TopLevelServer) gnmiSubscribeOnce(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error) {
+	var notifications []*gnmi.Notification
+	err := utils.RetryGnmiCall(ctx, i.GnmiRetries, func() error {
+		subscribeCtx, span := i.startClientSpan(ctx, "gnmi.Subscribe")
+		defer span.End()
+		var err error
+		start := time.Now()
+		notifications, err = i.GnmiClient.SubscribeOnce(subscribeCtx, request)
+		i.Metrics.ObserveGnmiDuration("Subscribe", time.Since(start))
+		setSpanError(span, err)
+		return err
+	})
+	i.Metrics.ObserveGnmiCall("Subscribe", err)
+	return notifications, err
+}
+
+// gnmiPatchAetherRocAPI patches an existing configuration with PatchBody, and deletes the paths of a
+// JSON Merge Patch. The ID and index (revision) of the resulting transaction are returned
+func (i *TopLevelServer) gnmiPatchAetherRocAPI(ctx context.Context, body []byte, dummy string,
+	deletes ...*gnmi.Path) (*configapi.TransactionInfo, error) {
+	gnmiSet, _, err := i.gnmiPatchSetRequest(ctx, body, deletes...)
+	if err != nil {
+		return nil, err
+	}
+	return i.gnmiSet(ctx, gnmiSet)
+}
+
+// gnmiPatchSetRequest prepares the gNMI Set of a PatchBody and the paths of a JSON Merge Patch to delete,
+// with the targets it changes. The prefix of the body, if it has one, is the prefix of the Set, with the
+// paths relative to it
+func (i *TopLevelServer) gnmiPatchSetRequest(ctx context.Context, body []byte,
+	deletes ...*gnmi.Path) (*gnmi.SetRequest, []configapi.TargetID, error) {
+	patchBody, err := i.preparePatch(ctx, body, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if deletes, err = withBodyPrefix(patchBody.Prefix, deletes...); err != nil {
+		return nil, nil, err
+	}
+	if deletes, err = i.withPathPrefix(deletes...); err != nil {
+		return nil, nil, err
+	}
+	patchBody.Deletes = append(patchBody.Deletes, deletes...)
+	gnmiSet, err := utils.NewGnmiSetRequest(patchBody.Updates, patchBody.Deletes,
+		patchBody.Ext100Name, patchBody.Ext101Version, patchBody.Ext102Type, patchBody.Ext111Strategy)
+	if err != nil {
+		return nil, nil, err
+	}
+	targets := patchSetTargets(gnmiSet, patchBody.DefaultTarget)
+	if patchBody.Prefix != nil {
+		// Sent as the prefix of the Set, with the PathPrefix before it as the paths have
+		prefix, err := i.withPathPrefix(&gnmi.Path{Elem: patchBody.Prefix.GetElem()})
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = relativeToPrefix(gnmiSet, prefix[0].GetElem()); err != nil {
+			return nil, nil, err
+		}
+	}
+	return gnmiSet, targets, nil
+}
+
+// gnmiPutAetherRocAPI replaces everything at path (in gNMI path string form) of target with PatchBody.
+// The Set deletes the path and gives the updates as Replace, so what is under the path but not in
+// the body is removed, unlike with gnmiPatchAetherRocAPI. The body may only have updates under the path
+func (i *TopLevelServer) gnmiPutAetherRocAPI(ctx context.Context, body []byte, target string, path string) (*configapi.TransactionInfo, error) {
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path %s %v", path, err))
+	}
+	patchBody, err := i.preparePatch(ctx, body, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(patchBody.Deletes) > 0 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "a PUT replaces everything at the path, so cannot have deletes")
+	}
+	if target == "" {
+		target = patchBody.DefaultTarget
+	}
+	gnmiPath.Target = target
+	prefixed, err := i.withPathPrefix(gnmiPath)
+	if err != nil {
+		return nil, err
+	}
+	gnmiPath = prefixed[0]
+	for _, u := range patchBody.Updates {
+		updateTarget := u.GetPath().GetTarget()
+		if updateTarget == "" {
+			updateTarget = patchBody.DefaultTarget
+		}
+		if updateTarget != target || !pathElemsUnder(u.GetPath().GetElem(), gnmiPath.GetElem()) {
+			updatePath, _ := ygot.PathToString(u.GetPath())
+			return nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("%s of %s is not under %s of %s", updatePath, updateTarget, path, target))
+		}
+	}
+	gnmiSet, err := utils.NewGnmiSetRequest(nil, []*gnmi.Path{gnmiPath},
+		patchBody.Ext100Name, patchBody.Ext101Version, patchBody.Ext102Type, patchBody.Ext111Strategy)
+	if err != nil {
+		return nil, err
+	}
+	// A Set applies its deletes before its replaces, so the path ends up with only the body
+	gnmiSet.Replace = patchBody.Updates
+	return i.gnmiSet(ctx, gnmiSet)
+}
+
+// pathElemsUnder - whether path is parent, or beneath it
+func pathElemsUnder(path []*gnmi.PathElem, parent []*gnmi.PathElem) bool {
+	if len(path) < len(parent) {
+		return false
+	}
+	for idx, elem := range parent {
+		if path[idx].GetName() != elem.GetName() || len(path[idx].GetKey()) != len(elem.GetKey()) {
+			return false
+		}
+		for key, value := range elem.GetKey() {
+			if path[idx].GetKey()[key] != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// gnmiSet makes the gNMI Set, returning the ID and index of the resulting transaction
+func (i *TopLevelServer) gnmiSet(ctx context.Context, gnmiSet *gnmi.SetRequest) (*configapi.TransactionInfo, error) {
+	gnmiSetResponse, err := i.gnmiSetResponse(ctx, gnmiSet)
+	if err != nil {
+		return nil, err
+	}
+	transactionInfo, err := utils.ExtractTransactionInfo(gnmiSetResponse)
+	if err == nil && transactionInfo.Index > 0 {
+		i.revisions.record(setTargets(gnmiSet), transactionInfo.Index)
+	}
+	return transactionInfo, err
+}
+
+// gnmiSetResponse makes the gNMI Set, returning the whole response. All the gNMI Sets are counted,
+// recorded for the audit, and have their transaction linked to the request ID, here
+func (i *TopLevelServer) gnmiSetResponse(ctx context.Context, gnmiSet *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	log.Debugf("gnmiSetRequest %s", gnmiSet.String())
+	setCtx, span := i.startClientSpan(ctx, "gnmi.Set")
+	start := time.Now()
+	gnmiSetResponse, err := i.GnmiClient.Set(setCtx, gnmiSet)
+	i.Metrics.ObserveGnmiDuration("Set", time.Since(start))
+	setSpanError(span, err)
+	span.End()
+	i.Metrics.ObserveGnmiCall("Set", err)
+	if err != nil {
+		return nil, err
+	}
+	recordAuditChanges(ctx, gnmiSet)
+	if info, err := utils.ExtractTransactionInfo(gnmiSetResponse); err == nil {
+		i.recordTransactionRequest(ctx, info.ID)
+	}
+	return gnmiSetResponse, nil
+}
 
+// gnmiDeleteAetherRocAPI deletes path (in gNMI path string form) and everything under it from target.
+// The ID and index (revision) of the resulting transaction are returned
+func (i *TopLevelServer) gnmiDeleteAetherRocAPI(ctx context.Context, target string, path string) (*configapi.TransactionInfo, error) {
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path %s %v", path, err))
+	}
+	gnmiPath.Target = target
+	prefixed, err := i.withPathPrefix(gnmiPath)
+	if err != nil {
+		return nil, err
+	}
+	gnmiPath = prefixed[0]
+	exists, err := i.gnmiPathExists(ctx, gnmiPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("nothing at %s of %s", path, target))
+	}
+	gnmiSet, err := utils.NewGnmiSetRequest(nil, []*gnmi.Path{gnmiPath}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return i.gnmiSet(ctx, gnmiSet)
+}
+
+// gnmiDryRunPatchAetherRocAPI checks PatchBody as gnmiPatchAetherRocAPI does, but instead of the Set
+// returns the changes it would make. onos-config has no validate-only Set, so nothing is sent to it
+// beyond the Gets of validateParentsExist
+func (i *TopLevelServer) gnmiDryRunPatchAetherRocAPI(ctx context.Context, body []byte, deletes ...*gnmi.Path) (types.ChangeList, error) {
+	patchBody, err := i.preparePatch(ctx, body, true)
+	if err != nil {
+		return nil, err
+	}
+	if deletes, err = withBodyPrefix(patchBody.Prefix, deletes...); err != nil {
+		return nil, err
+	}
+	if deletes, err = i.withPathPrefix(deletes...); err != nil {
+		return nil, err
+	}
+	patchBody.Deletes = append(patchBody.Deletes, deletes...)
+
+	changes := make(types.ChangeList, 0)
+	byTarget := make(map[string]int)
+	addValue := func(path *gnmi.Path, value *string, removed bool) error {
+		// The target is on each path, but a path with none goes to the default
+		target := path.GetTarget()
+		if target == "" {
+			target = patchBody.DefaultTarget
+		}
+		pathStr, err := ygot.PathToString(&gnmi.Path{Elem: path.GetElem()})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		idx, ok := byTarget[target]
+		if !ok {
+			idx = len(changes)
+			byTarget[target] = idx
+			changes = append(changes, types.Change{
+				TargetId:      target,
+				TargetType:    patchBody.Ext102Type,
+				TargetVersion: patchBody.Ext101Version,
+				Values:        &[]types.ChangeValue{},
+			})
+		}
+		changeValue := types.ChangeValue{Path: pathStr, Value: value}
+		if removed {
+			changeValue.Removed = &removed
+		}
+		*changes[idx].Values = append(*changes[idx].Values, changeValue)
+		return nil
+	}
+	for _, u := range patchBody.Updates {
+		value := typedValueString(u.GetVal())
+		if err = addValue(u.GetPath(), &value, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range patchBody.Deletes {
+		if err = addValue(d, nil, true); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// preparePatch decodes PatchBody and converts it to gNMI, checking that the parents of what it
+// creates exist and that the leaves it expects have their values. The prefix of the body, if it has one,
+// is joined to every path so they are checked whole
+func (i *TopLevelServer) preparePatch(ctx context.Context, body []byte, prefixed bool) (*GnmiPatchBody, error) {
 	var jsonObj types.PatchBody
 	dec := json.NewDecoder(bytes.NewReader(body))
 	dec.DisallowUnknownFields() // Force errors
@@ -32,15 +325,242 @@ func (i *TopLevelServer) gnmiPatchAetherRocAPI(ctx context.Context, body []byte,
 	if err != nil {
 		return nil, err
 	}
-	gnmiSet, err := utils.NewGnmiSetRequest(patchBody.Updates, patchBody.Deletes,
-		patchBody.Ext100Name, patchBody.Ext101Version, patchBody.Ext102Type, patchBody.Ext111Strategy)
+	if patchBody.Prefix, err = parseBodyPrefix(jsonObj.Prefix); err != nil {
+		return nil, err
+	}
+	if patchBody.Deletes, err = withBodyPrefix(patchBody.Prefix, patchBody.Deletes...); err != nil {
+		return nil, err
+	}
+	for _, u := range patchBody.Updates {
+		joined, err := withBodyPrefix(patchBody.Prefix, u.GetPath())
+		if err != nil {
+			return nil, err
+		}
+		u.Path = joined[0]
+	}
+	if prefixed {
+		paths := make([]*gnmi.Path, 0, len(patchBody.Updates))
+		for _, u := range patchBody.Updates {
+			paths = append(paths, u.GetPath())
+		}
+		if paths, err = i.withPathPrefix(paths...); err != nil {
+			return nil, err
+		}
+		for idx, u := range patchBody.Updates {
+			u.Path = paths[idx]
+		}
+		if patchBody.Deletes, err = i.withPathPrefix(patchBody.Deletes...); err != nil {
+			return nil, err
+		}
+	}
+	if err = i.validateTargetsExist(ctx, patchBody); err != nil {
+		return nil, err
+	}
+	if err = i.validateParentsExist(ctx, patchBody.Updates); err != nil {
+		return nil, err
+	}
+	expected, err := i.expectedValues(&jsonObj, patchBody.Prefix, prefixed)
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("gnmiSetRequest %s", gnmiSet.String())
-	gnmiSetResponse, err := i.GnmiClient.Set(ctx, gnmiSet)
+	if err = i.checkExpectedValues(ctx, expected); err != nil {
+		return nil, err
+	}
+	return patchBody, nil
+}
+
+// typedValueString - the value of a scalar or leaf list as it would be printed, or the gNMI text
+// form of anything else
+func typedValueString(tv *gnmi.TypedValue) string {
+	if tv == nil {
+		return ""
+	}
+	scalar, err := decodeTypedValue(tv)
+	if err != nil {
+		return tv.String()
+	}
+	return fmt.Sprintf("%v", scalar)
+}
+
+// decodeTypedValue - the Go value of a gNMI value, with JSON values decoded
+func decodeTypedValue(tv *gnmi.TypedValue) (interface{}, error) {
+	if tv == nil {
+		return nil, nil
+	}
+	var decoded interface{}
+	switch v := tv.GetValue().(type) {
+	case *gnmi.TypedValue_JsonVal:
+		err := json.Unmarshal(v.JsonVal, &decoded)
+		return decoded, err
+	case *gnmi.TypedValue_JsonIetfVal:
+		err := json.Unmarshal(v.JsonIetfVal, &decoded)
+		return decoded, err
+	}
+	return value.ToScalar(tv)
+}
+
+// readEncodings - the encodings that may be asked for on a read. JSON and JSON_IETF values are
+// decoded from JSON, PROTO values are scalars or leaf lists
+var readEncodings = map[string]gnmi.Encoding{
+	gnmi.Encoding_PROTO.String():     gnmi.Encoding_PROTO,
+	gnmi.Encoding_JSON.String():      gnmi.Encoding_JSON,
+	gnmi.Encoding_JSON_IETF.String(): gnmi.Encoding_JSON_IETF,
+}
+
+// gnmiGetPath gets the value at path (in gNMI path string form) of target, which may be "*"
+func (i *TopLevelServer) gnmiGetPath(ctx context.Context, target string, path string, encoding gnmi.Encoding) (interface{}, error) {
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path %s %v", path, err))
+	}
+	gnmiPath.Target = target
+	prefixed, err := i.withPathPrefix(gnmiPath)
+	if err != nil {
+		return nil, err
+	}
+	gnmiGet := &gnmi.GetRequest{
+		Encoding: encoding,
+		Path:     prefixed,
+	}
+
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
+	gnmiVal, err := utils.GetResponseUpdate(i.gnmiGet(ctx, gnmiGet))
+	if err != nil {
+		return nil, err
+	}
+	return decodeTypedValue(gnmiVal)
+}
+
+// validateParentsExist checks, before the Set, that the list entries the updates are created
+// under exist - either because they are in this same patch or because they are already on the target.
+// This gives a clearer error than the backend when config is built in the wrong order
+func (i *TopLevelServer) validateParentsExist(ctx context.Context, updates []*gnmi.Update) error {
+	// The list entries that the updates set attributes of directly are being created by this patch
+	inPatch := make(map[string]bool)
+	for _, u := range updates {
+		if deepest := deepestKeyedElem(u.GetPath()); deepest >= 0 {
+			inPatch[u.GetPath().GetTarget()+entryPath(u.GetPath().GetElem()[:deepest+1])] = true
+		}
+	}
+
+	checked := make(map[string]bool)
+	for _, u := range updates {
+		elems := u.GetPath().GetElem()
+		for idx := deepestKeyedElem(u.GetPath()) - 1; idx >= 0; idx-- {
+			if len(elems[idx].GetKey()) == 0 {
+				continue
+			}
+			parent := u.GetPath().GetTarget() + entryPath(elems[:idx+1])
+			if inPatch[parent] || checked[parent] {
+				continue
+			}
+			exists, err := i.gnmiPathExists(ctx, &gnmi.Path{Target: u.GetPath().GetTarget(), Elem: elems[:idx+1]})
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return echo.NewHTTPError(http.StatusUnprocessableEntity,
+					fmt.Sprintf("parent %s does not exist", parent))
+			}
+			checked[parent] = true
+		}
+	}
+	return nil
+}
+
+// validateTargetsExist checks, before the Set, that the targets of the patch are known to onos-config,
+// from the cached list of targets. A target missing from the cache is looked for again in a fresh
+// list, in case it has just been added. Unless SkipTargetCheck, as when targets are created on first write
+func (i *TopLevelServer) validateTargetsExist(ctx context.Context, patchBody *GnmiPatchBody) error {
+	if i.SkipTargetCheck {
+		return nil
+	}
+	targets := make([]string, 0)
+	addTarget := func(path *gnmi.Path) {
+		target := path.GetTarget()
+		if target == "" {
+			target = patchBody.DefaultTarget
+		}
+		if target != "" && !contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	for _, u := range patchBody.Updates {
+		addTarget(u.GetPath())
+	}
+	for _, d := range patchBody.Deletes {
+		addTarget(d)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	for _, refresh := range []bool{false, true} {
+		known, err := i.getTargets(ctx, refresh)
+		if err != nil {
+			return err
+		}
+		unknown := ""
+		for _, target := range targets {
+			found := false
+			for _, name := range *known {
+				if name.Name != nil && *name.Name == target {
+					found = true
+					break
+				}
+			}
+			if !found {
+				unknown = target
+				break
+			}
+		}
+		if unknown == "" {
+			return nil
+		} else if refresh || i.TargetsCacheTTL <= 0 {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown target %s", unknown))
+		}
+	}
+	return nil
+}
+
+// gnmiPathExists - whether there is any config on the target at the path
+func (i *TopLevelServer) gnmiPathExists(ctx context.Context, path *gnmi.Path) (bool, error) {
+	gnmiGet := &gnmi.GetRequest{
+		Encoding: gnmi.Encoding_JSON,
+		Path:     []*gnmi.Path{path},
+	}
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
+	gnmiVal, err := utils.GetResponseUpdate(i.gnmiGet(ctx, gnmiGet))
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if gnmiVal == nil {
+		return false, nil
+	}
+	if jsonVal, ok := gnmiVal.Value.(*gnmi.TypedValue_JsonVal); ok {
+		trimmed := bytes.TrimSpace(jsonVal.JsonVal)
+		return len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("{}")), nil
+	}
+	return true, nil
+}
+
+// deepestKeyedElem - the index of the last list entry in the path, or -1 if there is none
+func deepestKeyedElem(path *gnmi.Path) int {
+	elems := path.GetElem()
+	for idx := len(elems) - 1; idx >= 0; idx-- {
+		if len(elems[idx].GetKey()) > 0 {
+			return idx
+		}
+	}
+	return -1
+}
+
+func entryPath(elems []*gnmi.PathElem) string {
+	p, err := ygot.PathToString(&gnmi.Path{Elem: elems})
 	if err != nil {
-		return nil, fmt.Errorf(" %v", err)
+		return fmt.Sprintf("%v", elems)
 	}
-	return utils.ExtractResponseID(gnmiSetResponse)
+	return p
 }
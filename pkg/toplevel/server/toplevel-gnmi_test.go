@@ -6,8 +6,23 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGnmiPachAetherRocApi_wrongFormat(t *testing.T) {
@@ -33,3 +48,545 @@ func TestGnmiPachAetherRocApi_wrongFormat2(t *testing.T) {
 	_, err := server.gnmiPatchAetherRocAPI(context.Background(), body, "")
 	assert.Error(t, err, `default-target cannot be blank`)
 }
+
+func Test_validateParentsExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			if request.Path[0].Elem[1].Key["id"] == "dg-missing" {
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &gnmi.GetResponse{
+				Notification: []*gnmi.Notification{{
+					Update: []*gnmi.Update{{
+						Val: &gnmi.TypedValue{
+							Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"id":"dg-existing"}`)},
+						},
+					}},
+				}},
+			}, nil
+		},
+	).Times(2)
+	server := &TopLevelServer{GnmiClient: mockClient}
+
+	imsiUpdate := func(dg string) *gnmi.Update {
+		return &gnmi.Update{Path: &gnmi.Path{Target: "internal", Elem: []*gnmi.PathElem{
+			{Name: "device-group"},
+			{Name: "device-group", Key: map[string]string{"id": dg}},
+			{Name: "imsis", Key: map[string]string{"imsi-id": "imsi1"}},
+			{Name: "imsi-range-from"},
+		}}}
+	}
+	dgUpdate := &gnmi.Update{Path: &gnmi.Path{Target: "internal", Elem: []*gnmi.PathElem{
+		{Name: "device-group"},
+		{Name: "device-group", Key: map[string]string{"id": "dg-new"}},
+		{Name: "display-name"},
+	}}}
+
+	// dg-new is created in the same patch, so is not looked up
+	assert.NilError(t, server.validateParentsExist(context.Background(),
+		[]*gnmi.Update{dgUpdate, imsiUpdate("dg-new"), imsiUpdate("dg-existing")}))
+
+	err := server.validateParentsExist(context.Background(), []*gnmi.Update{imsiUpdate("dg-missing")})
+	assert.Error(t, err, "code=422, message=parent internal/device-group/device-group[id=dg-missing] does not exist")
+}
+
+func Test_validateTargetsExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	targets := targetsLeafList("connectivity-service-v4")
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{
+				Update: []*gnmi.Update{{Path: &gnmi.Path{}, Val: targets}},
+			}}}, nil
+		},
+	).Times(3)
+	server := &TopLevelServer{GnmiClient: mockClient, TargetsCacheTTL: time.Minute}
+	patchBody := func(defaultTarget string, targets ...string) *GnmiPatchBody {
+		body := &GnmiPatchBody{DefaultTarget: defaultTarget}
+		for _, target := range targets {
+			body.Updates = append(body.Updates, &gnmi.Update{Path: &gnmi.Path{Target: target}})
+		}
+		return body
+	}
+
+	// From the cache
+	assert.NilError(t, server.validateTargetsExist(context.Background(), patchBody("connectivity-service-v4", "")))
+	assert.NilError(t, server.validateTargetsExist(context.Background(), patchBody("", "connectivity-service-v4")))
+
+	// Not in the cache, nor in the list fetched again
+	err := server.validateTargetsExist(context.Background(), patchBody("connectivity-service-v4", "", "no-such-target"))
+	assert.Error(t, err, "code=404, message=unknown target no-such-target")
+
+	// Added since the cache was filled
+	targets = targetsLeafList("connectivity-service-v4", "new-target")
+	assert.NilError(t, server.validateTargetsExist(context.Background(), patchBody("new-target", "")))
+
+	server.SkipTargetCheck = true
+	assert.NilError(t, server.validateTargetsExist(context.Background(), patchBody("no-such-target", "")))
+}
+
+func Test_gnmiGetTargets_retry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "restarting")).Times(2),
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&gnmi.GetResponse{
+			Notification: []*gnmi.Notification{{
+				Update: []*gnmi.Update{{
+					Val: &gnmi.TypedValue{
+						Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{
+							Element: []*gnmi.TypedValue{{Value: &gnmi.TypedValue_StringVal{StringVal: "connectivity-service-v4"}}},
+						}},
+					},
+				}},
+			}},
+		}, nil),
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiRetries: 2}
+
+	targets, err := server.gnmiGetTargets(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(*targets))
+	assert.Equal(t, "connectivity-service-v4", *(*targets)[0].Name)
+}
+
+func Test_gnmiGet_deadlineExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.DeadlineExceeded, "slow")),
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+				deadline, ok := ctx.Deadline()
+				assert.Assert(t, ok)
+				assert.Assert(t, time.Until(deadline) > 90*time.Second)
+				return &gnmi.GetResponse{}, nil
+			},
+		),
+		// Already at GnmiTimeoutMax, so not retried
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.DeadlineExceeded, "slow")),
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, GnmiTimeoutMax: 2 * time.Minute}
+	echoCtx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/aether-roc-api", nil), httptest.NewRecorder())
+
+	gnmiCtx, cancel := utils.NewGnmiContext(echoCtx, time.Minute)
+	defer cancel()
+	_, err := server.gnmiGet(gnmiCtx, &gnmi.GetRequest{})
+	assert.NilError(t, err)
+
+	gnmiCtx, cancel = utils.NewGnmiContext(echoCtx, 2*time.Minute)
+	defer cancel()
+	_, err = server.gnmiGet(gnmiCtx, &gnmi.GetRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func Test_gnmiGetTargets_aliases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&gnmi.GetResponse{
+		Notification: []*gnmi.Notification{{
+			Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{
+					Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{
+						Element: []*gnmi.TypedValue{
+							{Value: &gnmi.TypedValue_StringVal{StringVal: "5f2e7a1c"}},
+							{Value: &gnmi.TypedValue_StringVal{StringVal: "connectivity-service-v4"}},
+						},
+					}},
+				},
+			}},
+		}},
+	}, nil)
+	server := &TopLevelServer{GnmiClient: mockClient, TargetAliases: map[string]string{"5f2e7a1c": "Acme Corp"}}
+
+	targets, err := server.gnmiGetTargets(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(*targets))
+	assert.Equal(t, "5f2e7a1c", *(*targets)[0].Name)
+	assert.Equal(t, "Acme Corp", *(*targets)[0].DisplayName)
+	assert.Assert(t, (*targets)[1].DisplayName == nil)
+
+	body, err := json.Marshal(targets)
+	assert.NilError(t, err)
+	assert.Equal(t, `[{"displayName":"Acme Corp","name":"5f2e7a1c"},{"name":"connectivity-service-v4"}]`, string(body))
+}
+
+func Test_gnmiGetTargets_noRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "bad")).Times(1)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiRetries: 2}
+
+	_, err := server.gnmiGetTargets(context.Background())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_GetReady(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().Capabilities(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+				// The short readiness timeout is used, not GnmiTimeout
+				deadline, ok := ctx.Deadline()
+				assert.Assert(t, ok)
+				assert.Assert(t, time.Until(deadline) <= readyTimeout)
+				return &gnmi.CapabilityResponse{}, nil
+			}),
+		mockClient.EXPECT().Capabilities(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "connection refused")),
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	rec := httptest.NewRecorder()
+	err := server.GetReady(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/ready", nil), rec))
+	assert.NilError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	err = server.GetReady(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/ready", nil), httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+}
+
+func Test_GetCapabilities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	gomock.InOrder(
+		mockClient.EXPECT().Capabilities(gomock.Any(), gomock.Any()).Return(&gnmi.CapabilityResponse{
+			SupportedModels: []*gnmi.ModelData{
+				{Name: "connectivity-service", Organization: "Open Networking Foundation", Version: "4.0.0"},
+			},
+			SupportedEncodings: []gnmi.Encoding{gnmi.Encoding_JSON, gnmi.Encoding_PROTO},
+			GNMIVersion:        "0.7.0",
+		}, nil),
+		mockClient.EXPECT().Capabilities(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "connection refused")),
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	rec := httptest.NewRecorder()
+	err := server.GetCapabilities(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/capabilities", nil), rec))
+	assert.NilError(t, err)
+	var capabilities types.Capabilities
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &capabilities))
+	assert.Equal(t, "0.7.0", capabilities.GnmiVersion)
+	assert.DeepEqual(t, []string{"JSON", "PROTO"}, capabilities.SupportedEncodings)
+	assert.DeepEqual(t, []types.SupportedModel{
+		{Name: "connectivity-service", Organization: "Open Networking Foundation", Version: "4.0.0"},
+	}, capabilities.SupportedModels)
+
+	err = server.GetCapabilities(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/capabilities", nil), httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+}
+
+func Test_PatchAetherRocAPI_dryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// No calls are expected - the Set in particular must not be made
+	server := &TopLevelServer{GnmiClient: southbound.NewMockGnmiClient(ctrl), SkipTargetCheck: true}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api?dryRun=true", strings.NewReader(
+		`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}},
+"Deletes":{"device-group-4.0.0":{"device-group":[{"id":"dg2","additional-properties":{"unchanged":"site"}}]}}}`))
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.PatchAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var changes types.ChangeList
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &changes))
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, "connectivity-service-v4", changes[0].TargetId)
+	values := make(map[string]types.ChangeValue)
+	for _, v := range *changes[0].Values {
+		values[v.Path] = v
+	}
+	assert.Equal(t, 4, len(values))
+	assert.Equal(t, "Site 1", *values["/site/site[id=s1]/display-name"].Value)
+	assert.Assert(t, values["/site/site[id=s1]/display-name"].Removed == nil)
+	deleted := values["/device-group/device-group[id=dg2]/id"]
+	assert.Assert(t, deleted.Value == nil)
+	assert.Equal(t, true, *deleted.Removed)
+}
+
+func Test_PatchAetherRocAPI_dryRunInvalid(t *testing.T) {
+	server := &TopLevelServer{}
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api?dryRun=maybe", strings.NewReader(`{}`))
+	err := server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_GetTargetsHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			var val *gnmi.TypedValue
+			switch request.Path[0].Target {
+			case "*":
+				val = &gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{
+					Element: []*gnmi.TypedValue{
+						{Value: &gnmi.TypedValue_StringVal{StringVal: "t1"}},
+						{Value: &gnmi.TypedValue_StringVal{StringVal: "t2"}},
+						{Value: &gnmi.TypedValue_StringVal{StringVal: "t3"}},
+					},
+				}}}
+			case "t2":
+				return nil, status.Error(codes.NotFound, "target t2 not found")
+			default:
+				val = &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{}`)}}
+			}
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{Val: val}}}}}, nil
+		},
+	).Times(4)
+	synced := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := &TopLevelServer{
+		GnmiClient:  mockClient,
+		GnmiTimeout: time.Minute,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{{
+			ObjectMeta: configapi.ObjectMeta{Updated: synced},
+			ID:         "tx1",
+			Details: &configapi.Transaction_Change{Change: &configapi.ChangeTransaction{
+				Values: map[configapi.TargetID]*configapi.PathValues{"t1": {}},
+			}},
+			Status: configapi.TransactionStatus{State: configapi.TransactionStatus_APPLIED},
+		}}},
+	}
+
+	rec := httptest.NewRecorder()
+	err := server.GetTargetsHealth(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets/health", nil), rec))
+	assert.NilError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var health types.TargetsHealth
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &health))
+	assert.Equal(t, 3, len(health))
+	assert.Equal(t, "t1", health[0].Name)
+	assert.Equal(t, true, health[0].Connected)
+	assert.Assert(t, health[0].LastSync != nil && health[0].LastSync.Equal(synced))
+	assert.Equal(t, "t2", health[1].Name)
+	assert.Equal(t, false, health[1].Connected)
+	assert.Assert(t, health[1].LastSync == nil)
+	assert.Equal(t, true, health[2].Connected)
+}
+
+func Test_GetAetherRocAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			assert.Equal(t, "connectivity-service-v4", request.Path[0].Target)
+			assert.Equal(t, "display-name", request.Path[0].Elem[2].Name)
+			assert.Equal(t, "s1", request.Path[0].Elem[1].Key["id"])
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "Site 1"}},
+			}}}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s1]/display-name", nil)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.GetAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"Site 1"`, strings.TrimSpace(rec.Body.String()))
+
+	err := server.GetAetherRocAPI(echo.New().NewContext(
+		httptest.NewRequest(http.MethodGet, "/aether-roc-api?path=/site", nil), httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_GetAetherRocAPI_encoding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			assert.Equal(t, gnmi.Encoding_JSON_IETF, request.Encoding)
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{
+					JsonIetfVal: []byte(`{"site:display-name":"Site 1"}`)}},
+			}}}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s1]&encoding=json_ietf", nil)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.GetAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, `{"site:display-name":"Site 1"}`, strings.TrimSpace(rec.Body.String()))
+
+	req = httptest.NewRequest(http.MethodGet, "/aether-roc-api?target=connectivity-service-v4&encoding=ASCII", nil)
+	err := server.GetAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_DeleteAetherRocAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			if request.Path[0].Elem[1].Key["id"] == "s-missing" {
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"id":"s1"}`)}},
+			}}}}}, nil
+		},
+	).Times(2)
+	infoBytes, err := (&configapi.TransactionInfo{ID: "tx-9", Index: 9}).Marshal()
+	assert.NilError(t, err)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			assert.Equal(t, 0, len(request.Update))
+			assert.Equal(t, 1, len(request.Delete))
+			assert.Equal(t, "connectivity-service-v4", request.Delete[0].Target)
+			assert.Equal(t, "s1", request.Delete[0].Elem[1].Key["id"])
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: configapi.TransactionInfoExtensionID, Msg: infoBytes,
+				}},
+			}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute}
+
+	req := httptest.NewRequest(http.MethodDelete, "/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s1]", nil)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.DeleteAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"index":9,"transactionId":"tx-9"}`, strings.TrimSpace(rec.Body.String()))
+	assert.Equal(t, `"9"`, rec.Header().Get(headerETag))
+
+	req = httptest.NewRequest(http.MethodDelete, "/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s-missing]", nil)
+	err = server.DeleteAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
+
+func Test_PatchAetherRocAPI_transactionID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	// An onos-config that gives only the ID, in the older extension
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(&gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+		Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id: 100, Msg: []byte("tx-10"),
+		}},
+	}}}, nil)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.PatchAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var info types.TransactionInfo
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	assert.Equal(t, "tx-10", info.TransactionId)
+	assert.Assert(t, info.Index == nil)
+	assert.Equal(t, "", rec.Header().Get(headerETag))
+}
+
+func Test_PatchAetherRocAPI_setNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "target not found"))
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	err := server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	body, ok := httpErr.Message.(utils.GrpcErrorBody)
+	assert.Assert(t, ok)
+	assert.Equal(t, codes.NotFound.String(), body.GrpcStatus)
+}
+
+func Test_PutAetherRocAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			assert.Equal(t, 0, len(request.Update))
+			assert.Equal(t, 1, len(request.Delete))
+			assert.Equal(t, "connectivity-service-v4", request.Delete[0].Target)
+			assert.Equal(t, 2, len(request.Delete[0].Elem))
+			assert.Equal(t, "s1", request.Delete[0].Elem[1].Key["id"])
+			assert.Assert(t, len(request.Replace) > 0)
+			for _, replace := range request.Replace {
+				assert.Assert(t, pathElemsUnder(replace.Path.Elem, request.Delete[0].Elem))
+			}
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-11"),
+				}},
+			}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true}
+	put := func(path string, body string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPut, "/aether-roc-api?path="+path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		return rec, server.PutAetherRocAPI(echo.New().NewContext(req, rec))
+	}
+	site := `{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`
+
+	rec, err := put("/site/site[id=s1]", site)
+	assert.NilError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"transactionId":"tx-11"}`, strings.TrimSpace(rec.Body.String()))
+
+	// Updates outside the path
+	_, err = put("/site/site[id=s2]", site)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+
+	_, err = put("/", site)
+	httpErr, ok = err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_pathElemsUnder(t *testing.T) {
+	site := []*gnmi.PathElem{{Name: "site"}, {Name: "site", Key: map[string]string{"id": "s1"}}}
+	name := append(append([]*gnmi.PathElem{}, site...), &gnmi.PathElem{Name: "display-name"})
+	assert.Assert(t, pathElemsUnder(name, site))
+	assert.Assert(t, pathElemsUnder(site, site))
+	assert.Assert(t, !pathElemsUnder(site, name))
+	assert.Assert(t, !pathElemsUnder(site, []*gnmi.PathElem{{Name: "site"}, {Name: "site", Key: map[string]string{"id": "s2"}}}))
+	assert.Assert(t, !pathElemsUnder(site, []*gnmi.PathElem{{Name: "site"}, {Name: "site"}}))
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// gzipBodyMiddleware - decompresses a request body sent with Content-Encoding gzip, so that the
+// middleware and handler after it see the plain body. The decompressed body may be no larger than
+// maxBodyBytes, however well it compresses. A corrupt stream is a 400, and any encoding other than
+// gzip or identity is a 415
+func gzipBodyMiddleware(maxBodyBytes int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			encodings := make([]string, 0)
+			for _, encoding := range strings.Split(req.Header.Get(echo.HeaderContentEncoding), ",") {
+				if encoding = strings.ToLower(strings.TrimSpace(encoding)); encoding != "" && encoding != "identity" {
+					encodings = append(encodings, encoding)
+				}
+			}
+			if len(encodings) == 0 {
+				return next(ctx)
+			}
+			if len(encodings) > 1 || (encodings[0] != "gzip" && encodings[0] != "x-gzip") {
+				return echo.NewHTTPError(http.StatusUnsupportedMediaType,
+					fmt.Sprintf("Content-Encoding %s is not supported. Only gzip is",
+						req.Header.Get(echo.HeaderContentEncoding)))
+			}
+			body, err := gunzipBody(req.Body, maxBodyBytes)
+			if err != nil {
+				return err
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+			req.Header.Del(echo.HeaderContentEncoding)
+			return next(ctx)
+		}
+	}
+}
+
+// gunzipBody - the decompressed body, read no further than one byte past maxBodyBytes
+func gunzipBody(bodyReader io.ReadCloser, maxBodyBytes int64) ([]byte, error) {
+	defer bodyReader.Close()
+	gzipReader, err := gzip.NewReader(bodyReader)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid gzip body. %v", err))
+	}
+	defer gzipReader.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(gzipReader, maxBodyBytes+1))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid gzip body. %v", err))
+	}
+	if int64(len(body)) > maxBodyBytes {
+		return nil, echo.NewHTTPError(http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("decompressed request body is larger than the limit of %d bytes", maxBodyBytes))
+	}
+	return body, nil
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func gzipped(t *testing.T, body string) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte(body))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func Test_PatchAetherRocAPI_gzip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var displayNames []string
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			for _, u := range request.Update {
+				if u.Path.Elem[len(u.Path.Elem)-1].Name == "display-name" {
+					displayNames = append(displayNames, u.Val.GetStringVal())
+				}
+			}
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		}).Times(1)
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute,
+		MaxBodyBytes: 1024, SkipTargetCheck: true}))
+	patch := func(encoding string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderContentEncoding, encoding)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := patch("gzip", gzipped(t, `{"default-target":"connectivity-service-v4","Updates":{"site-4.0.0":`+
+		`{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{"Site 1"}, displayNames)
+
+	// Well within the limit compressed, but not once decompressed
+	rec = patch("gzip", gzipped(t, `{"default-target":"`+strings.Repeat("x", 2048)+`"}`))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code, rec.Body.String())
+
+	rec = patch("gzip", []byte("not gzip"))
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	truncated := gzipped(t, `{"default-target":"connectivity-service-v4"}`)
+	rec = patch("gzip", truncated[:len(truncated)-6])
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	rec = patch("br", []byte("{}"))
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code, rec.Body.String())
+	rec = patch("gzip, gzip", []byte("{}"))
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strings"
+)
+
+// streamRoutes - the routes that are meant to stay open, so have no HandlerTimeout
+var streamRoutes = map[string]bool{
+	"/transactions/stream":      true,
+	"/aether-roc-api/subscribe": true,
+}
+
+// HandlerTimeoutMiddleware - gives the context of each request a deadline of HandlerTimeout, whatever
+// the handler calls e.g. the sdcore synchronize service. A handler that is still running then has its
+// result replaced by 503, unless it has started the response. The deadline is never shorter than the
+// request's gNMI timeout, which stays the tighter bound. Streams and websockets have no deadline
+func (i *TopLevelServer) HandlerTimeoutMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if i.HandlerTimeout <= 0 || isStreamRequest(ctx) {
+				return next(ctx)
+			}
+			timeout := i.HandlerTimeout
+			if gnmiTimeout := i.gnmiTimeout(ctx); gnmiTimeout > timeout {
+				timeout = gnmiTimeout
+			}
+			reqCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+			defer cancel()
+			ctx.SetRequest(ctx.Request().WithContext(reqCtx))
+
+			err := next(ctx)
+			if reqCtx.Err() == context.DeadlineExceeded && !ctx.Response().Committed {
+				log.Warnf("%s %s not handled within %v for %s", ctx.Request().Method, ctx.Request().URL.Path,
+					timeout, requester(ctx))
+				return echo.NewHTTPError(http.StatusServiceUnavailable,
+					fmt.Sprintf("request not handled within %v", timeout))
+			}
+			return err
+		}
+	}
+}
+
+// isStreamRequest - whether the request is for a stream of events or a websocket
+func isStreamRequest(ctx echo.Context) bool {
+	return streamRoutes[ctx.Path()] ||
+		strings.EqualFold(ctx.Request().Header.Get(echo.HeaderUpgrade), "websocket") ||
+		strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), "text/event-stream")
+}
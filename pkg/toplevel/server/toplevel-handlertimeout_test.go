@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_HandlerTimeoutMiddleware(t *testing.T) {
+	server := &TopLevelServer{HandlerTimeout: 10 * time.Millisecond}
+	slow := func(ctx echo.Context) error {
+		<-ctx.Request().Context().Done()
+		return ctx.Request().Context().Err()
+	}
+	e := echo.New()
+	e.Use(server.HandlerTimeoutMiddleware())
+	e.GET("/config", slow)
+	e.GET("/transactions/stream", func(ctx echo.Context) error {
+		_, ok := ctx.Request().Context().Deadline()
+		assert.Assert(t, !ok)
+		return ctx.NoContent(http.StatusOK)
+	})
+	e.GET("/targets", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	// A handler that does not finish gets 503
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/config", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/targets", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Streams have no deadline
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/transactions/stream", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_HandlerTimeoutMiddleware_gnmiTimeout(t *testing.T) {
+	// The deadline is never shorter than the gNMI timeout
+	server := &TopLevelServer{HandlerTimeout: time.Millisecond, GnmiTimeout: time.Minute}
+	handler := server.HandlerTimeoutMiddleware()(func(ctx echo.Context) error {
+		deadline, ok := ctx.Request().Context().Deadline()
+		assert.Assert(t, ok)
+		assert.Assert(t, time.Until(deadline) > 30*time.Second)
+		return ctx.NoContent(http.StatusOK)
+	})
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets", nil), rec)
+	assert.NilError(t, handler(ctx))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// targetsHealthWorkers - how many targets are queried at once, so that a large fleet is neither
+// queried one by one nor all at once
+const targetsHealthWorkers = 8
+
+// GetTargetsHealth -
+func (i *TopLevelServer) GetTargetsHealth(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	targets, err := i.gnmiGetTargets(gnmiCtx)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	lastSyncs := i.targetsLastSync(gnmiCtx)
+
+	health := make(externalRef0.TargetsHealth, len(*targets))
+	names := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < targetsHealthWorkers && w < len(*targets); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range names {
+				name := *(*targets)[idx].Name
+				health[idx] = externalRef0.TargetHealth{Name: name, Connected: i.targetConnected(gnmiCtx, name)}
+				if lastSync, ok := lastSyncs[configapi.TargetID(name)]; ok {
+					health[idx].LastSync = &lastSync
+				}
+			}
+		}()
+	}
+	for idx := range *targets {
+		names <- idx
+	}
+	close(names)
+	wg.Wait()
+
+	log.Debugf("GetTargetsHealth by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, health)
+}
+
+// targetConnected - whether onos-config can give the configuration of the target
+func (i *TopLevelServer) targetConnected(ctx context.Context, target string) bool {
+	if _, err := i.gnmiGetTargetConfig(ctx, target); err != nil {
+		log.Warnf("target %s is not connected %v", target, err)
+		return false
+	}
+	return true
+}
+
+// targetsLastSync - when a transaction was last applied to each target. Empty if the transactions
+// cannot be had, as the connected status is still worth giving
+func (i *TopLevelServer) targetsLastSync(ctx context.Context) map[configapi.TargetID]time.Time {
+	lastSyncs := make(map[configapi.TargetID]time.Time)
+	if i.ConfigClient == nil {
+		return lastSyncs
+	}
+	transactions, err := i.grpcGetTransactionsRaw(ctx, nil)
+	if err != nil {
+		log.Warnf("unable to get the transactions for the last sync of targets %v", err)
+		return lastSyncs
+	}
+	for _, t := range transactions {
+		if t.GetStatus().State != configapi.TransactionStatus_APPLIED || t.GetChange() == nil {
+			continue
+		}
+		for targetID := range t.GetChange().Values {
+			if updated := t.GetUpdated(); updated.After(lastSyncs[targetID]) {
+				lastSyncs[targetID] = updated
+			}
+		}
+	}
+	return lastSyncs
+}
@@ -7,30 +7,35 @@ package server
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
-	"github.com/ghodss/yaml"
 	"github.com/labstack/echo/v4"
-	aether_2_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_2_0_0/server"
-	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
-	app_gtwy "github.com/onosproject/aether-roc-api/pkg/app_gtwy/server"
 	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 	htmltemplate "html/template"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // server-interface template override
 
 import (
+	"github.com/onosproject/aether-roc-api/pkg/metrics"
 	"github.com/onosproject/aether-roc-api/pkg/southbound"
 	"github.com/onosproject/aether-roc-api/pkg/utils"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
@@ -43,7 +48,27 @@ type HTMLData struct {
 	Description string
 }
 
-const authorization = "Authorization"
+const (
+	authorization = "Authorization"
+	// adminGroup is the group a user must belong to for admin-only operations
+	adminGroup        = "AetherROCAdmin"
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+	headerIfMatch     = "If-Match"
+	headerNextCursor  = "X-Next-Cursor"
+	defaultSyncScheme = "http"
+	defaultSyncPort   = 8080
+	// defaultSyncTimeout - the sdcore adapter pushes the whole config to the core, which can take a while
+	defaultSyncTimeout = 30 * time.Second
+	// sseHeartbeatInterval - how often a comment is sent on an idle event stream, so proxies keep it open
+	sseHeartbeatInterval = 15 * time.Second
+	// streamRetryAfter - when a client turned away because MaxStreams are open is told to try again
+	streamRetryAfter = 5 * time.Second
+	// defaultMaxBodyBytes - the largest PATCH body read, unless MaxBodyBytes is set
+	defaultMaxBodyBytes = 4 << 20
+	// readyTimeout - kept short, and apart from GnmiTimeout, so that readiness probes answer quickly
+	readyTimeout = 2 * time.Second
+)
 
 // Implement the Server Interface for access to gNMI
 var log = logging.GetLogger("toplevel")
@@ -57,8 +82,8 @@ func (i *TopLevelServer) gnmiGetTargets(ctx context.Context) (*externalRef0.Targ
 		Target: "*",
 	}
 
-	log.Infof("gnmiGetRequest %s", gnmiGet.String())
-	gnmiVal, err := utils.GetResponseUpdate(i.GnmiClient.Get(ctx, gnmiGet))
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
+	gnmiVal, err := utils.GetResponseUpdate(i.gnmiGet(ctx, gnmiGet))
 	if err != nil {
 		return nil, err
 	}
@@ -67,49 +92,226 @@ func (i *TopLevelServer) gnmiGetTargets(ctx context.Context) (*externalRef0.Targ
 		return nil, fmt.Errorf("expecting a leaf list. Got %s", gnmiVal.String())
 	}
 
-	log.Infof("gNMI %s", gnmiLeafListStr.LeaflistVal.String())
-	targetsNames := make(externalRef0.TargetsNames, 0)
+	log.Debugf("gNMI %s", gnmiLeafListStr.LeaflistVal.String())
+	names := make([]string, 0, len(gnmiLeafListStr.LeaflistVal.Element))
 	for _, elem := range gnmiLeafListStr.LeaflistVal.Element {
-		targetName := elem.GetStringVal()
-		targetsNames = append(targetsNames, externalRef0.TargetName{
-			Name: &targetName,
-		})
+		names = append(names, elem.GetStringVal())
+	}
+	return i.targetsNames(names), nil
+}
+
+// targetsNames - the TargetsNames of the names of targets, with their aliases
+func (i *TopLevelServer) targetsNames(names []string) *externalRef0.TargetsNames {
+	targetsNames := make(externalRef0.TargetsNames, 0, len(names))
+	for idx := range names {
+		name := externalRef0.TargetName{
+			Name: &names[idx],
+		}
+		if alias, ok := i.TargetAliases[names[idx]]; ok {
+			name.DisplayName = &alias
+		}
+		targetsNames = append(targetsNames, name)
 	}
-	return &targetsNames, nil
+	return &targetsNames
 }
 
-// grpcGetTransactions returns a list of Transactions.
-func (i *TopLevelServer) grpcGetTransactions(ctx context.Context) (*externalRef0.TransactionList, error) {
-	log.Infof("grpcGetTransactions - subscribe=false")
+// gnmiGetTargetConfig returns the complete configuration of a target as a decoded JSON tree.
+func (i *TopLevelServer) gnmiGetTargetConfig(ctx context.Context, target string) (interface{}, error) {
+	gnmiGet := new(gnmi.GetRequest)
+	gnmiGet.Encoding = gnmi.Encoding_JSON
+	gnmiGet.Path = make([]*gnmi.Path, 1)
+	gnmiGet.Path[0] = &gnmi.Path{
+		Target: target,
+	}
+
+	log.Debugf("gnmiGetRequest %s", gnmiGet.String())
+	gnmiVal, err := utils.GetResponseUpdate(i.gnmiGet(ctx, gnmiGet))
+	if err != nil {
+		return nil, err
+	}
+	if gnmiVal == nil {
+		return nil, nil
+	}
+	gnmiJSONVal, ok := gnmiVal.Value.(*gnmi.TypedValue_JsonVal)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of reply from server %v", gnmiVal.Value)
+	}
+
+	var config interface{}
+	if err = json.Unmarshal(gnmiJSONVal.JsonVal, &config); err != nil {
+		return nil, fmt.Errorf("error unmarshalling config of %s %v", target, err)
+	}
+	return config, nil
+}
+
+// grpcGetTransactions returns a list of Transactions, of those that match the filter.
+func (i *TopLevelServer) grpcGetTransactions(ctx context.Context, filter *transactionFilter) (*externalRef0.TransactionList, error) {
+	log.Debugf("grpcGetTransactions - subscribe=false")
+
+	var transactions []*configapi.Transaction
+	all, cached, err := i.cachedTransactions(ctx)
+	if cached {
+		transactions = filterTransactions(all, filter)
+	} else {
+		transactions, err = i.listTransactions(ctx, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	links := transactionLinks(transactions)
+	transactionList := make(externalRef0.TransactionList, 0, len(transactions))
+	for _, networkChange := range transactions {
+		transaction := networkChangeToTransaction(networkChange)
+		if networkChange != nil {
+			transaction.Links = links[networkChange.GetID()]
+		}
+		i.withRequestID(&transaction)
+		i.redactor().transaction(&transaction)
+		transactionList = append(transactionList, transaction)
+	}
 
-	stream, err := i.ConfigClient.ListTransactions(ctx, &admin.ListTransactionsRequest{})
+	return &transactionList, nil
+}
+
+// listTransactions - the transactions that match the filter, read from onos-config as they are.
+// Every read of the transactions stream goes through here, so that each is traced and counted
+func (i *TopLevelServer) listTransactions(ctx context.Context, filter *transactionFilter) ([]*configapi.Transaction, error) {
+	listCtx, span := i.startClientSpan(ctx, "admin.ListTransactions")
+	defer span.End()
+	// Ends the stream if the filter's limit is reached before onos-config has sent everything
+	listCtx, cancelList := context.WithCancel(listCtx)
+	defer cancelList()
+	stream, err := i.ConfigClient.ListTransactions(listCtx, &admin.ListTransactionsRequest{})
 	if err != nil {
-		return nil, errors.FromGRPC(err)
+		setSpanError(span, err)
+		i.Metrics.ObserveGnmiCall("ListTransactions", err)
+		return nil, transactionsError(err)
 	}
-	transactionList := make(externalRef0.TransactionList, 0)
+	limiter := i.newTransactionsReadLimiter()
+	transactions := make([]*configapi.Transaction, 0)
 	for {
+		if err = limiter.Wait(ctx); err != nil {
+			if ctx.Err() == nil {
+				// The wait would pass the deadline of ctx, so it has timed out already
+				err = fmt.Errorf("%w: %v", context.DeadlineExceeded, err)
+			}
+			setSpanError(span, err)
+			i.Metrics.ObserveGnmiCall("ListTransactions", err)
+			return nil, transactionsError(err)
+		}
 		networkChange, err := stream.Recv()
-		if err == io.EOF || networkChange == nil {
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			setSpanError(span, err)
+			i.Metrics.ObserveGnmiCall("ListTransactions", err)
+			return nil, transactionsError(err)
+		}
+		if networkChange == nil {
+			break
+		}
+		if networkChange.GetTransaction() == nil || !filter.matches(networkChange.GetTransaction()) {
+			continue
+		}
+		transactions = append(transactions, networkChange.GetTransaction())
+		if filter.full(len(transactions)) {
 			break
 		}
-		transactionList = append(transactionList, convertTrasaction(networkChange))
 	}
 
-	return &transactionList, nil
+	i.Metrics.ObserveGnmiCall("ListTransactions", nil)
+	return transactions, nil
+}
+
+// grpcCountTransactions returns the number of Transactions that match the filter, without mapping them.
+func (i *TopLevelServer) grpcCountTransactions(ctx context.Context, filter *transactionFilter) (int, error) {
+	log.Debugf("grpcCountTransactions - subscribe=false")
+	if transactions, cached, err := i.cachedTransactions(ctx); cached {
+		return len(filterTransactions(transactions, filter)), err
+	}
+	transactions, err := i.listTransactions(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return len(transactions), nil
+}
+
+// grpcGetTransaction returns the Transaction with the ID, or nil if there is none.
+func (i *TopLevelServer) grpcGetTransaction(ctx context.Context, id string) (*externalRef0.Transaction, error) {
+	log.Debugf("grpcGetTransaction %s", id)
+
+	resp, err := i.ConfigClient.GetTransaction(ctx, &admin.GetTransactionRequest{ID: configapi.TransactionID(id)})
+	if err != nil {
+		err = transactionsError(err)
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp.GetTransaction() == nil {
+		return nil, nil
+	}
+	transaction := networkChangeToTransaction(resp.GetTransaction())
+	i.withRequestID(&transaction)
+	i.redactor().transaction(&transaction)
+	return &transaction, nil
 }
 
-func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRef0.Transaction {
+// grpcGetTransactionsRaw returns the Transactions that match the filter exactly as onos-config reports them.
+func (i *TopLevelServer) grpcGetTransactionsRaw(ctx context.Context, filter *transactionFilter) ([]*configapi.Transaction, error) {
+	log.Debugf("grpcGetTransactionsRaw - subscribe=false")
+	if transactions, cached, err := i.cachedTransactions(ctx); cached {
+		if err != nil {
+			return nil, err
+		}
+		return filterTransactions(transactions, filter), nil
+	}
+	return i.listTransactions(ctx, filter)
+}
+
+// transactionsError - the errors from onos-config's transactions API. A stream error only shows on Recv(),
+// so an onos-config that predates the API would otherwise look like it had no transactions at all. Running
+// out of time, whether waiting on onos-config or on TransactionsReadRate, is a 504
+func transactionsError(err error) error {
+	if utils.IsDeadlineExceeded(err) {
+		return echo.NewHTTPError(http.StatusGatewayTimeout,
+			fmt.Sprintf("timed out listing the transactions of onos-config. %v", err))
+	}
+	err = errors.FromGRPC(err)
+	if errors.IsNotSupported(err) {
+		return echo.NewHTTPError(http.StatusNotImplemented,
+			fmt.Sprintf("onos-config does not support the transactions API. %v", err))
+	}
+	return err
+}
 
-	if networkChange.GetTransaction() == nil {
+// newTransactionsReadLimiter - limits how fast the transactions stream is read, to protect our CPU
+// when the whole history is processed. A TransactionsReadRate of 0 means no limit.
+func (i *TopLevelServer) newTransactionsReadLimiter() *rate.Limiter {
+	if i.TransactionsReadRate <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	burst := int(i.TransactionsReadRate)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(i.TransactionsReadRate), burst)
+}
+
+// networkChangeToTransaction maps a transaction (network change) from onos-config on to the REST type
+func networkChangeToTransaction(networkChange *configapi.Transaction) externalRef0.Transaction {
+
+	if networkChange == nil {
 		return externalRef0.Transaction{}
 	}
-	created := networkChange.GetTransaction().GetCreated()
-	updated := networkChange.GetTransaction().GetUpdated()
-	deleted := networkChange.GetTransaction().GetDeleted()
-	username := networkChange.GetTransaction().GetUsername()
-	key := networkChange.GetTransaction().GetKey()
-	version := (int64)(networkChange.GetTransaction().GetVersion())
-	revision := (externalRef0.Revision)(networkChange.GetTransaction().GetRevision())
+	created := networkChange.GetCreated()
+	updated := networkChange.GetUpdated()
+	deleted := networkChange.GetDeleted()
+	username := networkChange.GetUsername()
+	key := networkChange.GetKey()
+	version := (int64)(networkChange.GetVersion())
+	revision := (externalRef0.Revision)(networkChange.GetRevision())
 
 	objMeta := struct {
 		Created  *time.Time             `json:"created,omitempty"`
@@ -128,44 +330,11 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	}
 
 	changeTrasactions := make(externalRef0.ChangeTransaction, 0)
-	if (networkChange.GetTransaction().GetChange() != nil) && (networkChange.GetTransaction().GetChange().Values != nil) {
-		for targetID, pathValues := range networkChange.GetTransaction().GetChange().Values {
-			pValues := make(externalRef0.PathValues, 0)
-			for targetName, pValue := range pathValues.GetValues() {
-				path := pValue.GetPath()
-				bytes := pValue.GetValue().Bytes
-				valueType := pValue.GetValue().Type.String()
-				var typeOpts []externalRef0.TypeOpts
-				for _, tOpts := range pValue.GetValue().TypeOpts {
-					typeOpts = append(typeOpts, (externalRef0.TypeOpts)(tOpts))
-				}
-				pDeleted := pValue.GetDeleted()
-
-				typedValue := new(externalRef0.TypedValue)
-				typedValue.Bytes = (*externalRef0.Bytes)(&bytes)
-				typedValue.TypeOpts = &typeOpts
-				typedValue.Type = (*externalRef0.ValueType)(&valueType)
-
-				pathValue := new(externalRef0.PathValue)
-				pathValue.Path = (*externalRef0.Path)(&path)
-				pathValue.Value = typedValue
-				pathValue.Deleted = (*externalRef0.Deleted)(&pDeleted)
-
-				tName := targetName
-				pTarget := new(externalRef0.PathTarget)
-				pTarget.Path = &tName
-				pTarget.PathValue = pathValue
-				pValues = append(pValues, *pTarget)
-			}
-			tID := targetID
-			cTarget := new(externalRef0.ChangeTarget)
-			cTarget.TargetName = (*string)(&tID)
-			cTarget.PathValues = &pValues
-			changeTrasactions = append(changeTrasactions, *cTarget)
-		}
+	if (networkChange.GetChange() != nil) && (networkChange.GetChange().Values != nil) {
+		changeTrasactions = changeTargets(networkChange.GetChange())
 	}
 
-	rollBackIndex := (externalRef0.Index)(networkChange.GetTransaction().GetRollback().GetRollbackIndex())
+	rollBackIndex := (externalRef0.Index)(networkChange.GetRollback().GetRollbackIndex())
 	rollback := externalRef0.RollbackTransaction{RollbackIndex: &rollBackIndex}
 
 	details := externalRef0.Details{
@@ -176,11 +345,11 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	var abort externalRef0.TransactionAbortPhase
 	var abortState string
 	var abortStatus externalRef0.TransactionPhaseStatus
-	if networkChange.GetTransaction().GetStatus().Phases.Abort != nil {
-		abortState = networkChange.GetTransaction().GetStatus().Phases.Abort.GetState().String()
+	if networkChange.GetStatus().Phases.Abort != nil {
+		abortState = networkChange.GetStatus().Phases.Abort.GetState().String()
 		abortStatus = externalRef0.TransactionPhaseStatus{
-			End:   (*externalRef0.End)(networkChange.GetTransaction().GetStatus().Phases.Abort.GetEnd()),
-			Start: (*externalRef0.Start)(networkChange.GetTransaction().GetStatus().Phases.Abort.GetStart()),
+			End:   (*externalRef0.End)(networkChange.GetStatus().Phases.Abort.GetEnd()),
+			Start: (*externalRef0.Start)(networkChange.GetStatus().Phases.Abort.GetStart()),
 		}
 		abort.State = (*externalRef0.AbortPhaseState)(&abortState)
 		abort.Status = &abortStatus
@@ -192,17 +361,17 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	var appFailType string
 	var appFailure externalRef0.Failure
 	var appStatus externalRef0.TransactionPhaseStatus
-	if (networkChange.GetTransaction().GetStatus().Phases.Apply != nil) && (networkChange.GetTransaction().GetStatus().Phases.Apply.Failure != nil) {
-		appFailDes = networkChange.GetTransaction().GetStatus().Phases.Apply.Failure.GetDescription()
-		appFailType = networkChange.GetTransaction().GetStatus().Phases.Apply.Failure.GetType().String()
+	if (networkChange.GetStatus().Phases.Apply != nil) && (networkChange.GetStatus().Phases.Apply.Failure != nil) {
+		appFailDes = networkChange.GetStatus().Phases.Apply.Failure.GetDescription()
+		appFailType = networkChange.GetStatus().Phases.Apply.Failure.GetType().String()
 		appFailure.Description = &appFailDes
 		appFailure.Type = (*externalRef0.FailureType)(&appFailType)
 	}
 
-	if networkChange.GetTransaction().GetStatus().Phases.Apply != nil {
-		applyState = networkChange.GetTransaction().GetStatus().Phases.Apply.GetState().String()
-		appStatus.Start = (*externalRef0.Start)(networkChange.GetTransaction().GetStatus().Phases.Apply.GetStart())
-		appStatus.End = (*externalRef0.End)(networkChange.GetTransaction().GetStatus().Phases.Apply.GetEnd())
+	if networkChange.GetStatus().Phases.Apply != nil {
+		applyState = networkChange.GetStatus().Phases.Apply.GetState().String()
+		appStatus.Start = (*externalRef0.Start)(networkChange.GetStatus().Phases.Apply.GetStart())
+		appStatus.End = (*externalRef0.End)(networkChange.GetStatus().Phases.Apply.GetEnd())
 		apply.Failure = &appFailure
 		apply.State = (*externalRef0.ApplyPhaseState)(&applyState)
 		apply.Status = &appStatus
@@ -211,10 +380,10 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	var commit externalRef0.TransactionCommitPhase
 	var commitState string
 	var comStatus externalRef0.TransactionPhaseStatus
-	if networkChange.GetTransaction().GetStatus().Phases.Commit != nil {
-		commitState = networkChange.GetTransaction().GetStatus().Phases.Commit.GetState().String()
-		comStatus.Start = (*externalRef0.Start)(networkChange.GetTransaction().GetStatus().Phases.Commit.GetStart())
-		comStatus.End = (*externalRef0.End)(networkChange.GetTransaction().GetStatus().Phases.Commit.GetEnd())
+	if networkChange.GetStatus().Phases.Commit != nil {
+		commitState = networkChange.GetStatus().Phases.Commit.GetState().String()
+		comStatus.Start = (*externalRef0.Start)(networkChange.GetStatus().Phases.Commit.GetStart())
+		comStatus.End = (*externalRef0.End)(networkChange.GetStatus().Phases.Commit.GetEnd())
 		commit.State = (*externalRef0.CommitPhaseState)(&commitState)
 		commit.Status = &comStatus
 	}
@@ -225,17 +394,17 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	var iniFailType string
 	var iniFailure externalRef0.Failure
 	var iniStatus externalRef0.TransactionPhaseStatus
-	if (networkChange.GetTransaction().GetStatus().Phases.Initialize != nil) && (networkChange.GetTransaction().GetStatus().Phases.Initialize.Failure != nil) {
-		iniFailDes = networkChange.GetTransaction().GetStatus().Phases.Initialize.Failure.GetDescription()
-		iniFailType = networkChange.GetTransaction().GetStatus().Phases.Initialize.Failure.GetType().String()
+	if (networkChange.GetStatus().Phases.Initialize != nil) && (networkChange.GetStatus().Phases.Initialize.Failure != nil) {
+		iniFailDes = networkChange.GetStatus().Phases.Initialize.Failure.GetDescription()
+		iniFailType = networkChange.GetStatus().Phases.Initialize.Failure.GetType().String()
 		iniFailure.Description = &iniFailDes
 		iniFailure.Type = (*externalRef0.FailureType)(&iniFailType)
 	}
 
-	if networkChange.GetTransaction().GetStatus().Phases.Initialize != nil {
-		initializeState = networkChange.GetTransaction().GetStatus().Phases.Initialize.GetState().String()
-		iniStatus.Start = (*externalRef0.Start)(networkChange.GetTransaction().GetStatus().Phases.Initialize.GetStart())
-		iniStatus.End = (*externalRef0.End)(networkChange.GetTransaction().GetStatus().Phases.Initialize.GetEnd())
+	if networkChange.GetStatus().Phases.Initialize != nil {
+		initializeState = networkChange.GetStatus().Phases.Initialize.GetState().String()
+		iniStatus.Start = (*externalRef0.Start)(networkChange.GetStatus().Phases.Initialize.GetStart())
+		iniStatus.End = (*externalRef0.End)(networkChange.GetStatus().Phases.Initialize.GetEnd())
 		initialize.Failure = &iniFailure
 		initialize.State = (*externalRef0.InitializePhaseState)(&initializeState)
 		initialize.Status = &iniStatus
@@ -247,17 +416,17 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	var valFailType string
 	var valFailure externalRef0.Failure
 	var valStatus externalRef0.TransactionPhaseStatus
-	if (networkChange.GetTransaction().GetStatus().Phases.Validate != nil) && (networkChange.GetTransaction().GetStatus().Phases.Validate.Failure != nil) {
-		valFailDes = networkChange.GetTransaction().GetStatus().Phases.Validate.Failure.GetDescription()
-		valFailType = networkChange.GetTransaction().GetStatus().Phases.Validate.Failure.GetType().String()
+	if (networkChange.GetStatus().Phases.Validate != nil) && (networkChange.GetStatus().Phases.Validate.Failure != nil) {
+		valFailDes = networkChange.GetStatus().Phases.Validate.Failure.GetDescription()
+		valFailType = networkChange.GetStatus().Phases.Validate.Failure.GetType().String()
 		valFailure.Description = &valFailDes
 		valFailure.Type = (*externalRef0.FailureType)(&valFailType)
 	}
 
-	if networkChange.GetTransaction().GetStatus().Phases.Validate != nil {
-		validateState = networkChange.GetTransaction().GetStatus().Phases.Validate.GetState().String()
-		valStatus.Start = (*externalRef0.Start)(networkChange.GetTransaction().GetStatus().Phases.Validate.GetStart())
-		valStatus.End = (*externalRef0.End)(networkChange.GetTransaction().GetStatus().Phases.Validate.GetEnd())
+	if networkChange.GetStatus().Phases.Validate != nil {
+		validateState = networkChange.GetStatus().Phases.Validate.GetState().String()
+		valStatus.Start = (*externalRef0.Start)(networkChange.GetStatus().Phases.Validate.GetStart())
+		valStatus.End = (*externalRef0.End)(networkChange.GetStatus().Phases.Validate.GetEnd())
 		validate.Failure = &valFailure
 		validate.State = (*externalRef0.ValidatePhaseState)(&validateState)
 		validate.Status = &valStatus
@@ -272,16 +441,16 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	}
 
 	proposals := make([]externalRef0.ProposalID, 0)
-	for _, pro := range networkChange.GetTransaction().GetStatus().Proposals {
+	for _, pro := range networkChange.GetStatus().Proposals {
 		proposals = append(proposals, (externalRef0.ProposalID)(pro))
 	}
 
-	state := networkChange.GetTransaction().GetStatus().State.String()
+	state := networkChange.GetStatus().State.String()
 
 	failure := externalRef0.Failure{}
-	if networkChange.GetTransaction().GetStatus().Failure != nil {
-		failureDescription := networkChange.GetTransaction().GetStatus().Failure.GetDescription()
-		failureType := networkChange.GetTransaction().GetStatus().Failure.GetType().String()
+	if networkChange.GetStatus().Failure != nil {
+		failureDescription := networkChange.GetStatus().Failure.GetDescription()
+		failureType := networkChange.GetStatus().Failure.GetType().String()
 		failure.Description = &failureDescription
 		failure.Type = (*externalRef0.FailureType)(&failureType)
 	}
@@ -293,8 +462,8 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 		State:     (*externalRef0.State)(&state),
 	}
 
-	isolation := networkChange.GetTransaction().Isolation.String()
-	synchronicity := networkChange.GetTransaction().Synchronicity.String()
+	isolation := networkChange.Isolation.String()
+	synchronicity := networkChange.Synchronicity.String()
 	strategy := externalRef0.Strategy{
 		Isolation:     (*externalRef0.Isolation)(&isolation),
 		Synchronicity: (*externalRef0.Synchronicity)(&synchronicity),
@@ -302,8 +471,8 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 
 	transaction := externalRef0.Transaction{
 		Details:  &details,
-		Id:       string(networkChange.GetTransaction().GetID()),
-		Index:    int64(networkChange.GetTransaction().GetIndex()),
+		Id:       string(networkChange.GetID()),
+		Index:    int64(networkChange.GetIndex()),
 		Meta:     objMeta,
 		Status:   &status,
 		Strategy: &strategy,
@@ -312,176 +481,1071 @@ func convertTrasaction(networkChange *admin.ListTransactionsResponse) externalRe
 	return transaction
 }
 
+// newTransactionInfo - the response to a change. Nil if no transaction was made
+func newTransactionInfo(info *configapi.TransactionInfo) *externalRef0.TransactionInfo {
+	if info == nil {
+		return nil
+	}
+	transactionInfo := &externalRef0.TransactionInfo{TransactionId: string(info.ID)}
+	if info.Index > 0 {
+		index := int64(info.Index)
+		transactionInfo.Index = &index
+	}
+	return transactionInfo
+}
+
+// revisionETag - a strong ETag for a revision (transaction index) of the configuration
+func revisionETag(index configapi.Index) string {
+	return fmt.Sprintf(`"%d"`, index)
+}
+
 // TopLevelServer -
 type TopLevelServer struct {
 	GnmiClient    southbound.GnmiClient
 	ConfigClient  admin.TransactionServiceClient
+	AdminClient   admin.ConfigAdminServiceClient
 	GnmiTimeout   time.Duration
 	Authorization bool
+	// GnmiEndpoint - the address of onos-config, as dialled by the manager
+	GnmiEndpoint string
+	// GnmiTimeoutMax - the longest timeout a request may ask for with X-Gnmi-Timeout, and that a Get
+	// that timed out is retried with. When it is less than GnmiTimeout, requests may only shorten the
+	// timeout, and Gets are not retried
+	GnmiTimeoutMax time.Duration
+	// HandlerTimeout - the longest a request may take, whatever its handler calls, before it gets 503.
+	// Never less than the request's gNMI timeout. 0 is unlimited
+	HandlerTimeout time.Duration
+	// GnmiMaxInFlight - the most gNMI Get and Set calls to onos-config at once, from all of the APIs.
+	// Calls that cannot start within southbound.DefaultSlotWait fail with 503. 0 is unlimited
+	GnmiMaxInFlight int
+	// GnmiRetries - how many times a gNMI Get is retried when onos-config is unavailable
+	GnmiRetries int
+	// TransactionsReadRate - max transactions per second read from onos-config. 0 is unlimited
+	TransactionsReadRate float64
+	LatencyStats         *metrics.LatencyStats
+	// Metrics - exported to Prometheus. Nothing is recorded when nil
+	Metrics *metrics.PrometheusMetrics
+	// TracerProvider - for the spans of requests and calls to onos-config. The global provider when nil
+	TracerProvider trace.TracerProvider
+	// JWKSURL - where the ID provider publishes its token signing keys. When empty, tokens are
+	// verified by onos-lib-go with the keys of OIDC_SERVER_URL
+	JWKSURL string
+	// JWKSCacheTTL - how long the keys from JWKSURL are used before they are fetched again. They are
+	// refreshed in the background before then, and kept if a fetch fails. 0 keeps them until a token
+	// has an unknown key ID
+	JWKSCacheTTL time.Duration
+	// JWTAudience - if set, tokens must have been issued for this audience
+	JWTAudience string
+	// ClientCertRoles - the groups (roles) of each identity of a client certificate, being its common
+	// name or a DNS, email or URI SAN. They authorize a request with a verified certificate and no token
+	ClientCertRoles map[string][]string
+	// ValidateRequests - check the whole of PATCH bodies against the schema, listing every error,
+	// before onos-config is called
+	ValidateRequests bool
+	// MaxBodyBytes - the largest PATCH body accepted. Default 4 MiB
+	MaxBodyBytes int64
+	// BodyContentTypes - the Content-Types allowed for PATCH and PUT bodies at /aether-roc-api. Any
+	// other is a 415. DefaultBodyContentTypes when empty
+	BodyContentTypes []string
+	// MaxStreams - the most transaction streams and path subscriptions open at once, each holding a
+	// watch or subscription on onos-config. Any more get 503. 0 is unlimited
+	MaxStreams int
+	streams    int32
+	// RoleRequirements - the roles each operation needs. DefaultRoleRequirements when nil
+	RoleRequirements RoleRequirements
+	jwksOnce         sync.Once
+	jwksKeys         *jwksCache
+	// RateLimit - requests per second allowed from each client IP, in bursts of up to RateBurst. 0 is unlimited
+	RateLimit float64
+	RateBurst int
+	// RateLimiterStore - the token buckets of the clients. In memory when nil
+	RateLimiterStore RateLimiterStore
+	rateLimiterOnce  sync.Once
+	// RedactPaths - gNMI paths, with * for any name or key, whose values are shown as **** in the
+	// configuration and transactions read. None are redacted when empty
+	RedactPaths  []string
+	redactorOnce sync.Once
+	redactPaths  *redactor
+	// TrustedProxies - the IPs or CIDRs of the proxies whose X-Forwarded-For gives the client IP
+	TrustedProxies []string
+	// DefaultTarget - the target of the reads, patches and deletes at /aether-roc-api that do not give
+	// one. When empty the target must always be given
+	DefaultTarget string
+	// TargetAliases - human readable names of targets, by target name, given as the DisplayName of
+	// the targets listed. Targets without one have no DisplayName
+	TargetAliases map[string]string
+	// PathPrefix - a gNMI path put before the paths read, patched and deleted at /aether-roc-api, for
+	// targets whose models are not at their root. Empty leaves the paths as they are given
+	PathPrefix      string
+	pathPrefixOnce  sync.Once
+	pathPrefixElems []*gnmi.PathElem
+	pathPrefixErr   error
+	// SkipTargetCheck - patch targets without first checking that onos-config knows them, for
+	// targets that are created on their first write
+	SkipTargetCheck bool
+	// TargetsCacheTTL - how long the list of targets is served from memory before it is fetched again.
+	// 0 fetches it on every request
+	TargetsCacheTTL time.Duration
+	targetsCache    targetsCache
+	// TransactionsCacheTTL - how long the list of transactions read for GET /transactions, and for the
+	// revision in the ETag of a read, is shared by the requests that follow, unless they ask for refresh.
+	// 0 reads it from onos-config for each request
+	TransactionsCacheTTL time.Duration
+	transactionsCache    transactionsCache
+	// revisions - the revision of each target, for the ETags and If-Match
+	revisions targetRevisions
+	// transactionRequests - the request ID of each transaction made through this instance
+	transactionRequests transactionRequests
+	// AuditSink - where an AuditEvent is sent for each change made. With Authorization on and no
+	// AuditSink, the events are written to stdout as JSON. Otherwise none are sent when nil
+	AuditSink        AuditSink
+	auditSinkOnce    sync.Once
+	defaultAuditSink AuditSink
+	// WebhookURLs - where each transaction is POSTed when it is applied. None are notified when empty
+	WebhookURLs []string
+	// SyncScheme and SyncPort - how the sdcore synchronize service is reached. Default http and 8080
+	SyncScheme string
+	SyncPort   uint
+	// SyncTLSConfig - client TLS settings for when SyncScheme is https
+	SyncTLSConfig *tls.Config
+	// SyncTimeout - how long to wait for the sdcore synchronize service. Default 30s
+	SyncTimeout time.Duration
+}
+
+// gnmiTimeout - the timeout of the request's calls to onos-config. GnmiTimeout unless it sent X-Gnmi-Timeout
+func (i *TopLevelServer) gnmiTimeout(ctx echo.Context) time.Duration {
+	return utils.RequestGnmiTimeout(ctx, i.GnmiTimeout, i.gnmiTimeoutMax())
+}
+
+// gnmiTimeoutMax - the longest timeout of a call to onos-config. GnmiTimeoutMax, or GnmiTimeout if that is longer
+func (i *TopLevelServer) gnmiTimeoutMax() time.Duration {
+	if i.GnmiTimeoutMax < i.GnmiTimeout {
+		return i.GnmiTimeout
+	}
+	return i.GnmiTimeoutMax
+}
+
+// syncURL - the synchronize endpoint of the sdcore service
+func (i *TopLevelServer) syncURL(service string) string {
+	scheme := i.SyncScheme
+	if scheme == "" {
+		scheme = defaultSyncScheme
+	}
+	port := i.SyncPort
+	if port == 0 {
+		port = defaultSyncPort
+	}
+	return fmt.Sprintf("%s://%s:%d/synchronize", scheme, service, port)
+}
+
+// syncTimeout - how long to wait for the sdcore synchronize service
+func (i *TopLevelServer) syncTimeout() time.Duration {
+	if i.SyncTimeout <= 0 {
+		return defaultSyncTimeout
+	}
+	return i.SyncTimeout
 }
 
-// PatchAetherRocAPI impl of gNMI access at /aether-roc-api
+// isSyncTimeout - whether the call failed because it ran out of time or the caller went away
+func isSyncTimeout(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+	timeoutErr, ok := err.(interface{ Timeout() bool })
+	return ok && timeoutErr.Timeout()
+}
+
+// syncClient - the HTTP client for calling the sdcore synchronize service
+func (i *TopLevelServer) syncClient() *http.Client {
+	client := &http.Client{Timeout: i.syncTimeout()}
+	if i.SyncTLSConfig != nil {
+		client.Transport = &http.Transport{TLSClientConfig: i.SyncTLSConfig}
+	}
+	return client
+}
+
+func (i *TopLevelServer) maxBodyBytes() int64 {
+	if i.MaxBodyBytes > 0 {
+		return i.MaxBodyBytes
+	}
+	return defaultMaxBodyBytes
+}
+
+// PatchAetherRocAPI impl of gNMI access at /aether-roc-api. The Updates of the PatchBody are gNMI
+// Set updates and its Deletes are Set deletes. Sent as application/merge-patch+json it is a JSON Merge
+// Patch (RFC 7386) instead: each null in Updates is a Set delete of its path, and everything else is
+// an update, leaving what is not given as it is
 func (i *TopLevelServer) PatchAetherRocAPI(ctx echo.Context) error {
 
 	var response interface{}
 	var err error
 
-	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.GnmiTimeout)
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
 	defer cancel()
 
 	// Response patched
-	body, err := utils.ReadRequestBody(ctx.Request().Body)
+	body, err := utils.ReadRequestBodyLimited(ctx.Request().Body, i.maxBodyBytes())
 	if err != nil {
 		return err
 	}
-	response, err = i.gnmiPatchAetherRocAPI(gnmiCtx, body, "/aether-roc-api")
+	if i.ValidateRequests {
+		if err = validatePatchBody(body); err != nil {
+			return err
+		}
+	}
+	// The If-Match is checked against every target the patch changes, once they are known
+	if dryRunParam := ctx.QueryParam("dryRun"); dryRunParam != "" {
+		dryRun, err := strconv.ParseBool(dryRunParam)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("dryRun must be true or false. Got %s", dryRunParam))
+		}
+		if dryRun {
+			changes, err := i.gnmiDryRunPatchAetherRocAPI(gnmiCtx, body, mergePatchDeletes(ctx)...)
+			if err != nil {
+				return utils.ConvertGrpcError(err)
+			}
+			targets := make([]configapi.TargetID, 0, len(changes))
+			for _, change := range changes {
+				targets = append(targets, configapi.TargetID(change.TargetId))
+			}
+			if err = i.checkIfMatch(ctx, gnmiCtx, targets...); err != nil {
+				return err
+			}
+			log.Debugf("PatchAetherRocAPI dry run by %s", requester(ctx))
+			return ctx.JSON(http.StatusOK, changes)
+		}
+	}
+	gnmiSet, targets, err := i.gnmiPatchSetRequest(gnmiCtx, body, mergePatchDeletes(ctx)...)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	if err = i.checkIfMatch(ctx, gnmiCtx, targets...); err != nil {
+		return err
+	}
+	auditCtx, changes := withAuditChanges(gnmiCtx)
+	transactionInfo, err := i.gnmiSet(auditCtx, gnmiSet)
 	if err != nil {
 		return utils.ConvertGrpcError(err)
 	}
+	i.audit(ctx, changes, AuditEvent{TransactionID: string(transactionInfo.ID)})
+	// The transaction can be followed with GetTransaction
+	response = newTransactionInfo(transactionInfo)
+	// The index of the transaction is the new revision - usable in a subsequent If-Match
+	if transactionInfo.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(transactionInfo.Index))
+	}
 	// It's not enough to check if response==nil - see https://medium.com/@glucn/golang-an-interface-holding-a-nil-value-is-not-nil-bb151f472cc7
 	if reflect.ValueOf(response).Kind() == reflect.Ptr && reflect.ValueOf(response).IsNil() {
 		return echo.NewHTTPError(http.StatusNotFound)
 	}
 
-	log.Infof("PatchAetherRocAPI")
+	log.Debugf("PatchAetherRocAPI by %s", requester(ctx))
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// PutAetherRocAPI - replace everything at path of the target with the PatchBody. Where PATCH merges
+// the body in to the configuration, leaving what it does not mention, PUT removes whatever under the
+// path is not in the body. The path may not be the root, so a whole target cannot be emptied by mistake
+func (i *TopLevelServer) PutAetherRocAPI(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	target, path := ctx.QueryParam("target"), ctx.QueryParam("path")
+	if path == "" || path == "/" {
+		return echo.NewHTTPError(http.StatusBadRequest, "path cannot be empty")
+	}
+	body, err := utils.ReadRequestBodyLimited(ctx.Request().Body, i.maxBodyBytes())
+	if err != nil {
+		return err
+	}
+	// Without a target query parameter, the body's default-target, else the server's
+	if target == "" {
+		if targets := patchTargets(body); len(targets) > 0 {
+			target = string(targets[0])
+		} else {
+			target = i.queryTarget(ctx)
+		}
+	}
+	if target == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "target cannot be empty")
+	}
+	if i.ValidateRequests {
+		if err = validatePatchBody(body); err != nil {
+			return err
+		}
+	}
+	if err = i.checkIfMatch(ctx, gnmiCtx, configapi.TargetID(target)); err != nil {
+		return err
+	}
+
+	auditCtx, changes := withAuditChanges(gnmiCtx)
+	transactionInfo, err := i.gnmiPutAetherRocAPI(auditCtx, body, target, path)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	i.audit(ctx, changes, AuditEvent{Target: target, Path: path, TransactionID: string(transactionInfo.ID)})
+	if transactionInfo.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(transactionInfo.Index))
+	}
+	log.Debugf("PutAetherRocAPI %s %s by %s", target, path, requester(ctx))
+	return ctx.JSON(http.StatusOK, newTransactionInfo(transactionInfo))
+}
+
+// GetAetherRocAPI -
+func (i *TopLevelServer) GetAetherRocAPI(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	target, path := i.queryTarget(ctx), ctx.QueryParam("path")
+	if target == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "target cannot be empty")
+	}
+	if path == "" {
+		path = "/"
+	}
+	encoding := gnmi.Encoding_PROTO
+	if encodingParam := ctx.QueryParam("encoding"); encodingParam != "" {
+		var ok bool
+		if encoding, ok = readEncodings[strings.ToUpper(encodingParam)]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("unsupported encoding %s. Only PROTO, JSON and JSON_IETF are supported", encodingParam))
+		}
+	}
+
+	// Response GET OK 200
+	response, err := i.gnmiGetPath(gnmiCtx, target, path, encoding)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	if response == nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	response = i.redactor().treeAt(response, i.prefixedPathString(path))
+	if target != "*" {
+		i.setRevisionETag(ctx, gnmiCtx, configapi.TargetID(target))
+	}
+	log.Debugf("GetAetherRocAPI %s %s by %s", target, path, requester(ctx))
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// DeleteAetherRocAPI -
+func (i *TopLevelServer) DeleteAetherRocAPI(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	target, path := i.queryTarget(ctx), ctx.QueryParam("path")
+	if target == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "target cannot be empty")
+	}
+	if path == "" || path == "/" {
+		return echo.NewHTTPError(http.StatusBadRequest, "path cannot be empty")
+	}
+	if err := i.checkIfMatch(ctx, gnmiCtx, configapi.TargetID(target)); err != nil {
+		return err
+	}
+
+	// Response deleted
+	auditCtx, changes := withAuditChanges(gnmiCtx)
+	transactionInfo, err := i.gnmiDeleteAetherRocAPI(auditCtx, target, path)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	i.audit(ctx, changes, AuditEvent{Target: target, Path: path, TransactionID: string(transactionInfo.ID)})
+	if transactionInfo.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(transactionInfo.Index))
+	}
+	log.Debugf("DeleteAetherRocAPI %s %s by %s", target, path, requester(ctx))
+	return ctx.JSON(http.StatusOK, newTransactionInfo(transactionInfo))
+}
+
 // GetTargets -
 func (i *TopLevelServer) GetTargets(ctx echo.Context) error {
 	var response interface{}
 	var err error
 
-	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.GnmiTimeout)
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	limitParam, offsetParam := ctx.QueryParam("limit"), ctx.QueryParam("offset")
+	limit, offset := 0, 0
+	if limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("limit must be a positive integer. Got %s", limitParam))
+		}
+	}
+	if offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err != nil || offset < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("offset must be a non-negative integer. Got %s", offsetParam))
+		}
+	}
+
+	refresh := false
+	if refreshParam := ctx.QueryParam("refresh"); refreshParam != "" {
+		if refresh, err = strconv.ParseBool(refreshParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("refresh must be true or false. Got %s", refreshParam))
+		}
+	}
+	envelope, err := wantsEnvelope(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Response GET OK 200
+	targets, err := i.getTargets(gnmiCtx, refresh)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	i.setRevisionETag(ctx, gnmiCtx)
+	log.Debugf("GetTargets by %s", requester(ctx))
+	if envelope {
+		start, end := pageBounds(len(*targets), limit, offset)
+		return sendPage(ctx, (*targets)[start:end], len(*targets), limit, offset)
+	}
+	response = targets
+	if limitParam != "" || offsetParam != "" {
+		response = paginateTargets(*targets, limit, offset)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// paginateTargets - the page of targets from offset. A limit of 0 means the rest of the targets
+func paginateTargets(targets externalRef0.TargetsNames, limit int, offset int) *externalRef0.PaginatedTargetsNames {
+	page := &externalRef0.PaginatedTargetsNames{
+		Targets: make(externalRef0.TargetsNames, 0),
+		Total:   len(targets),
+	}
+	start, end := pageBounds(len(targets), limit, offset)
+	if end < len(targets) {
+		page.Next = &end
+	}
+	page.Targets = append(page.Targets, targets[start:end]...)
+	return page
+}
+
+// GetTransaction -
+func (i *TopLevelServer) GetTransaction(ctx echo.Context, id string) error {
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "transaction id cannot be empty")
+	}
+
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
 	defer cancel()
 
 	// Response GET OK 200
-	response, err = i.gnmiGetTargets(gnmiCtx)
+	response, err := i.grpcGetTransaction(gnmiCtx, id)
 	if err != nil {
 		return utils.ConvertGrpcError(err)
 	}
-	log.Infof("GetTargets")
+	if response == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("transaction %s not found", id))
+	}
+	log.Debugf("GetTransaction %s by %s", id, requester(ctx))
+	if fields := ctx.QueryParam("fields"); fields != "" {
+		return ctx.JSON(http.StatusOK, projectTransaction(response, utils.ParseFieldMask(fields)))
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// acquireStream - take one of the MaxStreams. false if they are all in use
+func (i *TopLevelServer) acquireStream() bool {
+	if open := atomic.AddInt32(&i.streams, 1); i.MaxStreams > 0 && int(open) > i.MaxStreams {
+		atomic.AddInt32(&i.streams, -1)
+		return false
+	}
+	return true
+}
+
+func (i *TopLevelServer) releaseStream() {
+	atomic.AddInt32(&i.streams, -1)
+}
+
+// GetTransactionsStream - push each transaction to the client as a Server-Sent Event as onos-config reports it.
+// The stream is held open until the client goes away
+func (i *TopLevelServer) GetTransactionsStream(ctx echo.Context) error {
+	if !i.acquireStream() {
+		ctx.Response().Header().Set(headerRetryAfter, strconv.Itoa(int(streamRetryAfter.Seconds())))
+		return echo.NewHTTPError(http.StatusServiceUnavailable,
+			fmt.Sprintf("too many transaction streams open. The limit is %d", i.MaxStreams))
+	}
+	defer i.releaseStream()
+
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+
+	stream, err := i.ConfigClient.WatchTransactions(streamCtx, &admin.WatchTransactionsRequest{Noreplay: true})
+	if err != nil {
+		return utils.ConvertGrpcError(errors.FromGRPC(err))
+	}
+	log.Debugf("GetTransactionsStream opened by %s", requester(ctx))
+
+	events := make(chan *admin.WatchTransactionsResponse)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-streamCtx.Done():
+			log.Debugf("GetTransactionsStream closed by client")
+			return nil
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(resp, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			resp.Flush()
+		case event, ok := <-events:
+			if !ok {
+				if err = <-recvErr; err != io.EOF && streamCtx.Err() == nil {
+					log.Warnf("GetTransactionsStream ended %v", err)
+				}
+				return nil
+			}
+			transaction := networkChangeToTransaction(&event.Transaction)
+			i.withRequestID(&transaction)
+			i.redactor().transaction(&transaction)
+			data, err := json.Marshal(transaction)
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(resp, "id: %d\ndata: %s\n\n", transaction.Index, data); err != nil {
+				return nil
+			}
+			resp.Flush()
+		}
+	}
+}
+
+// GetTargetConfig -
+func (i *TopLevelServer) GetTargetConfig(ctx echo.Context, target string) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	// Response GET OK 200
+	response, err := i.gnmiGetTargetConfig(gnmiCtx, target)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	if response == nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	response = i.redactor().tree(response, nil)
+	log.Debugf("GetTargetConfig %s by %s", target, requester(ctx))
+
+	format := ctx.QueryParam("format")
+	if format == "" && strings.Contains(ctx.Request().Header.Get("Accept"), echo.MIMETextPlain) {
+		format = "cli"
+	}
+	switch format {
+	case "", "json":
+		return ctx.JSON(http.StatusOK, response)
+	case "cli":
+		return ctx.String(http.StatusOK, utils.RenderCLIConfig(response))
+	}
+	return echo.NewHTTPError(http.StatusBadRequest,
+		fmt.Sprintf("unsupported format %s. Only json and cli are supported", format))
+}
+
 // GetTransactions -
 func (i *TopLevelServer) GetTransactions(ctx echo.Context) error {
 	var response interface{}
 	var err error
 
-	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.GnmiTimeout)
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
 	defer cancel()
 
+	filter, err := newTransactionFilter(ctx.QueryParam("target"), ctx.QueryParam("phase"), ctx.QueryParam("state"))
+	if err == nil {
+		err = filter.setBounds(ctx.QueryParam("limit"), ctx.QueryParam("since"))
+	}
+	if err == nil {
+		err = filter.setCursor(ctx.QueryParam("cursor"))
+	}
+	if err == nil {
+		err = filter.setFailed(ctx.QueryParam("failed"))
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	refresh := false
+	if refreshParam := ctx.QueryParam("refresh"); refreshParam != "" {
+		if refresh, err = strconv.ParseBool(refreshParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("refresh must be true or false. Got %s", refreshParam))
+		}
+	}
+	gnmiCtx = withTransactionsCache(gnmiCtx, refresh)
+	envelope, err := wantsEnvelope(ctx)
+	if err != nil {
+		return err
+	}
+	offset := 0
+	if offsetParam := ctx.QueryParam("offset"); offsetParam != "" {
+		if offset, err = strconv.Atoi(offsetParam); err != nil || offset < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("offset must be a non-negative integer. Got %s", offsetParam))
+		}
+	}
+	// A page from an offset, or with its total, needs the whole of the matching history
+	listFilter := filter
+	paged := envelope || offset > 0
+	if paged {
+		all := *filter
+		all.limit = 0
+		listFilter = &all
+	}
+
+	if countParam := ctx.QueryParam("count"); countParam != "" {
+		countOnly, err := strconv.ParseBool(countParam)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("count must be true or false. Got %s", countParam))
+		}
+		if countOnly {
+			// The count is of all that match, not of a page of them
+			countFilter := *filter
+			countFilter.limit = 0
+			countFilter.after = 0
+			count, err := i.grpcCountTransactions(gnmiCtx, &countFilter)
+			if err != nil {
+				return utils.ConvertGrpcError(err)
+			}
+			log.Debugf("GetTransactions count %d by %s", count, requester(ctx))
+			return ctx.JSON(http.StatusOK, externalRef0.TransactionCount{Count: int64(count)})
+		}
+	}
+
+	// The raw form is for debugging the mapping in grpcGetTransactions and is restricted to admins
+	rawOnly := false
+	if rawParam := ctx.QueryParam("raw"); rawParam != "" {
+		if rawOnly, err = strconv.ParseBool(rawParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("raw must be true or false. Got %s", rawParam))
+		}
+	}
+	if rawOnly {
+		if i.Authorization {
+			if err = i.checkAuthorization(ctx, roleAdmin, adminGroup); err != nil {
+				return err
+			}
+		}
+		raw, err := i.grpcGetTransactionsRaw(gnmiCtx, listFilter)
+		if err != nil {
+			return utils.ConvertGrpcError(err)
+		}
+		total := len(raw)
+		if paged {
+			start, end := pageBounds(total, filter.limit, offset)
+			raw = raw[start:end]
+		}
+		if filter.full(len(raw)) && (!paged || offset+len(raw) < total) {
+			ctx.Response().Header().Set(headerNextCursor, transactionsCursor(raw[len(raw)-1].GetIndex()))
+		}
+		log.Debugf("GetTransactions raw by %s", requester(ctx))
+		if envelope {
+			return sendPage(ctx, i.redactor().rawTransactions(raw), total, filter.limit, offset)
+		}
+		response = i.redactor().rawTransactions(raw)
+		return ctx.JSON(http.StatusOK, response)
+	}
+
 	// Response GET OK 200
-	response, err = i.grpcGetTransactions(gnmiCtx)
+	transactions, err := i.grpcGetTransactions(gnmiCtx, listFilter)
 	if err != nil {
 		return utils.ConvertGrpcError(err)
 	}
-	log.Infof("GetTransactions")
+	total := len(*transactions)
+	if paged {
+		start, end := pageBounds(total, filter.limit, offset)
+		page := (*transactions)[start:end]
+		transactions = &page
+	}
+	response = transactions
+	// A full page may have more after it, resumed from the cursor. A page from an offset knows whether it does
+	if filter.full(len(*transactions)) && (!paged || offset+len(*transactions) < total) {
+		last := (*transactions)[len(*transactions)-1]
+		ctx.Response().Header().Set(headerNextCursor, transactionsCursor(configapi.Index(last.Index)))
+	}
+	if fields := ctx.QueryParam("fields"); fields != "" {
+		response = projectTransactions(*transactions, fields)
+	}
+	log.Debugf("GetTransactions by %s", requester(ctx))
+	if envelope {
+		return sendPage(ctx, response, total, filter.limit, offset)
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
 // PostSdcoreSynchronize -
 func (i *TopLevelServer) PostSdcoreSynchronize(httpContext echo.Context) error {
 
-	// Response GET OK 200
-	if i.Authorization {
-		if err := checkAuthorization(httpContext, "AetherROCAdmin"); err != nil {
-			return err
-		}
+	// The roles needed are checked by RBACMiddleware
+	address := i.syncURL(httpContext.Param("service"))
+	reqCtx := httpContext.Request().Context()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, address, nil)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("error creating request to %s. %v", address, err))
 	}
-
-	address := fmt.Sprintf("http://%s:8080/synchronize", httpContext.Param("service"))
-	resp, err := http.Post(address, "application/json", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp, err := i.syncClient().Do(req)
 	if err != nil {
+		if isSyncTimeout(reqCtx, err) {
+			return echo.NewHTTPError(http.StatusGatewayTimeout,
+				fmt.Sprintf("timed out calling %s after %v. %v", address, i.syncTimeout(), err))
+		}
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("error calling %s. %v", address, err))
 	}
+	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
+		if isSyncTimeout(reqCtx, err) {
+			return echo.NewHTTPError(http.StatusGatewayTimeout,
+				fmt.Sprintf("timed out reading body %s after %v. %v", address, i.syncTimeout(), err))
+		}
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("error reading body %s. %v", address, err))
 	}
 
-	log.Infof("PostSdcoreSynchronize to %s %s %s", httpContext.Param("service"), resp.Status, string(body))
+	if resp.StatusCode < http.StatusMultipleChoices {
+		i.audit(httpContext, nil, AuditEvent{Target: httpContext.Param("service")})
+	}
+	log.Debugf("PostSdcoreSynchronize to %s by %s %s %s", httpContext.Param("service"), requestUser(httpContext), resp.Status, string(body))
 	respStruct := struct {
 		Response string `json:"response"`
 	}{Response: string(body)}
 	return httpContext.JSON(resp.StatusCode, &respStruct)
 }
 
-// GetSpec -
-func (i *TopLevelServer) GetSpec(ctx echo.Context) error {
-	response, err := GetSwagger()
+// GetVersions -
+func (i *TopLevelServer) GetVersions(ctx echo.Context) error {
+	versions := make(externalRef0.ModelVersions, 0, len(modelVersions))
+	for _, mv := range modelVersions {
+		versions = append(versions, externalRef0.ModelVersion{
+			Version: mv.version,
+			Spec:    "/" + mv.specFile,
+			Api:     mv.apiPath(),
+		})
+	}
+	log.Debugf("GetVersions by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, versions)
+}
+
+// GetOperationPaths -
+func (i *TopLevelServer) GetOperationPaths(ctx echo.Context, version string) error {
+	for _, mv := range modelVersions {
+		if mv.version != version {
+			continue
+		}
+		swagger, err := mv.getSwagger()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+		response, err := operationPaths(swagger)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+		log.Debugf("GetOperationPaths %s by %s", version, requester(ctx))
+		return ctx.JSON(http.StatusOK, response)
+	}
+	return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown model version %s", version))
+}
+
+// AuthorizeCheck - an operation a user might make, as checked by PostAuthorizeCheck
+type AuthorizeCheck struct {
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+}
+
+// AuthorizeCheckResult - whether the user may make the operation, and why not
+type AuthorizeCheckResult struct {
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	Allowed   bool   `json:"allowed"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// PostAuthorizeCheck -
+func (i *TopLevelServer) PostAuthorizeCheck(ctx echo.Context) error {
+	body, err := utils.ReadRequestBody(ctx.Request().Body)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
+		return err
 	}
-	log.Infof("GetSpec")
-	return acceptTypes(ctx, response)
+	checks := make([]AuthorizeCheck, 0)
+	if err = json.Unmarshal(body, &checks); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unable to unmarshal JSON as a list of operation, path pairs. %v", err))
+	}
+
+	var groups []string
+	if i.Authorization {
+		if _, groups, err = i.parseAuthClaims(ctx); err != nil {
+			return err
+		}
+	}
+
+	results := make([]AuthorizeCheckResult, 0, len(checks))
+	for _, check := range checks {
+		result := AuthorizeCheckResult{
+			Operation: check.Operation,
+			Path:      check.Path,
+			Allowed:   true,
+		}
+		if i.Authorization {
+			route := operationRoute(ctx.Echo(), check.Operation, check.Path)
+			result.Allowed, result.Reason = i.authorizeOperation(groups, check.Operation, route, check.Path)
+		}
+		results = append(results, result)
+	}
+	log.Debugf("PostAuthorizeCheck %d operations by %s", len(checks), requester(ctx))
+	return ctx.JSON(http.StatusOK, results)
 }
 
-// GetAether200Spec -
-func (i *TopLevelServer) GetAether200Spec(ctx echo.Context) error {
-	response, err := aether_2_0_0.GetSwagger()
+// GetLatencyStats -
+func (i *TopLevelServer) GetLatencyStats(ctx echo.Context) error {
+	if i.LatencyStats == nil {
+		return echo.NewHTTPError(http.StatusNotImplemented, "latency stats are not being collected")
+	}
+	log.Debugf("GetLatencyStats by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, i.LatencyStats.Percentiles())
+}
+
+// GetReady - 200 if onos-config answers a gNMI Capabilities request, otherwise 503
+func (i *TopLevelServer) GetReady(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, readyTimeout)
+	defer cancel()
+
+	if _, err := i.GnmiClient.Capabilities(gnmiCtx, &gnmi.CapabilityRequest{}); err != nil {
+		log.Warnf("GetReady onos-config cannot be reached %v", err)
+		return echo.NewHTTPError(http.StatusServiceUnavailable, fmt.Sprintf("onos-config cannot be reached: %v", err))
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ready"})
+}
+
+// GetCapabilities - the models, encodings and gNMI version that onos-config supports
+func (i *TopLevelServer) GetCapabilities(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	capabilities, err := i.GnmiClient.Capabilities(gnmiCtx, &gnmi.CapabilityRequest{})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
+		return utils.ConvertGrpcError(err)
+	}
+	response := externalRef0.Capabilities{
+		GnmiVersion:        capabilities.GetGNMIVersion(),
+		SupportedEncodings: make([]string, 0, len(capabilities.GetSupportedEncodings())),
+		SupportedModels:    make([]externalRef0.SupportedModel, 0, len(capabilities.GetSupportedModels())),
+	}
+	for _, encoding := range capabilities.GetSupportedEncodings() {
+		response.SupportedEncodings = append(response.SupportedEncodings, encoding.String())
+	}
+	for _, model := range capabilities.GetSupportedModels() {
+		response.SupportedModels = append(response.SupportedModels, externalRef0.SupportedModel{
+			Name:         model.GetName(),
+			Organization: model.GetOrganization(),
+			Version:      model.GetVersion(),
+		})
 	}
-	return acceptTypes(ctx, response)
+	log.Debugf("GetCapabilities by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetHealthz - always 200 while the process is serving. Nothing is asked of onos-config, so that
+// the pod is not restarted just because onos-config is down - that is for GetReady to report
+func (i *TopLevelServer) GetHealthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// GetSpec -
+func (i *TopLevelServer) GetSpec(ctx echo.Context) error {
+	log.Debugf("GetSpec by %s", requester(ctx))
+	return serveSpec(ctx, topLevelSpec)
+}
+
+// GetAether200Spec -
+func (i *TopLevelServer) GetAether200Spec(ctx echo.Context) error {
+	return serveSpec(ctx, aether200Spec)
 }
 
 // GetAether400Spec -
 func (i *TopLevelServer) GetAether400Spec(ctx echo.Context) error {
-	response, err := aether_4_0_0.GetSwagger()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
-	}
-	return acceptTypes(ctx, response)
+	return serveSpec(ctx, aether400Spec)
 }
 
 // GetAetherAppGtwySpec -
 func (i *TopLevelServer) GetAetherAppGtwySpec(ctx echo.Context) error {
-	response, err := app_gtwy.GetSwagger()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
-	}
-	return acceptTypes(ctx, response)
+	return serveSpec(ctx, appGtwySpec)
 }
 
-func acceptTypes(ctx echo.Context, response *openapi3.T) error {
-	acceptType := ctx.Request().Header.Get("Accept")
+// GetAllSpecs - the spec of every model version in one object keyed by version, for UIs that
+// offer a choice of version
+func (i *TopLevelServer) GetAllSpecs(ctx echo.Context) error {
+	return serveSpec(ctx, allSpecs)
+}
 
-	if strings.Contains(acceptType, "application/json") {
-		return ctx.JSONPretty(http.StatusOK, response, "  ")
-	} else if strings.Contains(acceptType, "text/html") {
-		templateText, err := ioutil.ReadFile("assets/html-page.tpl")
+// GetConsolidatedSchema -
+func (i *TopLevelServer) GetConsolidatedSchema(ctx echo.Context) error {
+	specs := make(map[string]*openapi3.T)
+	for _, mv := range modelVersions {
+		swagger, err := mv.getSwagger()
 		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "unable to load template %s", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
 		}
-		specTemplate, err := htmltemplate.New("spectemplate").Parse(string(templateText))
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "error parsing template %s", err)
+		specs[mv.version] = swagger
+	}
+	log.Debugf("GetConsolidatedSchema by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, consolidateSchemas(specs))
+}
+
+// etagMatches - whether an If-None-Match header matches the etag, using the weak comparison
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
 		}
-		var b bytes.Buffer
-		_ = specTemplate.Execute(&b, HTMLData{
-			File:        ctx.Request().RequestURI[1:],
-			Description: "Aether ROC API",
-		})
-		ctx.Response().Header().Set("Content-Type", "text/html")
-		return ctx.HTMLBlob(http.StatusOK, b.Bytes())
-	} else if strings.Contains(acceptType, "application/yaml") || strings.Contains(acceptType, "*/*") {
-		jsonFirst, err := json.Marshal(response)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	return false
+}
+
+// acceptTypes - send a spec without caching its encodings
+func acceptTypes(ctx echo.Context, response *openapi3.T) error {
+	return serveSpec(ctx, newSpecCache(func() (*openapi3.T, error) { return response, nil }))
+}
+
+// serveSpec - send the spec in the type negotiated from the Accept header, encoded only the first
+// time that type is asked for. With a tag query parameter, only the operations with that tag are sent.
+// JSON is indented for people reading it in a browser, unless the pretty query parameter is false
+func serveSpec(ctx echo.Context, spec *specCache) error {
+	acceptType := ctx.Request().Header.Get("Accept")
+	if tag := ctx.QueryParam("tag"); tag != "" {
+		spec = spec.forTag(tag)
+	}
+	pretty := true
+	if prettyParam := ctx.QueryParam("pretty"); prettyParam != "" {
+		var err error
+		if pretty, err = strconv.ParseBool(prettyParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("pretty must be true or false. Got %s", prettyParam))
 		}
-		yamlResp, err := yaml.JSONToYAML(jsonFirst)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	var contentType, representation string
+	var encode func() ([]byte, error)
+	switch negotiateSpecType(acceptType) {
+	case mimeJSON:
+		contentType, representation, encode = echo.MIMEApplicationJSONCharsetUTF8, "json", spec.indentedJSON
+		if !pretty {
+			representation, encode = "json-compact", spec.compactJSON
 		}
-		ctx.Response().Header().Set("Content-Type", "application/yaml")
-		return ctx.HTMLBlob(http.StatusOK, yamlResp)
+	case mimeHTML:
+		contentType, representation = "text/html", "html"
+		encode = func() ([]byte, error) {
+			return specHTML(ctx)
+		}
+	case mimeXML:
+		contentType, representation, encode = echo.MIMEApplicationXML, "xml", spec.xmlBytes
+	case mimeYAML:
+		contentType, representation, encode = "application/yaml", "yaml", spec.yamlBytes
+	case mimeProtobuf:
+		contentType, representation, encode = mimeProtobuf, "protobuf", spec.protobufBytes
+	default:
+		return echo.NewHTTPError(http.StatusNotImplemented,
+			fmt.Sprintf("only application/yaml, application/json, application/xml, application/x-protobuf and text/html "+
+				"encoding supported. No match for %s", acceptType))
+	}
+	gzipped := strings.Contains(ctx.Request().Header.Get(echo.HeaderAcceptEncoding), "gzip")
+	if gzipped {
+		representation += "-gzip"
+	}
+
+	// Each type and encoding of the spec is a representation of its own, so has its own ETag
+	ctx.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	ctx.Response().Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+	etag, err := spec.eTag(representation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	ctx.Response().Header().Set(headerETag, etag)
+	if ifNoneMatch := ctx.Request().Header.Get(headerIfNoneMatch); ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	body, err := encode()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	return writeSpec(ctx, contentType, body, gzipped)
+}
+
+// specHTML - the page that shows a spec in the browser
+func specHTML(ctx echo.Context) ([]byte, error) {
+	templateText, err := ioutil.ReadFile("assets/html-page.tpl")
+	if err != nil {
+		return nil, fmt.Errorf("unable to load template %s", err)
+	}
+	specTemplate, err := htmltemplate.New("spectemplate").Parse(string(templateText))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template %s", err)
+	}
+	var b bytes.Buffer
+	_ = specTemplate.Execute(&b, HTMLData{
+		File:        ctx.Request().RequestURI[1:],
+		Description: "Aether ROC API",
+	})
+	return b.Bytes(), nil
+}
+
+// writeSpec - send the spec, gzipped if the client accepts it since the model specs are large.
+// For HEAD only the headers a GET would have are sent
+func writeSpec(ctx echo.Context, contentType string, body []byte, gzipped bool) error {
+	if !gzipped {
+		return writeSpecBody(ctx, contentType, body)
+	}
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	if _, err := gz.Write(body); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	if err := gz.Close(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentEncoding, "gzip")
+	return writeSpecBody(ctx, contentType, b.Bytes())
+}
+
+func writeSpecBody(ctx echo.Context, contentType string, body []byte) error {
+	if ctx.Request().Method != http.MethodHead {
+		return ctx.Blob(http.StatusOK, contentType, body)
 	}
-	return echo.NewHTTPError(http.StatusNotImplemented,
-		fmt.Sprintf("only application/yaml, application/json and text/html encoding supported. "+
-			"No match for %s", acceptType))
+	ctx.Response().Header().Set(echo.HeaderContentType, contentType)
+	ctx.Response().Header().Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+	ctx.Response().WriteHeader(http.StatusOK)
+	return nil
 }
 
 // register template override
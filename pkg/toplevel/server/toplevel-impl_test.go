@@ -5,15 +5,23 @@
 package server
 
 import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
 	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
 	v2 "github.com/onosproject/onos-api/go/onos/config/v2"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
 
-func Test_convertTrasaction(t *testing.T) {
+func Test_networkChangeToTransaction(t *testing.T) {
 
 	meta := v2.ObjectMeta{
 		Key:      "",
@@ -132,7 +140,7 @@ func Test_convertTrasaction(t *testing.T) {
 		Transaction: &v1,
 	}
 
-	ct := convertTrasaction(&t1)
+	ct := networkChangeToTransaction(t1.Transaction)
 	assert.NotNil(t, ct)
 	assert.Equal(t, "acbfu-23fgtj", ct.Id)
 	assert.Equal(t, int64(15), *ct.Meta.Version)
@@ -206,7 +214,7 @@ func Test_convertTrasaction(t *testing.T) {
 		Transaction: &v3,
 	}
 
-	ct1 := convertTrasaction(&t2)
+	ct1 := networkChangeToTransaction(t2.Transaction)
 	assert.NotNil(t, ct1)
 	assert.Equal(t, "acbfu-323fgtj", ct1.Id)
 	assert.Equal(t, int64(12), *ct1.Meta.Version)
@@ -214,8 +222,123 @@ func Test_convertTrasaction(t *testing.T) {
 
 	t3 := admin.ListTransactionsResponse{}
 
-	ct2 := convertTrasaction(&t3)
+	ct2 := networkChangeToTransaction(t3.Transaction)
 	assert.Equal(t, externalRef0.Transaction{}, ct2)
 	assert.Len(t, ct2.Id, 0)
 	assert.Nil(t, ct2.Status)
 }
+
+func Test_paginateTargets(t *testing.T) {
+	targets := make(externalRef0.TargetsNames, 0)
+	for _, name := range []string{"t1", "t2", "t3", "t4", "t5"} {
+		n := name
+		targets = append(targets, externalRef0.TargetName{Name: &n})
+	}
+
+	page := paginateTargets(targets, 2, 1)
+	assert.Equal(t, 5, page.Total)
+	assert.Len(t, page.Targets, 2)
+	assert.Equal(t, "t2", *page.Targets[0].Name)
+	assert.Equal(t, 3, *page.Next)
+
+	last := paginateTargets(targets, 2, 4)
+	assert.Len(t, last.Targets, 1)
+	assert.Nil(t, last.Next)
+
+	beyond := paginateTargets(targets, 2, 10)
+	assert.Len(t, beyond.Targets, 0)
+	assert.Equal(t, 5, beyond.Total)
+}
+
+func Test_syncURL(t *testing.T) {
+	assert.Equal(t, "http://sdcore-adapter-v4:8080/synchronize", (&TopLevelServer{}).syncURL("sdcore-adapter-v4"))
+
+	server := &TopLevelServer{SyncScheme: "https", SyncPort: 8443}
+	assert.Equal(t, "https://sdcore-adapter-v4:8443/synchronize", server.syncURL("sdcore-adapter-v4"))
+}
+
+func Test_PostSdcoreSynchronize_timeout(t *testing.T) {
+	release := make(chan struct{})
+	slowSync := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slowSync.Close()
+	defer close(release)
+	syncURL, err := url.Parse(slowSync.URL)
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(syncURL.Port())
+	assert.NoError(t, err)
+
+	server := &TopLevelServer{SyncPort: uint(port), SyncTimeout: 50 * time.Millisecond}
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/sdcore/synchronize/127.0.0.1", nil), httptest.NewRecorder())
+	ctx.SetParamNames("service")
+	ctx.SetParamValues(syncURL.Hostname())
+
+	err = server.PostSdcoreSynchronize(ctx)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusGatewayTimeout, httpErr.Code)
+}
+
+func Test_GetHealthz(t *testing.T) {
+	// No clients - there must be no backend calls
+	server := &TopLevelServer{}
+	rec := httptest.NewRecorder()
+	err := server.GetHealthz(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/healthz", nil), rec))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func Test_PatchAetherRocAPI_tooLarge(t *testing.T) {
+	server := &TopLevelServer{MaxBodyBytes: 16}
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(`{"default-target":"connectivity-service-v4"}`))
+	err := server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, httpErr.Code)
+
+	assert.Equal(t, int64(defaultMaxBodyBytes), (&TopLevelServer{}).maxBodyBytes())
+}
+
+func Test_GetVersions(t *testing.T) {
+	e := echo.New()
+	server := &TopLevelServer{}
+	assert.NoError(t, RegisterHandlers(e, server))
+	routes := make(map[string]bool)
+	for _, r := range e.Routes() {
+		routes[r.Method+" "+r.Path] = true
+	}
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, server.GetVersions(e.NewContext(httptest.NewRequest(http.MethodGet, "/versions", nil), rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var versions externalRef0.ModelVersions
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &versions))
+	assert.Equal(t, len(modelVersions), len(versions))
+	for _, v := range versions {
+		assert.True(t, routes["GET "+v.Spec], v.Spec)
+	}
+	assert.Equal(t, externalRef0.ModelVersion{
+		Version: "4.0.0",
+		Spec:    "/aether-4.0.0-openapi3.yaml",
+		Api:     "/aether/v4.0.0",
+	}, versions[1])
+}
+
+func Test_gnmiTimeout(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/targets", nil)
+	req.Header.Set(utils.HeaderGnmiTimeout, "5m")
+	ctx := e.NewContext(req, httptest.NewRecorder())
+
+	server := &TopLevelServer{GnmiTimeout: 10 * time.Second, GnmiTimeoutMax: 2 * time.Minute}
+	assert.Equal(t, 2*time.Minute, server.gnmiTimeout(ctx))
+
+	// With no maximum the header may only shorten the timeout
+	server = &TopLevelServer{GnmiTimeout: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, server.gnmiTimeout(ctx))
+	req.Header.Set(utils.HeaderGnmiTimeout, "3s")
+	assert.Equal(t, 3*time.Second, server.gnmiTimeout(ctx))
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// importContainers - the model versions of each top level container that can be imported, as in the
+// keys of Elements, e.g. site-4.0.0
+var importContainers = func() map[string][]string {
+	containers := make(map[string][]string)
+	elements := reflect.TypeOf(types.Elements{})
+	for idx := 0; idx < elements.NumField(); idx++ {
+		key := strings.Split(elements.Field(idx).Tag.Get("json"), ",")[0]
+		sep := strings.LastIndex(key, "-")
+		if sep <= 0 {
+			continue
+		}
+		containers[key[:sep]] = append(containers[key[:sep]], key[sep+1:])
+	}
+	return containers
+}()
+
+// PostTargetImport - apply a configuration file, as from GetTargetExport, to a target in one transaction.
+// It is merged with the configuration already there, or with replace=true the top level containers in
+// the file are replaced, everything else in them being removed
+func (i *TopLevelServer) PostTargetImport(ctx echo.Context, target string) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	replace := false
+	if replaceParam := ctx.QueryParam("replace"); replaceParam != "" {
+		var err error
+		if replace, err = strconv.ParseBool(replaceParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("replace must be true or false. Got %s", replaceParam))
+		}
+	}
+	body, err := utils.ReadRequestBodyLimited(ctx.Request().Body, i.maxBodyBytes())
+	if err != nil {
+		return err
+	}
+	patchBody, containers, err := importPatchBody(target, body, ctx.QueryParam("version"))
+	if err != nil {
+		return err
+	}
+	if err = validatePatchBody(patchBody); err != nil {
+		return err
+	}
+	if err = i.checkIfMatch(ctx, gnmiCtx, configapi.TargetID(target)); err != nil {
+		return err
+	}
+
+	auditCtx, changes := withAuditChanges(gnmiCtx)
+	transactionInfo, err := i.gnmiImportTarget(auditCtx, target, patchBody, containers, replace)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	i.audit(ctx, changes, AuditEvent{Target: target, TransactionID: string(transactionInfo.ID)})
+	if transactionInfo.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(transactionInfo.Index))
+	}
+	log.Debugf("PostTargetImport %s %v replace=%v by %s", target, containers, replace, requester(ctx))
+	return ctx.JSON(http.StatusOK, newTransactionInfo(transactionInfo))
+}
+
+// importPatchBody - the PatchBody that updates target with the configuration file, and the top level
+// containers in it. Where a container is in more than one model version, version picks which
+func importPatchBody(target string, body []byte, version string) ([]byte, []string, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("configuration file is not a JSON or YAML object %v", err))
+	}
+	if len(config) == 0 {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "configuration file is empty")
+	}
+
+	updates := make(map[string]interface{})
+	containers := make([]string, 0, len(config))
+	for field, value := range config {
+		container := elemName(field)
+		versions, ok := importContainers[container]
+		if !ok {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("%s is not a container of any model version", field))
+		}
+		containerVersion := version
+		if containerVersion == "" {
+			if len(versions) > 1 {
+				return nil, nil, echo.NewHTTPError(http.StatusBadRequest,
+					fmt.Sprintf("%s is in model versions %v. Give the version", container, versions))
+			}
+			containerVersion = versions[0]
+		}
+		updates[container+"-"+containerVersion] = value
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+
+	patchBody, err := json.Marshal(map[string]interface{}{
+		"default-target": target,
+		"Updates":        updates,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return patchBody, containers, nil
+}
+
+// gnmiImportTarget makes the updates of the PatchBody in one gNMI Set. To replace, the top level
+// containers are deleted in the same Set - gNMI applies the deletes before the updates. A field still
+// holding the redacted placeholder of an export is rejected with 400, naming it, and nothing is set. The
+// ID and index (revision) of the transaction are returned
+func (i *TopLevelServer) gnmiImportTarget(ctx context.Context, target string, body []byte, containers []string,
+	replace bool) (*configapi.TransactionInfo, error) {
+	// The configuration file is the whole of the target, as exported, so is not under PathPrefix
+	patchBody, err := i.preparePatch(ctx, body, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range patchBody.Updates {
+		if u.GetVal().GetStringVal() == redactedValue && i.redactor().matches(u.GetPath().GetElem()) {
+			return nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("%s was redacted on export. Give its value", entryPath(u.GetPath().GetElem())))
+		}
+	}
+	deletes := make([]*gnmi.Path, 0)
+	if replace {
+		for _, container := range containers {
+			deletes = append(deletes, &gnmi.Path{Target: target, Elem: []*gnmi.PathElem{{Name: container}}})
+		}
+	}
+	gnmiSet, err := utils.NewGnmiSetRequest(patchBody.Updates, deletes, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return i.gnmiSet(ctx, gnmiSet)
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const importSites = `
+site-4.0.0:site:
+  site:
+    - id: s1
+      display-name: Site 1
+      enterprise: e1
+`
+
+func Test_PostTargetImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var sets []*gnmi.SetRequest
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			sets = append(sets, request)
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		}).Times(2)
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{
+		GnmiClient:      mockClient,
+		GnmiTimeout:     time.Minute,
+		RedactPaths:     []string{"/site/site[id=*]/display-name"},
+		SkipTargetCheck: true,
+	}))
+	post := func(query string, contentType string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/targets/connectivity-service-v4/import"+query, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("", mimeYAML, importSites)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"transactionId":"tx-10"`)
+
+	rec = post("?replace=true", echo.MIMEApplicationJSON,
+		`{"site":{"site":[{"id":"s2","display-name":"Site 2","enterprise":"e1"}]}}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	assert.Len(t, sets, 2)
+	// Merged, in one Set of every leaf
+	assert.Empty(t, sets[0].Delete)
+	displayNames := make([]string, 0)
+	for _, set := range sets {
+		for _, u := range set.Update {
+			assert.Equal(t, "connectivity-service-v4", u.GetPath().GetTarget())
+			if u.GetPath().GetElem()[len(u.GetPath().GetElem())-1].GetName() == "display-name" {
+				displayNames = append(displayNames, u.GetVal().GetStringVal())
+			}
+		}
+	}
+	assert.Equal(t, []string{"Site 1", "Site 2"}, displayNames)
+	// Replaced, by deleting the container in the same Set
+	assert.Len(t, sets[1].Delete, 1)
+	deleted, err := ygot.PathToString(sets[1].Delete[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "/site", deleted)
+
+	for name, tc := range map[string]struct {
+		query   string
+		body    string
+		message string
+	}{
+		"empty":           {body: "{}"},
+		"not an object":   {body: "- site"},
+		"unknown":         {body: "not-a-container:\n  x: 1"},
+		"schema mismatch": {body: "site:\n  site:\n    - id: s3\n      display-name: [1, 2]"},
+		"redacted":        {body: strings.Replace(importSites, "Site 1", `"`+redactedValue+`"`, 1), message: "redacted on export"},
+		"replace":         {query: "?replace=maybe", body: importSites},
+	} {
+		rec = post(tc.query, mimeYAML, tc.body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, name)
+		assert.Contains(t, rec.Body.String(), tc.message, name)
+	}
+}
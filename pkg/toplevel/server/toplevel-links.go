@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"sort"
+)
+
+// transactionLinks derives the causal relationships between transactions.
+// A rollback transaction names the index of the transaction it rolls back, and a
+// change transaction supersedes the last successful earlier change on any of the same paths.
+// Only transactions that are related to another have an entry in the result.
+func transactionLinks(transactions []*configapi.Transaction) map[configapi.TransactionID]*externalRef0.TransactionLinks {
+	ordered := make([]*configapi.Transaction, 0, len(transactions))
+	byIndex := make(map[configapi.Index]configapi.TransactionID)
+	for _, t := range transactions {
+		if t == nil {
+			continue
+		}
+		ordered = append(ordered, t)
+		byIndex[t.Index] = t.ID
+	}
+	sort.Slice(ordered, func(a, b int) bool {
+		return ordered[a].Index < ordered[b].Index
+	})
+
+	links := make(map[configapi.TransactionID]*externalRef0.TransactionLinks)
+	linksOf := func(id configapi.TransactionID) *externalRef0.TransactionLinks {
+		l, ok := links[id]
+		if !ok {
+			l = &externalRef0.TransactionLinks{}
+			links[id] = l
+		}
+		return l
+	}
+
+	lastWriter := make(map[string]configapi.TransactionID)
+	for _, t := range ordered {
+		if rollback := t.GetRollback(); rollback != nil {
+			if rolledBack, ok := byIndex[rollback.RollbackIndex]; ok {
+				rollbackOf := string(rolledBack)
+				linksOf(t.ID).RollbackOf = &rollbackOf
+				rolledBackBy := string(t.ID)
+				linksOf(rolledBack).RolledBackBy = &rolledBackBy
+			}
+			continue
+		}
+		if t.GetChange() == nil || t.Status.State == configapi.TransactionStatus_FAILED {
+			continue
+		}
+		superseded := make(map[configapi.TransactionID]bool)
+		for targetID, pathValues := range t.GetChange().Values {
+			for path := range pathValues.GetValues() {
+				key := string(targetID) + ":" + path
+				if prev, ok := lastWriter[key]; ok && prev != t.ID {
+					superseded[prev] = true
+				}
+				lastWriter[key] = t.ID
+			}
+		}
+		if len(superseded) > 0 {
+			supersedes := make([]string, 0, len(superseded))
+			for id := range superseded {
+				supersedes = append(supersedes, string(id))
+			}
+			sort.Strings(supersedes)
+			linksOf(t.ID).Supersedes = &supersedes
+		}
+	}
+	return links
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func changeTransaction(id configapi.TransactionID, index configapi.Index, paths ...string) *configapi.Transaction {
+	values := make(map[string]*configapi.PathValue)
+	for _, p := range paths {
+		values[p] = &configapi.PathValue{Path: p}
+	}
+	return &configapi.Transaction{
+		ID:    id,
+		Index: index,
+		Details: &configapi.Transaction_Change{
+			Change: &configapi.ChangeTransaction{
+				Values: map[configapi.TargetID]*configapi.PathValues{
+					"connectivity-service-v4": {Values: values},
+				},
+			},
+		},
+	}
+}
+
+func Test_transactionLinks(t *testing.T) {
+	rollback := &configapi.Transaction{
+		ID:    "tx-4",
+		Index: 4,
+		Details: &configapi.Transaction_Rollback{
+			Rollback: &configapi.RollbackTransaction{RollbackIndex: 3},
+		},
+	}
+	failed := changeTransaction("tx-5", 5, "/a")
+	failed.Status.State = configapi.TransactionStatus_FAILED
+
+	links := transactionLinks([]*configapi.Transaction{
+		rollback,
+		changeTransaction("tx-3", 3, "/a", "/b"),
+		changeTransaction("tx-1", 1, "/a"),
+		changeTransaction("tx-2", 2, "/c"),
+		failed,
+		changeTransaction("tx-6", 6, "/a"),
+	})
+
+	assert.Len(t, links, 3)
+	_, ok := links["tx-1"]
+	assert.False(t, ok)
+	_, ok = links["tx-2"]
+	assert.False(t, ok)
+	assert.Equal(t, []string{"tx-1"}, *links["tx-3"].Supersedes)
+	assert.Equal(t, "tx-4", *links["tx-3"].RolledBackBy)
+	assert.Equal(t, "tx-3", *links["tx-4"].RollbackOf)
+	assert.Nil(t, links["tx-4"].Supersedes)
+	assert.Equal(t, []string{"tx-3"}, *links["tx-6"].Supersedes)
+	_, ok = links["tx-5"]
+	assert.False(t, ok)
+}
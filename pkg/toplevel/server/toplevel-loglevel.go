@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"net/http"
+	"strings"
+)
+
+// loggerNames - the loggers of aether-roc-api, whose levels can be changed at runtime. Every
+// logging.GetLogger of the packages must be here - Test_loggerNames checks that none is missed
+var loggerNames = []string{
+	"access",
+	"app_gtwy",
+	"gnmi_utils",
+	"main",
+	"manager",
+	"middleware/fieldmaskmw",
+	"middleware/openapi3mw",
+	"model_0_0_0",
+	"southbound",
+	"toplevel",
+	"webhook",
+}
+
+// logLevels - the levels that can be set
+var logLevels = map[string]logging.Level{
+	"debug": logging.DebugLevel,
+	"info":  logging.InfoLevel,
+	"warn":  logging.WarnLevel,
+	"error": logging.ErrorLevel,
+}
+
+// currentLogLevels - the effective level of each of the loggers
+func currentLogLevels() externalRef0.LogLevels {
+	levels := make(externalRef0.LogLevels, len(loggerNames))
+	for _, name := range loggerNames {
+		levels[name] = strings.ToLower(logging.GetLogger(name).GetLevel().String())
+	}
+	return levels
+}
+
+// GetLogLevel -
+func (i *TopLevelServer) GetLogLevel(ctx echo.Context) error {
+	log.Debugf("GetLogLevel by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, currentLogLevels())
+}
+
+// PutLogLevel - set the level of one logger, or of them all, until the process is restarted
+func (i *TopLevelServer) PutLogLevel(ctx echo.Context) error {
+	body, err := utils.ReadRequestBodyLimited(ctx.Request().Body, i.maxBodyBytes())
+	if err != nil {
+		return err
+	}
+	var logLevel externalRef0.LogLevel
+	if err = json.Unmarshal(body, &logLevel); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unable to unmarshal JSON as LogLevel %v", err))
+	}
+	level, ok := logLevels[strings.ToLower(logLevel.Level)]
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unknown log level %s. Valid values are debug, info, warn and error", logLevel.Level))
+	}
+
+	if logLevel.Logger == nil || *logLevel.Logger == "" {
+		logging.SetLevel(level)
+		// A logger that was given a level of its own would otherwise keep it
+		for _, name := range loggerNames {
+			logging.GetLogger(name).SetLevel(level)
+		}
+		log.Infof("Log level of all loggers set to %s by %s", level, requester(ctx))
+		return ctx.JSON(http.StatusOK, currentLogLevels())
+	}
+	for _, name := range loggerNames {
+		if name == *logLevel.Logger {
+			logging.GetLogger(name).SetLevel(level)
+			log.Infof("Log level of %s set to %s by %s", name, level, requester(ctx))
+			return ctx.JSON(http.StatusOK, currentLogLevels())
+		}
+	}
+	return echo.NewHTTPError(http.StatusBadRequest,
+		fmt.Sprintf("unknown logger %s. Valid values are %s", *logLevel.Logger, strings.Join(loggerNames, ", ")))
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// Test_loggerNames - each logger made by the packages can have its level changed
+func Test_loggerNames(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	root := filepath.Join(filepath.Dir(thisFile), "../../..")
+	getLogger := regexp.MustCompile(`logging\.GetLogger\(("[^)]*")\)`)
+	found := 0
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range getLogger.FindAllSubmatch(content, -1) {
+			parts := strings.Split(string(match[1]), ",")
+			for idx := range parts {
+				parts[idx] = strings.Trim(strings.TrimSpace(parts[idx]), `"`)
+			}
+			assert.Contains(t, loggerNames, strings.Join(parts, "/"), path)
+			found++
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, found)
+}
+
+func Test_PutLogLevel(t *testing.T) {
+	defer func() {
+		for _, name := range loggerNames {
+			logging.GetLogger(name).SetLevel(logging.InfoLevel)
+		}
+	}()
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{}))
+	call := func(method string, body string) (*httptest.ResponseRecorder, externalRef0.LogLevels) {
+		req := httptest.NewRequest(method, "/log-level", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		levels := make(externalRef0.LogLevels)
+		if rec.Code == http.StatusOK {
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &levels))
+		}
+		return rec, levels
+	}
+
+	rec, levels := call(http.MethodPut, `{"level":"info"}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Len(t, levels, len(loggerNames))
+
+	rec, levels = call(http.MethodPut, `{"level":"DEBUG","logger":"southbound"}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "debug", levels["southbound"])
+	assert.Equal(t, "info", levels["toplevel"])
+	assert.Equal(t, logging.DebugLevel, logging.GetLogger("southbound").GetLevel())
+
+	_, levels = call(http.MethodGet, "")
+	assert.Equal(t, "debug", levels["southbound"])
+
+	// All of them, including the one set on its own
+	_, levels = call(http.MethodPut, `{"level":"warn"}`)
+	for _, name := range loggerNames {
+		assert.Equal(t, "warn", levels[name], name)
+	}
+
+	rec, _ = call(http.MethodPut, `{"level":"verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec, _ = call(http.MethodPut, `{"level":"debug","logger":"no-such-logger"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "unknown logger")
+	rec, _ = call(http.MethodPut, `not json`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	mimeMergePatch = "application/merge-patch+json"
+	// mergePatchDeletesKey - where mergePatchMiddleware keeps the paths deleted by the patch
+	mergePatchDeletesKey = "mergePatchDeletes"
+	// additionalProperties - where mergePatch puts the unchanged fields it adds
+	additionalProperties = "additional-properties"
+)
+
+// mergePatchMiddleware - converts a JSON Merge Patch (RFC 7386) of PatchBody to the PatchBody of its
+// updates, so that the schema validation and the handler after it see an ordinary PatchBody. The
+// null values are taken out as the paths to delete, kept for mergePatchDeletes. Other bodies are
+// passed on as they are
+func mergePatchMiddleware(maxBodyBytes int64, elements *openapi3.SchemaRef) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+			if err != nil || mediaType != mimeMergePatch {
+				return next(ctx)
+			}
+			body, err := utils.ReadRequestBodyLimited(req.Body, maxBodyBytes)
+			if err != nil {
+				return err
+			}
+			jsonBody, deletes, err := mergePatch(body, elements)
+			if err != nil {
+				return err
+			}
+			ctx.Set(mergePatchDeletesKey, deletes)
+			req.Body = ioutil.NopCloser(bytes.NewReader(jsonBody))
+			req.ContentLength = int64(len(jsonBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			return next(ctx)
+		}
+	}
+}
+
+// mergePatchDeletes - the paths deleted by a JSON Merge Patch, if the request was one
+func mergePatchDeletes(ctx echo.Context) []*gnmi.Path {
+	deletes, _ := ctx.Get(mergePatchDeletesKey).([]*gnmi.Path)
+	return deletes
+}
+
+// mergePatch - the PatchBody of the values of the merge patch body, and the gNMI paths of its nulls.
+// Mandatory fields left out of a list entry are added to its unchanged, as a merge patch changes only
+// what it gives. A container or list entry left with nothing to update is dropped
+func mergePatch(body []byte, elements *openapi3.SchemaRef) ([]byte, []*gnmi.Path, error) {
+	var patchBody map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber() // So the key values are as they were given
+	if err := dec.Decode(&patchBody); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unable to unmarshal JSON merge patch %v", err))
+	}
+	target, _ := patchBody["default-target"].(string)
+	updates, ok := patchBody["Updates"].(map[string]interface{})
+	if !ok || elements == nil || elements.Value == nil {
+		return body, nil, nil
+	}
+
+	deletes := make([]*gnmi.Path, 0)
+	for _, field := range sortedFields(updates) {
+		propRef, ok := elements.Value.Properties[field]
+		if !ok || propRef.Value == nil {
+			continue // left for the schema validation to reject
+		}
+		// The fields of Elements are the top level containers suffixed by model version
+		container := field
+		if sep := strings.LastIndex(field, "-"); sep > 0 {
+			container = field[:sep]
+		}
+		path := []*gnmi.PathElem{{Name: container}}
+		switch value := updates[field].(type) {
+		case nil:
+			deletes = append(deletes, &gnmi.Path{Target: target, Elem: path})
+			delete(updates, field)
+		case map[string]interface{}:
+			if mergePatchObject(value, propRef.Value, path, nil, target, &deletes) {
+				delete(updates, field)
+			}
+		}
+	}
+	if len(updates) == 0 {
+		delete(patchBody, "Updates")
+	}
+
+	jsonBody, err := json.Marshal(patchBody)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return jsonBody, deletes, nil
+}
+
+// mergePatchObject - take the nulls out of an object of the merge patch, the schema of which is given,
+// adding their paths to deletes. Whether the object is left with nothing to update but its keys
+func mergePatchObject(object map[string]interface{}, schema *openapi3.Schema, path []*gnmi.PathElem,
+	keys []string, target string, deletes *[]*gnmi.Path) bool {
+	// An additional property of the object may give its target
+	for field, value := range object {
+		if additional, ok := value.(map[string]interface{}); ok && schema.Properties[field] == nil {
+			if objectTarget, ok := additional["target"].(string); ok && objectTarget != "" {
+				target = objectTarget
+			}
+		}
+	}
+	removed := false
+	for _, field := range sortedFields(object) {
+		propRef, ok := schema.Properties[field]
+		if !ok || propRef.Value == nil {
+			continue
+		}
+		fieldPath := append(append(make([]*gnmi.PathElem, 0, len(path)+1), path...), &gnmi.PathElem{Name: field})
+		switch value := object[field].(type) {
+		case nil:
+			*deletes = append(*deletes, &gnmi.Path{Target: target, Elem: fieldPath})
+			delete(object, field)
+			removed = true
+		case map[string]interface{}:
+			if mergePatchObject(value, propRef.Value, fieldPath, nil, target, deletes) {
+				delete(object, field)
+				removed = true
+			}
+		case []interface{}:
+			if propRef.Value.Items == nil || propRef.Value.Items.Value == nil {
+				continue
+			}
+			entryKeys := listKeys(propRef.Value)
+			entries := make([]interface{}, 0, len(value))
+			for _, item := range value {
+				entry, ok := item.(map[string]interface{})
+				if !ok {
+					entries = append(entries, item)
+					continue
+				}
+				entryPath := append(make([]*gnmi.PathElem, 0, len(fieldPath)), fieldPath...)
+				entryPath[len(entryPath)-1] = &gnmi.PathElem{Name: field, Key: entryKey(entry, entryKeys)}
+				if !mergePatchObject(entry, propRef.Value.Items.Value, entryPath, entryKeys, target, deletes) {
+					entries = append(entries, entry)
+				}
+			}
+			if len(entries) == 0 {
+				delete(object, field)
+				removed = true
+				continue
+			}
+			object[field] = entries
+		}
+	}
+
+	updated := 0
+	for field := range object {
+		if schema.Properties[field] != nil && !contains(keys, field) {
+			updated++
+		}
+	}
+	if removed && updated == 0 {
+		return true
+	}
+	markUnchanged(object, schema, keys)
+	return false
+}
+
+// markUnchanged - add the mandatory fields missing from the object to its unchanged additional property
+func markUnchanged(object map[string]interface{}, schema *openapi3.Schema, keys []string) {
+	if schema.AdditionalProperties == nil || schema.AdditionalProperties.Value == nil ||
+		schema.AdditionalProperties.Value.Properties["unchanged"] == nil {
+		return
+	}
+	unchanged := make([]string, 0)
+	for field, value := range object {
+		if additional, ok := value.(map[string]interface{}); ok && schema.Properties[field] == nil {
+			if given, ok := additional["unchanged"].(string); ok && given != "" {
+				unchanged = append(unchanged, strings.Split(given, ",")...)
+			}
+		}
+	}
+	added := false
+	for _, required := range schema.Required {
+		if _, ok := object[required]; ok || contains(keys, required) || contains(unchanged, required) {
+			continue
+		}
+		unchanged = append(unchanged, required)
+		added = true
+	}
+	if added {
+		object[additionalProperties] = map[string]interface{}{"unchanged": strings.Join(unchanged, ",")}
+	}
+}
+
+// listKeys - the key fields of a list, from the x-keys of its schema
+func listKeys(schema *openapi3.Schema) []string {
+	ext, ok := schema.Extensions["x-keys"]
+	if !ok {
+		return nil
+	}
+	raw, err := json.Marshal(ext)
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	if err = json.Unmarshal(raw, &keys); err != nil {
+		return nil
+	}
+	return keys
+}
+
+// entryKey - the gNMI key of a list entry
+func entryKey(entry map[string]interface{}, keys []string) map[string]string {
+	if len(keys) == 0 {
+		return nil
+	}
+	key := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if value, ok := entry[k]; ok {
+			key[k] = fmt.Sprintf("%v", value)
+		}
+	}
+	return key
+}
+
+func sortedFields(object map[string]interface{}) []string {
+	fields := make([]string, 0, len(object))
+	for field := range object {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
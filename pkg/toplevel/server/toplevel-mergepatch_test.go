@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_PatchAetherRocAPI_mergePatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var set *gnmi.SetRequest
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			set = request
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		})
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute,
+		SkipTargetCheck: true}))
+	patch := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, mimeMergePatch)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := patch(`{"default-target":"connectivity-service-v4","Updates":{
+"application-4.0.0":null,
+"site-4.0.0":{"site":[{"id":"s1","display-name":null,"description":"Site 1"},{"id":"s2","display-name":null}]}}}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"transactionId":"tx-10"`)
+
+	deletes := make([]string, 0)
+	for _, d := range set.Delete {
+		assert.Equal(t, "connectivity-service-v4", d.GetTarget())
+		path, err := ygot.PathToString(d)
+		assert.NoError(t, err)
+		deletes = append(deletes, path)
+	}
+	assert.Equal(t, []string{
+		"/application",
+		"/site/site[id=s1]/display-name",
+		"/site/site[id=s2]/display-name",
+	}, deletes)
+	// Only what was given is updated - not the mandatory enterprise, and nothing of s2
+	updates := make([]string, 0)
+	for _, u := range set.Update {
+		path, err := ygot.PathToString(&gnmi.Path{Elem: u.GetPath().GetElem()})
+		assert.NoError(t, err)
+		updates = append(updates, path)
+	}
+	assert.ElementsMatch(t, []string{"/site/site[id=s1]/id", "/site/site[id=s1]/description"}, updates)
+
+	rec = patch(`{"Updates":`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "JSON merge patch")
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// gnmiPathStartAt - the model specific handlers skip "", "aether", version and "{target}" in building the gNMI path
+const gnmiPathStartAt = 4
+
+// gnmiOperations - the gNMI call that each HTTP method of the model specific handlers makes
+var gnmiOperations = map[string]string{
+	http.MethodGet:    "Get",
+	http.MethodPost:   "Set (update)",
+	http.MethodDelete: "Set (delete)",
+}
+
+// OperationPath - the gNMI path template that an OpenAPI operation maps to
+type OperationPath struct {
+	OperationID   string `json:"operation-id"`
+	Method        string `json:"method"`
+	Path          string `json:"path"`
+	GnmiOperation string `json:"gnmi-operation"`
+	GnmiTarget    string `json:"gnmi-target"`
+	GnmiPath      string `json:"gnmi-path"`
+}
+
+// operationPaths maps each operation of a model's spec to its gNMI path template, in the same
+// way the generated handlers do. Path parameters are left as {placeholders}
+func operationPaths(spec *openapi3.T) ([]OperationPath, error) {
+	operations := make([]OperationPath, 0)
+	for path, pathItem := range spec.Paths {
+		parts := strings.Split(path, "/")
+		if len(parts) <= gnmiPathStartAt || parts[gnmiPathStartAt-1] != "{target}" {
+			continue
+		}
+		params := make([]string, 0)
+		for _, part := range parts[gnmiPathStartAt:] {
+			if strings.HasPrefix(part, "{") {
+				params = append(params, part)
+			}
+		}
+		elems, err := utils.BuildElems(path, gnmiPathStartAt, params...)
+		if err != nil {
+			return nil, err
+		}
+		gnmiPath, err := ygot.PathToString(&gnmi.Path{Elem: elems})
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert %s to a gNMI path %v", path, err)
+		}
+		for method, op := range pathItem.Operations() {
+			operations = append(operations, OperationPath{
+				OperationID:   op.OperationID,
+				Method:        method,
+				Path:          path,
+				GnmiOperation: gnmiOperations[method],
+				GnmiTarget:    "{target}",
+				GnmiPath:      gnmiPath,
+			})
+		}
+	}
+	sort.Slice(operations, func(a, b int) bool {
+		if operations[a].Path != operations[b].Path {
+			return operations[a].Path < operations[b].Path
+		}
+		return operations[a].Method < operations[b].Method
+	})
+	return operations, nil
+}
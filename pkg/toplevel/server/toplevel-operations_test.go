@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_operationPaths(t *testing.T) {
+	spec, err := aether_4_0_0.GetSwagger()
+	assert.NoError(t, err)
+
+	operations, err := operationPaths(spec)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, operations)
+
+	var found bool
+	for _, op := range operations {
+		if op.Path == "/aether/v4.0.0/{target}/application/application/{id}/endpoint/{endpoint-id}" && op.Method == "GET" {
+			found = true
+			assert.Equal(t, "Get", op.GnmiOperation)
+			assert.Equal(t, "/application/application[id={id}]/endpoint[endpoint-id={endpoint-id}]", op.GnmiPath)
+		}
+	}
+	assert.True(t, found)
+}
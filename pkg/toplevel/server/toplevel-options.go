@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// routeMethods - the methods of the routes registered for each path, gathered as they are registered
+// so that OPTIONS answers with what is really served
+type routeMethods map[string][]string
+
+// add - record the route. A nil route, as from a router that does not give them back, is left out
+func (r routeMethods) add(route *echo.Route) {
+	if route == nil {
+		return
+	}
+	r[route.Path] = append(r[route.Path], route.Method)
+}
+
+// registerOptions - an OPTIONS route for each path, answering 204 with an Allow header of the
+// methods registered for it, and OPTIONS itself
+func (r routeMethods) registerOptions(router EchoRouter) {
+	for path, methods := range r {
+		allowed := append([]string{http.MethodOptions}, methods...)
+		sort.Strings(allowed)
+		allow := strings.Join(allowed, ", ")
+		router.OPTIONS(path, func(ctx echo.Context) error {
+			ctx.Response().Header().Set(echo.HeaderAllow, allow)
+			return ctx.NoContent(http.StatusNoContent)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_OptionsAetherRocAPI(t *testing.T) {
+	e := echo.New()
+	server := &TopLevelServer{Authorization: true}
+	e.Use(server.RBACMiddleware())
+	assert.NoError(t, RegisterHandlers(e, server))
+
+	for path, allow := range map[string]string{
+		"/aether-roc-api":           "DELETE, GET, OPTIONS, PATCH, PUT",
+		"/aether-roc-api/batch":     "OPTIONS, PATCH",
+		"/aether-roc-api/diff":      "GET, OPTIONS",
+		"/aether-roc-api/subscribe": "GET, OPTIONS",
+	} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodOptions, path, nil))
+		// Answered without a token, as discovery needs none
+		assert.Equal(t, http.StatusNoContent, rec.Code, path)
+		assert.Equal(t, allow, rec.Header().Get(echo.HeaderAllow), path)
+	}
+}
+
+func Test_routeMethods(t *testing.T) {
+	methods := make(routeMethods)
+	methods.add(nil)
+	methods.add(&echo.Route{Method: http.MethodGet, Path: "/a"})
+	methods.add(&echo.Route{Method: http.MethodPut, Path: "/a"})
+	assert.Equal(t, routeMethods{"/a": {http.MethodGet, http.MethodPut}}, methods)
+}
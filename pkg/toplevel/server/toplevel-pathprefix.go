@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"net/http"
+	"strings"
+)
+
+// ParsePathPrefix - parse PathPrefix into its gNMI path elements. Called at startup, so that a prefix
+// that is not valid stops the server, else on first use
+func (i *TopLevelServer) ParsePathPrefix() error {
+	i.pathPrefixOnce.Do(func() {
+		if i.PathPrefix == "" || i.PathPrefix == "/" {
+			return
+		}
+		gnmiPath, err := ygot.StringToStructuredPath(i.PathPrefix)
+		if err == nil && !validBatchPath(gnmiPath) {
+			err = fmt.Errorf("empty element")
+		}
+		if err != nil {
+			i.pathPrefixErr = fmt.Errorf("invalid path prefix %s %v", i.PathPrefix, err)
+			return
+		}
+		i.pathPrefixElems = gnmiPath.GetElem()
+	})
+	return i.pathPrefixErr
+}
+
+// withPathPrefix - the paths with the elements of PathPrefix before their own. The paths given are
+// left as they are. With no PathPrefix they are returned as they are
+func (i *TopLevelServer) withPathPrefix(paths ...*gnmi.Path) ([]*gnmi.Path, error) {
+	if err := i.ParsePathPrefix(); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if len(i.pathPrefixElems) == 0 {
+		return paths, nil
+	}
+	prefixed := make([]*gnmi.Path, 0, len(paths))
+	for _, path := range paths {
+		elems := make([]*gnmi.PathElem, 0, len(i.pathPrefixElems)+len(path.GetElem()))
+		// Copied, so that nothing done with one request's path can change the prefix
+		for _, elem := range i.pathPrefixElems {
+			var key map[string]string
+			if len(elem.GetKey()) > 0 {
+				key = make(map[string]string, len(elem.GetKey()))
+				for k, v := range elem.GetKey() {
+					key[k] = v
+				}
+			}
+			elems = append(elems, &gnmi.PathElem{Name: elem.GetName(), Key: key})
+		}
+		prefixed = append(prefixed, &gnmi.Path{
+			Origin: path.GetOrigin(),
+			Target: path.GetTarget(),
+			Elem:   append(elems, path.GetElem()...),
+		})
+	}
+	return prefixed, nil
+}
+
+// prefixedPathString - path, in gNMI path string form, with PathPrefix before it
+func (i *TopLevelServer) prefixedPathString(path string) string {
+	if i.ParsePathPrefix() != nil || len(i.pathPrefixElems) == 0 {
+		return path
+	}
+	prefix, err := ygot.PathToString(&gnmi.Path{Elem: i.pathPrefixElems})
+	if err != nil {
+		return path
+	}
+	if path = strings.TrimPrefix(path, "/"); path == "" {
+		return prefix
+	}
+	return prefix + "/" + path
+}
+
+// withoutPathPrefix - the elements of a path from onos-config with those of PathPrefix taken off the
+// front, as the client gave it. A path not under PathPrefix is returned as it is
+func (i *TopLevelServer) withoutPathPrefix(elems []*gnmi.PathElem) []*gnmi.PathElem {
+	if i.ParsePathPrefix() != nil || len(i.pathPrefixElems) == 0 || !pathElemsUnder(elems, i.pathPrefixElems) {
+		return elems
+	}
+	return elems[len(i.pathPrefixElems):]
+}
+
+// parseBodyPrefix - the gNMI path of the prefix of a PatchBody, or nil when it has none. A prefix of "/"
+// is the same as none
+func parseBodyPrefix(prefix *string) (*gnmi.Path, error) {
+	if prefix == nil || *prefix == "" || *prefix == "/" {
+		return nil, nil
+	}
+	gnmiPath, err := ygot.StringToStructuredPath(*prefix)
+	if err == nil && !validBatchPath(gnmiPath) {
+		err = fmt.Errorf("empty element")
+	}
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid prefix %s %v", *prefix, err))
+	}
+	return gnmiPath, nil
+}
+
+// withBodyPrefix - the paths with the elements of the PatchBody prefix before their own, as gNMI joins
+// a prefix and path. Each joined path is checked to be one that can be given as a string. With no
+// prefix the paths are returned as they are
+func withBodyPrefix(prefix *gnmi.Path, paths ...*gnmi.Path) ([]*gnmi.Path, error) {
+	if prefix == nil {
+		return paths, nil
+	}
+	prefixed := make([]*gnmi.Path, 0, len(paths))
+	for _, path := range paths {
+		elems := make([]*gnmi.PathElem, 0, len(prefix.GetElem())+len(path.GetElem()))
+		joined := &gnmi.Path{
+			Origin: path.GetOrigin(),
+			Target: path.GetTarget(),
+			Elem:   append(append(elems, prefix.GetElem()...), path.GetElem()...),
+		}
+		if _, err := ygot.PathToString(joined); err != nil || !validBatchPath(joined) {
+			pathStr, _ := ygot.PathToString(&gnmi.Path{Elem: path.GetElem()})
+			prefixStr, _ := ygot.PathToString(prefix)
+			return nil, echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("%s under prefix %s is not a valid path", pathStr, prefixStr))
+		}
+		prefixed = append(prefixed, joined)
+	}
+	return prefixed, nil
+}
+
+// relativeToPrefix - sets the Prefix of the SetRequest, without a target as its paths may be of several,
+// and takes its elements off the front of the paths of the updates and deletes. Every path must be
+// under the prefix
+func relativeToPrefix(gnmiSet *gnmi.SetRequest, prefix []*gnmi.PathElem) error {
+	relative := func(path *gnmi.Path) (*gnmi.Path, error) {
+		if !pathElemsUnder(path.GetElem(), prefix) {
+			pathStr, _ := ygot.PathToString(&gnmi.Path{Elem: path.GetElem()})
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s is not under the prefix", pathStr))
+		}
+		return &gnmi.Path{Origin: path.GetOrigin(), Target: path.GetTarget(), Elem: path.GetElem()[len(prefix):]}, nil
+	}
+	var err error
+	for _, u := range append(gnmiSet.GetUpdate(), gnmiSet.GetReplace()...) {
+		if u.Path, err = relative(u.GetPath()); err != nil {
+			return err
+		}
+	}
+	for idx, path := range gnmiSet.GetDelete() {
+		if gnmiSet.Delete[idx], err = relative(path); err != nil {
+			return err
+		}
+	}
+	gnmiSet.Prefix = &gnmi.Path{Elem: prefix}
+	return nil
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_withPathPrefix(t *testing.T) {
+	path := &gnmi.Path{Target: "connectivity-service-v4", Elem: []*gnmi.PathElem{{Name: "site"}}}
+
+	// No prefix leaves the paths as they are
+	for _, prefix := range []string{"", "/"} {
+		server := &TopLevelServer{PathPrefix: prefix}
+		assert.NilError(t, server.ParsePathPrefix())
+		paths, err := server.withPathPrefix(path)
+		assert.NilError(t, err)
+		assert.Assert(t, paths[0] == path)
+		assert.Equal(t, "/site", server.prefixedPathString("/site"))
+	}
+
+	server := &TopLevelServer{PathPrefix: "/config/tenant[name=t1]"}
+	assert.NilError(t, server.ParsePathPrefix())
+	paths, err := server.withPathPrefix(path)
+	assert.NilError(t, err)
+	assert.Equal(t, "connectivity-service-v4", paths[0].Target)
+	assert.Equal(t, 3, len(paths[0].Elem))
+	assert.Equal(t, "config", paths[0].Elem[0].Name)
+	assert.Equal(t, "t1", paths[0].Elem[1].Key["name"])
+	assert.Equal(t, "site", paths[0].Elem[2].Name)
+	assert.Equal(t, 1, len(path.Elem))
+	assert.Equal(t, "/config/tenant[name=t1]/site", server.prefixedPathString("/site"))
+	assert.Equal(t, "/config/tenant[name=t1]", server.prefixedPathString("/"))
+
+	// The prefix is not changed through the paths it was put on
+	paths[0].Elem[1].Key["name"] = "t2"
+	assert.Equal(t, "t1", server.pathPrefixElems[1].Key["name"])
+
+	server = &TopLevelServer{PathPrefix: "/config//site"}
+	assert.ErrorContains(t, server.ParsePathPrefix(), "invalid path prefix /config//site")
+	_, err = server.withPathPrefix(path)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+}
+
+func Test_GetAetherRocAPI_pathPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+			assert.Equal(t, "connectivity-service-v4", request.Path[0].Target)
+			assert.Equal(t, 3, len(request.Path[0].Elem))
+			assert.Equal(t, "config", request.Path[0].Elem[0].Name)
+			assert.Equal(t, "s1", request.Path[0].Elem[2].Key["id"])
+			return &gnmi.GetResponse{Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+				Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"id":"s1","secret":"x"}`)}},
+			}}}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, PathPrefix: "/config",
+		RedactPaths: []string{"/config/site/site[id=*]/secret"}}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/aether-roc-api?target=connectivity-service-v4&path=/site/site[id=s1]", nil)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.GetAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"id":"s1","secret":"****"}`, strings.TrimSpace(rec.Body.String()))
+}
+
+func Test_PatchAetherRocAPI_pathPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			assert.Assert(t, len(request.Update) > 0)
+			for _, update := range request.Update {
+				assert.Equal(t, "config", update.Path.Elem[0].Name)
+				assert.Equal(t, "site", update.Path.Elem[1].Name)
+			}
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true,
+		PathPrefix: "/config"}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.PatchAetherRocAPI(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_PatchAetherRocAPI_bodyPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			// The PathPrefix and the prefix of the body are the prefix of the Set
+			assert.Equal(t, 2, len(request.Prefix.Elem))
+			assert.Equal(t, "config", request.Prefix.Elem[0].Name)
+			assert.Equal(t, "aether", request.Prefix.Elem[1].Name)
+			assert.Assert(t, len(request.Update) > 0)
+			for _, update := range request.Update {
+				assert.Equal(t, "site", update.Path.Elem[0].Name)
+				assert.Equal(t, "connectivity-service-v4", update.Path.Target)
+			}
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-11"),
+				}},
+			}}}, nil
+		},
+	)
+	sink := &recordingAuditSink{}
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true,
+		PathPrefix: "/config", AuditSink: sink}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4","prefix":"/aether",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+	ctx.SetPath("/aether-roc-api")
+	assert.NilError(t, server.PatchAetherRocAPI(ctx))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The audit has the paths whole
+	assert.Equal(t, 1, len(sink.events))
+	for _, change := range sink.events[0].Changes {
+		assert.Assert(t, strings.HasPrefix(change.Path, "/config/aether/site/"), change.Path)
+	}
+}
+
+func Test_PatchAetherRocAPI_invalidBodyPrefix(t *testing.T) {
+	server := &TopLevelServer{SkipTargetCheck: true}
+	for _, prefix := range []string{"site[", "/aether//site"} {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+			`{"default-target":"connectivity-service-v4","prefix":"`+prefix+`",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+		err := server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+		httpErr, ok := err.(*echo.HTTPError)
+		assert.Assert(t, ok, prefix)
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code, prefix)
+	}
+}
+
+func Test_relativeToPrefix(t *testing.T) {
+	prefix := []*gnmi.PathElem{{Name: "config"}}
+	set := &gnmi.SetRequest{
+		Delete: []*gnmi.Path{{Target: "t1", Elem: []*gnmi.PathElem{{Name: "config"}, {Name: "site"}}}},
+		Update: []*gnmi.Update{{Path: &gnmi.Path{Target: "t1", Elem: []*gnmi.PathElem{{Name: "config"}, {Name: "upf"}}}}},
+	}
+	assert.NilError(t, relativeToPrefix(set, prefix))
+	assert.Equal(t, "config", set.Prefix.Elem[0].Name)
+	assert.Equal(t, "", set.Prefix.Target)
+	assert.Equal(t, "site", set.Delete[0].Elem[0].Name)
+	assert.Equal(t, "t1", set.Delete[0].Target)
+	assert.Equal(t, "upf", set.Update[0].Path.Elem[0].Name)
+
+	// A path not under the prefix cannot be sent with it
+	set = &gnmi.SetRequest{Delete: []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "site"}}}}}
+	assert.ErrorContains(t, relativeToPrefix(set, prefix), "not under the prefix")
+}
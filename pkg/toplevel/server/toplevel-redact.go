@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/gogo/protobuf/proto"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strings"
+)
+
+// redactedValue - what a redacted value is replaced with
+const redactedValue = "****"
+
+// redactor - replaces the values under any of a list of paths with redactedValue. Each pattern is a gNMI
+// path, e.g. /sim-card/sim-card[sim-id=*]/k, where * matches any element name or key value, and an
+// element with no keys matches every list entry. A pattern also matches everything under it
+type redactor struct {
+	patterns [][]*gnmi.PathElem
+}
+
+// newRedactor - a redactor of the paths. Invalid patterns are logged and left out
+func newRedactor(paths []string) *redactor {
+	r := &redactor{}
+	for _, path := range paths {
+		gnmiPath, err := ygot.StringToStructuredPath(path)
+		if err != nil || len(gnmiPath.GetElem()) == 0 {
+			log.Warnf("Ignoring invalid redact path %s %v", path, err)
+			continue
+		}
+		r.patterns = append(r.patterns, gnmiPath.GetElem())
+	}
+	return r
+}
+
+// redactor - the redactor of RedactPaths, created on first use. nil if there are none
+func (i *TopLevelServer) redactor() *redactor {
+	i.redactorOnce.Do(func() {
+		if len(i.RedactPaths) > 0 {
+			i.redactPaths = newRedactor(i.RedactPaths)
+		}
+	})
+	return i.redactPaths
+}
+
+// matches - whether the path is at or under one of the patterns
+func (r *redactor) matches(path []*gnmi.PathElem) bool {
+	if r == nil {
+		return false
+	}
+	for _, pattern := range r.patterns {
+		if redactPatternMatches(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactPatternMatches(pattern []*gnmi.PathElem, path []*gnmi.PathElem) bool {
+	if len(path) < len(pattern) {
+		return false
+	}
+	for idx, want := range pattern {
+		got := path[idx]
+		if want.GetName() != "*" && want.GetName() != got.GetName() {
+			return false
+		}
+		for key, value := range want.GetKey() {
+			gotValue, ok := got.GetKey()[key]
+			if !ok || (value != "*" && value != gotValue) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchesPathString - whether the path, in gNMI path string form, is to be redacted
+func (r *redactor) matchesPathString(path string) bool {
+	if r == nil {
+		return false
+	}
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		// A path that cannot be checked is redacted rather than risk showing it
+		return true
+	}
+	return r.matches(gnmiPath.GetElem())
+}
+
+// tree - the configuration read at path as a decoded JSON tree (or a scalar) with the values under the
+// patterns redacted. The entries of a list are matched by the values of their fields, as the keys of
+// a list are not known without the schema
+func (r *redactor) tree(value interface{}, path []*gnmi.PathElem) interface{} {
+	if r == nil {
+		return value
+	}
+	if r.matches(path) {
+		return redactedValue
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, child := range v {
+			childPath := append(append(make([]*gnmi.PathElem, 0, len(path)+1), path...), &gnmi.PathElem{Name: elemName(field)})
+			v[field] = r.tree(child, childPath)
+		}
+		return v
+	case []interface{}:
+		for idx, item := range v {
+			v[idx] = r.tree(item, listEntryPath(path, item))
+		}
+		return v
+	}
+	return value
+}
+
+// treeAt - tree, for the configuration read at path in gNMI path string form
+func (r *redactor) treeAt(value interface{}, path string) interface{} {
+	if r == nil {
+		return value
+	}
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return redactedValue
+	}
+	return r.tree(value, gnmiPath.GetElem())
+}
+
+// elemName - the path element name of a JSON field. JSON_IETF names are prefixed with their module
+func elemName(field string) string {
+	if idx := strings.LastIndex(field, ":"); idx >= 0 {
+		return field[idx+1:]
+	}
+	return field
+}
+
+// listEntryPath - the path of an entry of the list at path, keyed by the scalar fields of the entry
+func listEntryPath(path []*gnmi.PathElem, item interface{}) []*gnmi.PathElem {
+	fields, ok := item.(map[string]interface{})
+	if !ok || len(path) == 0 {
+		return path
+	}
+	last := path[len(path)-1]
+	keys := make(map[string]string)
+	for key, value := range last.GetKey() {
+		keys[key] = value
+	}
+	for name, value := range fields {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}, nil:
+			continue
+		}
+		keys[elemName(name)] = fmt.Sprintf("%v", value)
+	}
+	entryPath := append(make([]*gnmi.PathElem, 0, len(path)), path[:len(path)-1]...)
+	return append(entryPath, &gnmi.PathElem{Name: last.GetName(), Key: keys})
+}
+
+// transaction - redact the change values of a transaction
+func (r *redactor) transaction(transaction *externalRef0.Transaction) {
+	if r == nil || transaction.Details == nil || transaction.Details.Change == nil {
+		return
+	}
+	for _, changeTarget := range *transaction.Details.Change {
+		if changeTarget.PathValues == nil {
+			continue
+		}
+		for _, pathTarget := range *changeTarget.PathValues {
+			pathValue := pathTarget.PathValue
+			if pathValue == nil || pathValue.Path == nil || pathValue.Value == nil || pathValue.Value.Bytes == nil {
+				continue
+			}
+			if r.matchesPathString(string(*pathValue.Path)) {
+				redacted := externalRef0.Bytes(redactedValue)
+				pathValue.Value.Bytes = &redacted
+			}
+		}
+	}
+}
+
+// rawTransactions - as sent by onos-config, but with the change values redacted. A transaction with a
+// value to redact is copied, so that the one passed in is left as it is
+func (r *redactor) rawTransactions(transactions []*configapi.Transaction) []*configapi.Transaction {
+	if r == nil {
+		return transactions
+	}
+	redacted := make([]*configapi.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		copied := false
+		for targetID, pathValues := range t.GetChange().GetValues() {
+			for key, pathValue := range pathValues.GetValues() {
+				if pathValue == nil || len(pathValue.Value.Bytes) == 0 || !r.matchesPathString(pathValue.GetPath()) {
+					continue
+				}
+				if !copied {
+					t = proto.Clone(t).(*configapi.Transaction)
+					copied = true
+				}
+				t.GetChange().Values[targetID].Values[key].Value.Bytes = []byte(redactedValue)
+			}
+		}
+		redacted = append(redacted, t)
+	}
+	return redacted
+}
+
+// diff - redact the values of a config diff
+func (r *redactor) diff(diff *externalRef0.ConfigDiff) {
+	if r == nil {
+		return
+	}
+	for _, values := range [][]externalRef0.ChangeValue{diff.Added, diff.Modified, diff.Removed} {
+		for idx := range values {
+			if values[idx].Value != nil && r.matchesPathString(values[idx].Path) {
+				redacted := redactedValue
+				values[idx].Value = &redacted
+			}
+		}
+	}
+}
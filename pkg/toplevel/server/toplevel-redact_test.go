@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const redactSecret = "s3cr3t-k"
+
+func Test_redactor_matchesPathString(t *testing.T) {
+	r := newRedactor([]string{"/sim-card/sim-card[sim-id=*]/k", "/*/password", "/site/site[id=s1]/secret", "/"})
+	assert.Len(t, r.patterns, 3)
+
+	assert.True(t, r.matchesPathString("/sim-card/sim-card[sim-id=sim1]/k"))
+	assert.True(t, r.matchesPathString("/sim-card/sim-card[sim-id=sim1]/k/sub"))
+	assert.True(t, r.matchesPathString("/user/password"))
+	assert.True(t, r.matchesPathString("/site/site[id=s1]/secret"))
+	assert.False(t, r.matchesPathString("/site/site[id=s2]/secret"))
+	assert.False(t, r.matchesPathString("/sim-card/sim-card[sim-id=sim1]/imsi"))
+	assert.False(t, r.matchesPathString("/sim-card/k"))
+
+	var none *redactor
+	assert.False(t, none.matchesPathString("/user/password"))
+	assert.Nil(t, (&TopLevelServer{}).redactor())
+}
+
+func Test_redactor_tree(t *testing.T) {
+	r := newRedactor([]string{"/sim-card/sim-card[sim-id=*]/k", "/site/site[id=s1]/secret"})
+	var config interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"sim-card": {"sim-card": [{"sim-id": "sim1", "imsi": "001", "k": "`+redactSecret+`"}]},
+		"site:site": {"site": [
+			{"id": "s1", "secret": "`+redactSecret+`"},
+			{"id": "s2", "secret": "public"}
+		]}
+	}`), &config))
+
+	redacted, err := json.Marshal(r.tree(config, nil))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(redacted), redactSecret)
+	assert.Contains(t, string(redacted), `"k":"****"`)
+	assert.Contains(t, string(redacted), `"imsi":"001"`)
+	assert.Contains(t, string(redacted), `"secret":"public"`)
+
+	// Read at a path under the pattern
+	assert.Equal(t, redactedValue, r.treeAt(redactSecret, "/sim-card/sim-card[sim-id=sim1]/k"))
+	assert.Equal(t, "001", r.treeAt("001", "/sim-card/sim-card[sim-id=sim1]/imsi"))
+
+	var none *redactor
+	assert.Equal(t, redactSecret, none.treeAt(redactSecret, "/sim-card/sim-card[sim-id=sim1]/k"))
+}
+
+func Test_redactor_transaction(t *testing.T) {
+	r := newRedactor([]string{"/sim-card/sim-card[sim-id=*]/k"})
+	raw := changeTransaction("tx-1", 1, "/sim-card/sim-card[sim-id=sim1]/k", "/sim-card/sim-card[sim-id=sim1]/imsi")
+	for _, pathValue := range raw.GetChange().Values["connectivity-service-v4"].Values {
+		pathValue.Value = configapi.TypedValue{Bytes: []byte(redactSecret), Type: configapi.ValueType_STRING}
+	}
+
+	transaction := networkChangeToTransaction(raw)
+	r.transaction(&transaction)
+	body, err := json.Marshal(transaction)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, countRedacted(transaction))
+	// Bytes are base64 in JSON. Only the imsi value is left
+	assert.Equal(t, 1, strings.Count(string(body), base64.StdEncoding.EncodeToString([]byte(redactSecret))))
+
+	redacted := r.rawTransactions([]*configapi.Transaction{raw})
+	values := redacted[0].GetChange().Values["connectivity-service-v4"].Values
+	assert.Equal(t, redactedValue, string(values["/sim-card/sim-card[sim-id=sim1]/k"].Value.Bytes))
+	assert.Equal(t, redactSecret, string(values["/sim-card/sim-card[sim-id=sim1]/imsi"].Value.Bytes))
+	// The transaction passed in is left as it is
+	original := raw.GetChange().Values["connectivity-service-v4"].Values
+	assert.Equal(t, redactSecret, string(original["/sim-card/sim-card[sim-id=sim1]/k"].Value.Bytes))
+}
+
+func countRedacted(transaction externalRef0.Transaction) int {
+	count := 0
+	for _, changeTarget := range *transaction.Details.Change {
+		for _, pathTarget := range *changeTarget.PathValues {
+			if string(*pathTarget.PathValue.Value.Bytes) == redactedValue {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func Test_redactor_diff(t *testing.T) {
+	r := newRedactor([]string{"/sim-card/sim-card[sim-id=*]/k"})
+	secret, imsi := redactSecret, "001"
+	diff := &externalRef0.ConfigDiff{
+		Added:    []externalRef0.ChangeValue{{Path: "/sim-card/sim-card[sim-id=sim1]/k", Value: &secret}},
+		Modified: []externalRef0.ChangeValue{{Path: "/sim-card/sim-card[sim-id=sim1]/imsi", Value: &imsi}},
+	}
+	r.diff(diff)
+	assert.Equal(t, redactedValue, *diff.Added[0].Value)
+	assert.Equal(t, "001", *diff.Modified[0].Value)
+}
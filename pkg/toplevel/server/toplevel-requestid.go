@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+)
+
+// RequestIDMiddleware - gives each request the ID sent in X-Request-ID, or a new UUID, and echoes it
+// in the X-Request-ID of the response. The ID is logged with the request and passed on to onos-config
+// in the gNMI metadata. Must be used first, so that every response has one
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		Generator: func() string {
+			return uuid.New().String()
+		},
+		RequestIDHandler: func(ctx echo.Context, requestID string) {
+			ctx.Set(utils.ContextRequestID, requestID)
+		},
+	})
+}
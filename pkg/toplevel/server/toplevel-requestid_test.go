@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_RequestIDMiddleware(t *testing.T) {
+	var requestID string
+	var gnmiRequestIDs []string
+	handler := RequestIDMiddleware()(func(ctx echo.Context) error {
+		requestID = utils.RequestID(ctx)
+		gnmiCtx, cancel := utils.NewGnmiContext(ctx, time.Minute)
+		defer cancel()
+		md, _ := metadata.FromOutgoingContext(gnmiCtx)
+		gnmiRequestIDs = md.Get(utils.ContextRequestID)
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	// The client's ID is kept
+	req := httptest.NewRequest(http.MethodGet, "/targets", nil)
+	req.Header.Set(echo.HeaderXRequestID, "abc-123")
+	rec := httptest.NewRecorder()
+	assert.NoError(t, handler(echo.New().NewContext(req, rec)))
+	assert.Equal(t, "abc-123", requestID)
+	assert.Equal(t, "abc-123", rec.Header().Get(echo.HeaderXRequestID))
+	assert.Equal(t, []string{"abc-123"}, gnmiRequestIDs)
+
+	// Otherwise one is made up
+	rec = httptest.NewRecorder()
+	assert.NoError(t, handler(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets", nil), rec)))
+	assert.Len(t, requestID, 36)
+	assert.Equal(t, requestID, rec.Header().Get(echo.HeaderXRequestID))
+	assert.Equal(t, []string{requestID}, gnmiRequestIDs)
+
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets", nil), httptest.NewRecorder())
+	assert.Equal(t, "anonymous", requester(ctx))
+	ctx.Set(utils.ContextRequestID, "abc-123")
+	assert.Equal(t, "anonymous (request abc-123)", requester(ctx))
+}
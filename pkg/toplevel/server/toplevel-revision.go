@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// targetRevisions - the revision of each target, which is the index of the latest transaction that
+// changed it, and of the configuration as a whole. They are worked out from each read of the whole list
+// of transactions, and between reads of the cached list are kept up to date from the Sets made here,
+// so that with a TransactionsCacheTTL a read need not stream all of them just for its ETag
+type targetRevisions struct {
+	mu       sync.Mutex
+	byTarget map[configapi.TargetID]configapi.Index
+	latest   configapi.Index
+	loaded   bool
+}
+
+// observe - take the revisions from the whole list of transactions. Nothing goes back, in case the
+// list was read before a Set that has already been recorded
+func (r *targetRevisions) observe(transactions []*configapi.Transaction) {
+	byIndex := make(map[configapi.Index]*configapi.Transaction, len(transactions))
+	for _, t := range transactions {
+		if t != nil {
+			byIndex[t.Index] = t
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range transactions {
+		if t == nil {
+			continue
+		}
+		change := t.GetChange()
+		// A rollback changes the targets of the transaction it rolls back
+		if rollback := t.GetRollback(); rollback != nil && byIndex[rollback.RollbackIndex] != nil {
+			change = byIndex[rollback.RollbackIndex].GetChange()
+		}
+		targets := make([]configapi.TargetID, 0)
+		if change != nil {
+			for target := range change.Values {
+				targets = append(targets, target)
+			}
+		}
+		r.recordLocked(targets, t.Index)
+	}
+	r.loaded = true
+}
+
+// record - the index of a transaction that changed the targets
+func (r *targetRevisions) record(targets []configapi.TargetID, index configapi.Index) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recordLocked(targets, index)
+}
+
+func (r *targetRevisions) recordLocked(targets []configapi.TargetID, index configapi.Index) {
+	if r.byTarget == nil {
+		r.byTarget = make(map[configapi.TargetID]configapi.Index)
+	}
+	for _, target := range targets {
+		if index > r.byTarget[target] {
+			r.byTarget[target] = index
+		}
+	}
+	if index > r.latest {
+		r.latest = index
+	}
+}
+
+// invalidate - have the revisions worked out again on the next read, after a change that cannot be
+// recorded, such as a rollback
+func (r *targetRevisions) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loaded = false
+}
+
+// revision - the latest revision of any of the targets, or of the whole configuration without any.
+// 0 for targets that have never been changed. False until the revisions have been worked out
+func (r *targetRevisions) revision(targets []configapi.TargetID) (configapi.Index, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(targets) == 0 {
+		return r.latest, r.loaded
+	}
+	var revision configapi.Index
+	for _, target := range targets {
+		if r.byTarget[target] > revision {
+			revision = r.byTarget[target]
+		}
+	}
+	return revision, r.loaded
+}
+
+// readRevision - the revision of the targets, for the ETag of a read. With a TransactionsCacheTTL it is
+// as up to date as the cached transactions, otherwise it is read from onos-config as the check of an
+// If-Match does, so that the ETag of a read matches it when nothing has changed since, wherever changed from
+func (i *TopLevelServer) readRevision(ctx context.Context, targets ...configapi.TargetID) (configapi.Index, error) {
+	if i.ConfigClient == nil {
+		return 0, echo.NewHTTPError(http.StatusServiceUnavailable, "the revision is not available without onos-config's admin API")
+	}
+	if i.TransactionsCacheTTL <= 0 {
+		return i.currentRevision(ctx, targets...)
+	}
+	// Refreshing the cache updates the revisions
+	if _, _, err := i.cachedTransactions(withTransactionsCache(ctx, false)); err != nil {
+		return 0, err
+	}
+	if revision, loaded := i.revisions.revision(targets); loaded {
+		return revision, nil
+	}
+	return i.currentRevision(ctx, targets...)
+}
+
+// currentRevision - the revision of the targets as it is now in onos-config, for the check of a
+// write's If-Match
+func (i *TopLevelServer) currentRevision(ctx context.Context, targets ...configapi.TargetID) (configapi.Index, error) {
+	if i.ConfigClient == nil {
+		return 0, echo.NewHTTPError(http.StatusServiceUnavailable, "the revision is not available without onos-config's admin API")
+	}
+	transactions, err := i.listTransactions(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	i.revisions.observe(transactions)
+	revision, _ := i.revisions.revision(targets)
+	return revision, nil
+}
+
+// setRevisionETag - give the revision of the targets (or of the whole configuration) as the ETag of a
+// response, for a subsequent If-Match. The response is still sent without it if the revision cannot be had
+func (i *TopLevelServer) setRevisionETag(ctx echo.Context, gnmiCtx context.Context, targets ...configapi.TargetID) {
+	if i.ConfigClient == nil {
+		return
+	}
+	revision, err := i.readRevision(gnmiCtx, targets...)
+	if err != nil {
+		log.Warnf("unable to get the current revision for the ETag %v", err)
+		return
+	}
+	ctx.Response().Header().Set(headerETag, revisionETag(revision))
+}
+
+// checkIfMatch - with an If-Match header, 412 if any of the targets (or any target at all, without
+// them) has been changed since the revision it gives. The revision can still move on between this
+// check and the Set, but a client working from a stale read is stopped
+func (i *TopLevelServer) checkIfMatch(ctx echo.Context, gnmiCtx context.Context, targets ...configapi.TargetID) error {
+	ifMatch := ctx.Request().Header.Get(headerIfMatch)
+	if ifMatch == "" {
+		return nil
+	}
+	revision, err := i.currentRevision(gnmiCtx, targets...)
+	if err != nil {
+		return err
+	}
+	if !ifMatchSatisfied(ifMatch, revision) {
+		etag := revisionETag(revision)
+		ctx.Response().Header().Set(headerETag, etag)
+		return echo.NewHTTPError(http.StatusPreconditionFailed,
+			fmt.Sprintf("the configuration has changed. %s is %s, not %s", headerIfMatch, etag, ifMatch))
+	}
+	return nil
+}
+
+// patchTargets - the target that a PatchBody changes, as all its updates and deletes go to its default
+// target. None if the body cannot be read, which the patch itself then reports
+func patchTargets(body []byte) []configapi.TargetID {
+	var patchBody struct {
+		DefaultTarget string `json:"default-target"`
+	}
+	if err := json.Unmarshal(body, &patchBody); err != nil || patchBody.DefaultTarget == "" {
+		return nil
+	}
+	return []configapi.TargetID{configapi.TargetID(patchBody.DefaultTarget)}
+}
+
+// setTargets - the targets that a Set changes
+func setTargets(gnmiSet *gnmi.SetRequest) []configapi.TargetID {
+	seen := make(map[string]bool)
+	targets := make([]configapi.TargetID, 0)
+	add := func(target string) {
+		if target != "" && !seen[target] {
+			seen[target] = true
+			targets = append(targets, configapi.TargetID(target))
+		}
+	}
+	add(gnmiSet.GetPrefix().GetTarget())
+	for _, u := range append(gnmiSet.GetUpdate(), gnmiSet.GetReplace()...) {
+		add(u.GetPath().GetTarget())
+	}
+	for _, d := range gnmiSet.GetDelete() {
+		add(d.GetTarget())
+	}
+	return targets
+}
+
+// patchSetTargets - the targets that the Set of a PatchBody changes. Its paths without a target go
+// to the default-target of the body
+func patchSetTargets(gnmiSet *gnmi.SetRequest, defaultTarget string) []configapi.TargetID {
+	targets := setTargets(gnmiSet)
+	if defaultTarget == "" || gnmiSet.GetPrefix().GetTarget() != "" {
+		return targets
+	}
+	paths := append([]*gnmi.Path{}, gnmiSet.GetDelete()...)
+	for _, u := range append(gnmiSet.GetUpdate(), gnmiSet.GetReplace()...) {
+		paths = append(paths, u.GetPath())
+	}
+	for _, path := range paths {
+		if path.GetTarget() != "" {
+			continue
+		}
+		for _, target := range targets {
+			if target == configapi.TargetID(defaultTarget) {
+				return targets
+			}
+		}
+		return append(targets, configapi.TargetID(defaultTarget))
+	}
+	return targets
+}
+
+// ifMatchSatisfied - whether an If-Match header has a revision that is no earlier than revision, so
+// that nothing has changed since it. A weak ETag never matches
+func ifMatchSatisfied(ifMatch string, revision configapi.Index) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			continue
+		}
+		index, err := strconv.ParseUint(strings.Trim(candidate, `"`), 10, 64)
+		if err == nil && revision <= configapi.Index(index) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ifMatchSatisfied(t *testing.T) {
+	assert.True(t, ifMatchSatisfied(`"12"`, 12))
+	assert.True(t, ifMatchSatisfied(`"11", "12"`, 12))
+	assert.True(t, ifMatchSatisfied(`*`, 12))
+	// Nothing has changed since 13
+	assert.True(t, ifMatchSatisfied(`"13"`, 12))
+	assert.False(t, ifMatchSatisfied(`"11"`, 12))
+	assert.False(t, ifMatchSatisfied(`W/"12"`, 12))
+	assert.False(t, ifMatchSatisfied(`"abc"`, 12))
+}
+
+func Test_PatchAetherRocAPI_ifMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	// Only the patch from the current revision is made
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(&gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+		Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id: 100, Msg: []byte("tx-13"),
+		}},
+	}}}, nil)
+	server := &TopLevelServer{
+		GnmiTimeout:     time.Second,
+		GnmiClient:      mockClient,
+		SkipTargetCheck: true,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			changeTransaction("t1", 11, "/site"), changeTransaction("t2", 12, "/site"),
+		}},
+	}
+	patch := func(ifMatch string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+		req.Header.Set(headerIfMatch, ifMatch)
+		rec := httptest.NewRecorder()
+		return rec, server.PatchAetherRocAPI(echo.New().NewContext(req, rec))
+	}
+
+	rec, err := patch(`"11"`)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+	assert.Equal(t, `"12"`, rec.Header().Get(headerETag))
+
+	rec, err = patch(`"12"`)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_PatchAetherRocAPI_ifMatchTarget(t *testing.T) {
+	other := changeTransaction("t2", 12, "/site")
+	other.GetChange().Values = map[configapi.TargetID]*configapi.PathValues{"other": {}}
+	server := &TopLevelServer{
+		GnmiTimeout:     time.Second,
+		SkipTargetCheck: true,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			changeTransaction("t1", 11, "/site"), other,
+		}},
+	}
+	patch := func(body string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(body))
+		req.Header.Set(headerIfMatch, `"11"`)
+		rec := httptest.NewRecorder()
+		return rec, server.PatchAetherRocAPI(echo.New().NewContext(req, rec))
+	}
+
+	// The entries go to other, which has changed since, even though the default-target has not
+	rec, err := patch(`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"target":{"target":"other"},"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+	assert.Equal(t, `"12"`, rec.Header().Get(headerETag))
+
+	rec, err = patch(`{"default-target":"other"}`)
+	httpErr, ok = err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+
+	// A dry run is checked against the targets it would change
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api?dryRun=true", strings.NewReader(`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"target":{"target":"other"},"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	req.Header.Set(headerIfMatch, `"11"`)
+	err = server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok = err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+}
+
+func Test_PutAetherRocAPI_ifMatchDefaultTarget(t *testing.T) {
+	server := &TopLevelServer{
+		GnmiTimeout: time.Second,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			changeTransaction("t1", 11, "/site"), changeTransaction("t2", 12, "/site"),
+		}},
+	}
+	put := func() (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPut, "/aether-roc-api?path=/site",
+			strings.NewReader(`{"default-target":"connectivity-service-v4"}`))
+		req.Header.Set(headerIfMatch, `"11"`)
+		rec := httptest.NewRecorder()
+		return rec, server.PutAetherRocAPI(echo.New().NewContext(req, rec))
+	}
+
+	// The target comes from the body, so its revision is checked
+	rec, err := put()
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+	assert.Equal(t, `"12"`, rec.Header().Get(headerETag))
+
+	req := httptest.NewRequest(http.MethodPut, "/aether-roc-api?path=/site", strings.NewReader(`{}`))
+	err = server.PutAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	assert.EqualError(t, err, "code=400, message=target cannot be empty")
+}
+
+func Test_targetRevisions(t *testing.T) {
+	other := changeTransaction("t2", 12, "/enterprise")
+	other.GetChange().Values = map[configapi.TargetID]*configapi.PathValues{"other": {}}
+	rollback := &configapi.Transaction{ID: "t3", Index: 13,
+		Details: &configapi.Transaction_Rollback{Rollback: &configapi.RollbackTransaction{RollbackIndex: 11}}}
+
+	var revisions targetRevisions
+	_, loaded := revisions.revision(nil)
+	assert.False(t, loaded)
+
+	revisions.observe([]*configapi.Transaction{changeTransaction("t1", 11, "/enterprise"), other, rollback})
+	revision, loaded := revisions.revision(nil)
+	assert.True(t, loaded)
+	assert.Equal(t, configapi.Index(13), revision)
+	// The rollback changed what t1 did
+	revision, _ = revisions.revision([]configapi.TargetID{"connectivity-service-v4"})
+	assert.Equal(t, configapi.Index(13), revision)
+	revision, _ = revisions.revision([]configapi.TargetID{"other"})
+	assert.Equal(t, configapi.Index(12), revision)
+	revision, _ = revisions.revision([]configapi.TargetID{"never-changed"})
+	assert.Equal(t, configapi.Index(0), revision)
+
+	revisions.record([]configapi.TargetID{"other"}, 14)
+	revision, _ = revisions.revision([]configapi.TargetID{"other", "connectivity-service-v4"})
+	assert.Equal(t, configapi.Index(14), revision)
+	// An older list does not take it back
+	revisions.observe([]*configapi.Transaction{other})
+	revision, _ = revisions.revision([]configapi.TargetID{"other"})
+	assert.Equal(t, configapi.Index(14), revision)
+
+	revisions.invalidate()
+	_, loaded = revisions.revision(nil)
+	assert.False(t, loaded)
+}
+
+func Test_readRevision(t *testing.T) {
+	client := &countingTransactionClient{fakeTransactionClient: fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("t1", 11, "/enterprise")},
+	}}
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: client}
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	revision, err := server.readRevision(ctx, "connectivity-service-v4")
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(11), revision)
+
+	// Without a cache a change made elsewhere e.g. by another replica is seen by the next read,
+	// which then gives the same revision as the check of a write
+	client.transactions = append(client.transactions, changeTransaction("t2", 12, "/enterprise"))
+	revision, err = server.readRevision(ctx, "connectivity-service-v4")
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(12), revision)
+	current, err := server.currentRevision(ctx, "connectivity-service-v4")
+	assert.NoError(t, err)
+	assert.Equal(t, revision, current)
+	assert.Equal(t, 3, int(client.lists))
+}
+
+func Test_readRevision_cached(t *testing.T) {
+	client := &countingTransactionClient{fakeTransactionClient: fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("t1", 11, "/enterprise")},
+	}}
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: client, TransactionsCacheTTL: time.Minute}
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	for range []int{1, 2, 3} {
+		revision, err := server.readRevision(ctx, "connectivity-service-v4")
+		assert.NoError(t, err)
+		assert.Equal(t, configapi.Index(11), revision)
+	}
+	// The transactions are read once for the cache, then the Sets made here keep the revisions
+	assert.Equal(t, 1, int(client.lists))
+	server.revisions.record([]configapi.TargetID{"connectivity-service-v4"}, 15)
+	revision, err := server.readRevision(ctx, "connectivity-service-v4")
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(15), revision)
+	assert.Equal(t, 1, int(client.lists))
+
+	// The check of a write reads them again
+	revision, err = server.currentRevision(ctx, "connectivity-service-v4")
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(15), revision)
+	assert.Equal(t, 2, int(client.lists))
+}
+
+func Test_readRevision_none(t *testing.T) {
+	server := &TopLevelServer{ConfigClient: &fakeTransactionClient{}}
+	revision, err := server.readRevision(httptest.NewRequest(http.MethodGet, "/", nil).Context())
+	assert.NoError(t, err)
+	assert.Equal(t, configapi.Index(0), revision)
+}
+
+func Test_setTargets(t *testing.T) {
+	gnmiSet := &gnmi.SetRequest{
+		Prefix: &gnmi.Path{Target: "a"},
+		Update: []*gnmi.Update{{Path: &gnmi.Path{Target: "b"}}, {Path: &gnmi.Path{}}},
+		Delete: []*gnmi.Path{{Target: "a"}, {Target: "c"}},
+	}
+	assert.Equal(t, []configapi.TargetID{"a", "b", "c"}, setTargets(gnmiSet))
+	// A path without a target goes to the default-target
+	assert.Equal(t, []configapi.TargetID{"b", "d"}, patchSetTargets(&gnmi.SetRequest{
+		Update: []*gnmi.Update{{Path: &gnmi.Path{Target: "b"}}, {Path: &gnmi.Path{}}}}, "d"))
+	assert.Equal(t, []configapi.TargetID{"b"}, patchSetTargets(&gnmi.SetRequest{
+		Update: []*gnmi.Update{{Path: &gnmi.Path{Target: "b"}}}}, "d"))
+	assert.Equal(t, []configapi.TargetID{"acme"}, patchTargets([]byte(`{"default-target":"acme"}`)))
+	assert.Empty(t, patchTargets([]byte(`not json`)))
+}
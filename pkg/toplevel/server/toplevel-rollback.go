@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"net/http"
+	"strconv"
+)
+
+// PostTransactionRollback - roll back the transaction with the ID or index, which makes a new rollback
+// transaction. 409 if it has already been rolled back. The admin role is checked by RBACMiddleware
+func (i *TopLevelServer) PostTransactionRollback(ctx echo.Context, id string) error {
+	if id == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "transaction id cannot be empty")
+	}
+	if i.ConfigClient == nil || i.AdminClient == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "rollback is not available without onos-config's admin API")
+	}
+
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+	transactions, err := i.grpcGetTransactionsRaw(gnmiCtx, nil)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	transaction := findTransaction(transactions, id)
+	if transaction == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("transaction %s not found", id))
+	}
+	if rollback := findRollbackOf(transactions, transaction.Index); rollback != nil {
+		return echo.NewHTTPError(http.StatusConflict,
+			fmt.Sprintf("transaction %s has already been rolled back by %s", transaction.ID, rollback.ID))
+	}
+
+	resp, err := i.grpcRollbackTransaction(gnmiCtx, transaction.Index)
+	if err != nil {
+		if typedErr := errors.FromGRPC(err); errors.IsAlreadyExists(typedErr) || errors.IsConflict(typedErr) {
+			return echo.NewHTTPError(http.StatusConflict,
+				fmt.Sprintf("transaction %s cannot be rolled back %v", transaction.ID, err))
+		}
+		return utils.ConvertGrpcError(err)
+	}
+
+	i.audit(ctx, nil, AuditEvent{TransactionID: string(resp.ID), RolledBack: string(transaction.ID)})
+	transactionInfo := newTransactionInfo(&configapi.TransactionInfo{ID: resp.ID, Index: resp.Index})
+	if resp.Index > 0 {
+		ctx.Response().Header().Set(headerETag, revisionETag(resp.Index))
+	}
+	log.Debugf("PostTransactionRollback %s by %s", transaction.ID, requester(ctx))
+	return ctx.JSON(http.StatusOK, transactionInfo)
+}
+
+// grpcRollbackTransaction - ask onos-config to roll back the transaction at index
+func (i *TopLevelServer) grpcRollbackTransaction(ctx context.Context, index configapi.Index) (*admin.RollbackResponse, error) {
+	rollbackCtx, span := i.startClientSpan(ctx, "admin.RollbackTransaction")
+	defer span.End()
+	resp, err := i.AdminClient.RollbackTransaction(rollbackCtx, &admin.RollbackRequest{Index: index})
+	i.Metrics.ObserveGnmiCall("RollbackTransaction", err)
+	setSpanError(span, err)
+	if err == nil {
+		i.revisions.invalidate()
+	}
+	return resp, err
+}
+
+// findTransaction - the transaction with the ID, or with the index if id is a number. nil if there is none
+func findTransaction(transactions []*configapi.Transaction, id string) *configapi.Transaction {
+	index, indexErr := strconv.ParseUint(id, 10, 64)
+	for _, t := range transactions {
+		if string(t.ID) == id || (indexErr == nil && t.Index == configapi.Index(index)) {
+			return t
+		}
+	}
+	return nil
+}
+
+// findRollbackOf - the rollback transaction, not failed, of the transaction at index. nil if there is none
+func findRollbackOf(transactions []*configapi.Transaction, index configapi.Index) *configapi.Transaction {
+	for _, t := range transactions {
+		if rollback := t.GetRollback(); rollback != nil && rollback.RollbackIndex == index &&
+			t.Status.State != configapi.TransactionStatus_FAILED {
+			return t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeAdminClient - a ConfigAdminServiceClient that records the rollbacks asked for
+type fakeAdminClient struct {
+	admin.ConfigAdminServiceClient
+	rolledBack []configapi.Index
+	err        error
+}
+
+func (f *fakeAdminClient) RollbackTransaction(ctx context.Context, in *admin.RollbackRequest, opts ...grpc.CallOption) (*admin.RollbackResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.rolledBack = append(f.rolledBack, in.Index)
+	return &admin.RollbackResponse{ID: "tx-9", Index: 9}, nil
+}
+
+func newRollbackTestServer(adminClient *fakeAdminClient) *TopLevelServer {
+	return &TopLevelServer{
+		GnmiTimeout: time.Minute,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			changeTransaction("tx-1", 1, "/a"),
+			changeTransaction("tx-2", 2, "/a"),
+			{
+				ID:      "tx-3",
+				Index:   3,
+				Details: &configapi.Transaction_Rollback{Rollback: &configapi.RollbackTransaction{RollbackIndex: 2}},
+			},
+		}},
+		AdminClient: adminClient,
+	}
+}
+
+func postRollback(server *TopLevelServer, id string) (*httptest.ResponseRecorder, error) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/transactions/"+id+"/rollback", nil)
+	return rec, server.PostTransactionRollback(echo.New().NewContext(req, rec), id)
+}
+
+func Test_PostTransactionRollback(t *testing.T) {
+	for _, id := range []string{"tx-1", "1"} {
+		adminClient := &fakeAdminClient{}
+		rec, err := postRollback(newRollbackTestServer(adminClient), id)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []configapi.Index{1}, adminClient.rolledBack)
+		assert.Equal(t, `"9"`, rec.Header().Get(headerETag))
+
+		var info externalRef0.TransactionInfo
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+		assert.Equal(t, "tx-9", info.TransactionId)
+		assert.Equal(t, int64(9), *info.Index)
+	}
+}
+
+func Test_PostTransactionRollback_errors(t *testing.T) {
+	httpCode := func(err error) int {
+		if httpErr, ok := err.(*echo.HTTPError); ok {
+			return httpErr.Code
+		}
+		return 0
+	}
+
+	adminClient := &fakeAdminClient{}
+	_, err := postRollback(newRollbackTestServer(adminClient), "tx-7")
+	assert.Equal(t, http.StatusNotFound, httpCode(err))
+
+	_, err = postRollback(newRollbackTestServer(adminClient), "tx-2")
+	assert.Equal(t, http.StatusConflict, httpCode(err))
+	assert.Contains(t, err.Error(), "already been rolled back by tx-3")
+	assert.Empty(t, adminClient.rolledBack)
+
+	adminClient = &fakeAdminClient{err: status.Error(codes.AlreadyExists, "already rolled back")}
+	_, err = postRollback(newRollbackTestServer(adminClient), "tx-1")
+	assert.Equal(t, http.StatusConflict, httpCode(err))
+}
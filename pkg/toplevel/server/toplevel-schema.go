@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	aether_2_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_2_0_0/server"
+	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// modelVersion - a version of the Aether models served by this API
+type modelVersion struct {
+	version    string
+	specFile   string
+	getSwagger func() (*openapi3.T, error)
+}
+
+// modelVersions - the Aether model versions registered with the top level server
+var modelVersions = []modelVersion{
+	{version: "2.0.0", specFile: "aether-2.0.0-openapi3.yaml", getSwagger: aether_2_0_0.GetSwagger},
+	{version: "4.0.0", specFile: "aether-4.0.0-openapi3.yaml", getSwagger: aether_4_0_0.GetSwagger},
+}
+
+// findModelVersion - the registered model version. False if there is none
+func findModelVersion(version string) (modelVersion, bool) {
+	for _, mv := range modelVersions {
+		if mv.version == version {
+			return mv, true
+		}
+	}
+	return modelVersion{}, false
+}
+
+// modelVersionSpecs - the spec of each model version, keyed by version
+func modelVersionSpecs() (interface{}, error) {
+	specs := make(map[string]*openapi3.T, len(modelVersions))
+	for _, mv := range modelVersions {
+		swagger, err := mv.getSwagger()
+		if err != nil {
+			return nil, err
+		}
+		specs[mv.version] = swagger
+	}
+	return specs, nil
+}
+
+// apiPath - where the API of the version is served, as registered by its server package
+func (mv modelVersion) apiPath() string {
+	return "/aether/v" + mv.version
+}
+
+// ConsolidatedProperty - a property of a schema and the versions it is present in
+type ConsolidatedProperty struct {
+	Versions []string          `json:"versions"`
+	Types    map[string]string `json:"types"`
+}
+
+// ConsolidatedSchema - a schema (type) and the versions it is present in
+type ConsolidatedSchema struct {
+	Versions   []string                         `json:"versions"`
+	Properties map[string]*ConsolidatedProperty `json:"properties,omitempty"`
+}
+
+// ConsolidatedSchemas - the schemas of all model versions merged together
+type ConsolidatedSchemas struct {
+	Versions []string                       `json:"versions"`
+	Schemas  map[string]*ConsolidatedSchema `json:"schemas"`
+}
+
+// consolidateSchemas merges the component schemas of each version in to one view.
+// The specs map is keyed by version.
+func consolidateSchemas(specs map[string]*openapi3.T) *ConsolidatedSchemas {
+	versions := make([]string, 0, len(specs))
+	for v := range specs {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+
+	consolidated := &ConsolidatedSchemas{
+		Versions: versions,
+		Schemas:  make(map[string]*ConsolidatedSchema),
+	}
+	for _, v := range versions {
+		for name, schemaRef := range specs[v].Components.Schemas {
+			cs, ok := consolidated.Schemas[name]
+			if !ok {
+				cs = &ConsolidatedSchema{
+					Versions:   make([]string, 0),
+					Properties: make(map[string]*ConsolidatedProperty),
+				}
+				consolidated.Schemas[name] = cs
+			}
+			cs.Versions = append(cs.Versions, v)
+			if schemaRef == nil || schemaRef.Value == nil {
+				continue
+			}
+			for propName, propRef := range schemaRef.Value.Properties {
+				cp, ok := cs.Properties[propName]
+				if !ok {
+					cp = &ConsolidatedProperty{
+						Versions: make([]string, 0),
+						Types:    make(map[string]string),
+					}
+					cs.Properties[propName] = cp
+				}
+				cp.Versions = append(cp.Versions, v)
+				cp.Types[v] = schemaTypeName(propRef)
+			}
+		}
+	}
+	return consolidated
+}
+
+// schemaTypeName - the name of the referenced schema, or else the type of the schema
+func schemaTypeName(schemaRef *openapi3.SchemaRef) string {
+	if schemaRef == nil {
+		return ""
+	}
+	if schemaRef.Ref != "" {
+		return schemaRef.Ref[strings.LastIndex(schemaRef.Ref, "/")+1:]
+	}
+	if schemaRef.Value == nil {
+		return ""
+	}
+	if schemaRef.Value.Type == "array" && schemaRef.Value.Items != nil {
+		return "array of " + schemaTypeName(schemaRef.Value.Items)
+	}
+	return schemaRef.Value.Type
+}
+
+// GetSchema - the JSON Schema of one component of a model version, with the schemas it references
+// put in place of their $ref
+func (i *TopLevelServer) GetSchema(ctx echo.Context, version string, component string) error {
+	mv, ok := findModelVersion(version)
+	if !ok {
+		versions := make([]string, 0, len(modelVersions))
+		for _, v := range modelVersions {
+			versions = append(versions, v.version)
+		}
+		return echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unknown version %s. Versions are %s", version, strings.Join(versions, ", ")))
+	}
+	swagger, err := mv.getSwagger()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	schemaRef, ok := swagger.Components.Schemas[component]
+	if !ok || schemaRef == nil || schemaRef.Value == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("no component %s in %s", component, version))
+	}
+	log.Debugf("GetSchema %s %s by %s", version, component, requester(ctx))
+	return ctx.JSON(http.StatusOK, dereferenceSchema(schemaRef, make(map[*openapi3.Schema]bool)).Value)
+}
+
+// dereferenceSchema - a copy of the schema with every $ref under it replaced by the schema referenced.
+// A schema that refers back to one it is inside of keeps its $ref, so the copy is finite
+func dereferenceSchema(schemaRef *openapi3.SchemaRef, inside map[*openapi3.Schema]bool) *openapi3.SchemaRef {
+	if schemaRef == nil || schemaRef.Value == nil || inside[schemaRef.Value] {
+		return schemaRef
+	}
+	inside[schemaRef.Value] = true
+	defer delete(inside, schemaRef.Value)
+
+	schema := *schemaRef.Value
+	if schema.Properties != nil {
+		schema.Properties = make(openapi3.Schemas, len(schemaRef.Value.Properties))
+		for name, property := range schemaRef.Value.Properties {
+			schema.Properties[name] = dereferenceSchema(property, inside)
+		}
+	}
+	schema.Items = dereferenceSchema(schema.Items, inside)
+	schema.AdditionalProperties = dereferenceSchema(schema.AdditionalProperties, inside)
+	schema.Not = dereferenceSchema(schema.Not, inside)
+	schema.OneOf = dereferenceSchemas(schema.OneOf, inside)
+	schema.AnyOf = dereferenceSchemas(schema.AnyOf, inside)
+	schema.AllOf = dereferenceSchemas(schema.AllOf, inside)
+	return &openapi3.SchemaRef{Value: &schema}
+}
+
+func dereferenceSchemas(schemaRefs openapi3.SchemaRefs, inside map[*openapi3.Schema]bool) openapi3.SchemaRefs {
+	if schemaRefs == nil {
+		return nil
+	}
+	dereferenced := make(openapi3.SchemaRefs, 0, len(schemaRefs))
+	for _, schemaRef := range schemaRefs {
+		dereferenced = append(dereferenced, dereferenceSchema(schemaRef, inside))
+	}
+	return dereferenced
+}
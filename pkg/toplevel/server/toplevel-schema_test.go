@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_consolidateSchemas(t *testing.T) {
+	spec1 := &openapi3.T{
+		Components: openapi3.Components{
+			Schemas: openapi3.Schemas{
+				"Site": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().
+					WithProperty("id", openapi3.NewStringSchema()).
+					WithProperty("imsi-format", openapi3.NewStringSchema())),
+			},
+		},
+	}
+	spec2 := &openapi3.T{
+		Components: openapi3.Components{
+			Schemas: openapi3.Schemas{
+				"Site": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().
+					WithProperty("id", openapi3.NewStringSchema()).
+					WithProperty("imsi-format", openapi3.NewIntegerSchema())),
+				"Upf": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().
+					WithProperty("address", openapi3.NewStringSchema())),
+			},
+		},
+	}
+
+	consolidated := consolidateSchemas(map[string]*openapi3.T{
+		"4.0.0": spec2,
+		"2.0.0": spec1,
+	})
+	assert.Equal(t, []string{"2.0.0", "4.0.0"}, consolidated.Versions)
+	assert.Len(t, consolidated.Schemas, 2)
+
+	site, ok := consolidated.Schemas["Site"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"2.0.0", "4.0.0"}, site.Versions)
+	assert.Equal(t, "string", site.Properties["imsi-format"].Types["2.0.0"])
+	assert.Equal(t, "integer", site.Properties["imsi-format"].Types["4.0.0"])
+
+	upf, ok := consolidated.Schemas["Upf"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"4.0.0"}, upf.Versions)
+	assert.Equal(t, []string{"4.0.0"}, upf.Properties["address"].Versions)
+}
+
+func Test_GetSchema(t *testing.T) {
+	server := &TopLevelServer{}
+	getSchema := func(version string, component string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/schemas/"+version+"/"+component, nil), rec)
+		return rec, server.GetSchema(ctx, version, component)
+	}
+
+	rec, err := getSchema("4.0.0", "Site")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "$ref")
+	var schema map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &schema))
+	assert.Equal(t, "Site", schema["title"])
+	site := schema["properties"].(map[string]interface{})["site"].(map[string]interface{})
+	siteSite := site["items"].(map[string]interface{})
+	assert.Contains(t, siteSite["properties"], "display-name")
+
+	_, err = getSchema("4.0.0", "No-such-component")
+	assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+
+	_, err = getSchema("9.9.9", "Site")
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	assert.True(t, strings.Contains(err.Error(), "2.0.0, 4.0.0"))
+}
+
+func Test_dereferenceSchema_cycle(t *testing.T) {
+	node := openapi3.NewObjectSchema()
+	nodeRef := openapi3.NewSchemaRef("#/components/schemas/Node", node)
+	node.WithPropertyRef("child", nodeRef)
+
+	dereferenced := dereferenceSchema(nodeRef, make(map[*openapi3.Schema]bool))
+	assert.Equal(t, "", dereferenced.Ref)
+	assert.Equal(t, "#/components/schemas/Node", dereferenced.Value.Properties["child"].Ref)
+	// The component itself is not changed
+	assert.Equal(t, "#/components/schemas/Node", node.Properties["child"].Ref)
+}
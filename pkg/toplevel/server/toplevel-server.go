@@ -15,13 +15,88 @@ type TopLevelServerInterface interface {
 	// PATCH at the top level of aether-roc-api
 	// (PATCH /aether-roc-api)
 	PatchAetherRocAPI(ctx echo.Context) error
+	// GET at the top level of aether-roc-api - any path of a target
+	// (GET /aether-roc-api)
+	GetAetherRocAPI(ctx echo.Context) error
+	// DELETE at the top level of aether-roc-api - a path of a target and everything under it
+	// (DELETE /aether-roc-api)
+	DeleteAetherRocAPI(ctx echo.Context) error
+	// PUT at the top level of aether-roc-api - replace a path of a target and everything under it
+	// (PUT /aether-roc-api)
+	PutAetherRocAPI(ctx echo.Context) error
+	// PATCH a list of paths of targets in one transaction
+	// (PATCH /aether-roc-api/batch)
+	PatchAetherRocAPIBatch(ctx echo.Context) error
+	// GET the differences in the configuration of a target between two revisions
+	// (GET /aether-roc-api/diff)
+	GetAetherRocAPIDiff(ctx echo.Context) error
+	// GET a path of a target as Server-Sent Events from a gNMI subscription
+	// (GET /aether-roc-api/subscribe)
+	SubscribePath(ctx echo.Context) error
 	// GET /targets A list of just target names
 	// (GET /targets)
 	GetTargets(ctx echo.Context) error
+	// GET /targets/health The connection and sync status of each target
+	// (GET /targets/health)
+	GetTargetsHealth(ctx echo.Context) error
+	// GET /targets/subscribe The targets, read with one gNMI Subscribe ONCE
+	// (GET /targets/subscribe)
+	GetTargetsSubscribe(ctx echo.Context) error
+	// GET /targets/{target}/config The full configuration of a target
+	// (GET /targets/{target}/config)
+	GetTargetConfig(ctx echo.Context, target string) error
+	// GET /targets/{target}/export The full configuration of a target as a file
+	// (GET /targets/{target}/export)
+	GetTargetExport(ctx echo.Context, target string) error
+	// POST /targets/{target}/import Apply a configuration file to a target
+	// (POST /targets/{target}/import)
+	PostTargetImport(ctx echo.Context, target string) error
 	// (GET /transactions)
 	GetTransactions(ctx echo.Context) error
+	// GET /transactions/{id} A single transaction
+	// (GET /transactions/{id})
+	GetTransaction(ctx echo.Context, id string) error
+	// POST /transactions/{id}/rollback Roll back a transaction
+	// (POST /transactions/{id}/rollback)
+	PostTransactionRollback(ctx echo.Context, id string) error
+	// POST /transactions/{id}/cancel Cancel a pending transaction
+	// (POST /transactions/{id}/cancel)
+	PostTransactionCancel(ctx echo.Context, id string) error
+	// GET /transactions/stream Transactions as Server-Sent Events as they happen
+	// (GET /transactions/stream)
+	GetTransactionsStream(ctx echo.Context) error
 	// (POST /sdcore/synchronize/{address})
 	PostSdcoreSynchronize(ctx echo.Context) error
+	// GET /versions The model versions served, with where to find their specs
+	// (GET /versions)
+	GetVersions(ctx echo.Context) error
+	// GET /operation-paths/{version} The gNMI path template of each operation of a model version
+	// (GET /operation-paths/{version})
+	GetOperationPaths(ctx echo.Context, version string) error
+	// POST /authorize-check Whether the user may make each of a list of operations
+	// (POST /authorize-check)
+	PostAuthorizeCheck(ctx echo.Context) error
+	// GET /latency-stats The request latency percentiles of each endpoint
+	// (GET /latency-stats)
+	GetLatencyStats(ctx echo.Context) error
+	// GET /log-level The effective level of each logger
+	// (GET /log-level)
+	GetLogLevel(ctx echo.Context) error
+	// PUT /log-level Change the level of a logger, or of all of them
+	// (PUT /log-level)
+	PutLogLevel(ctx echo.Context) error
+	// GET /config The effective settings of the server, without secrets
+	// (GET /config)
+	GetConfig(ctx echo.Context) error
+	// GET /ready Whether onos-config can be reached
+	// (GET /ready)
+	GetReady(ctx echo.Context) error
+	// GET /capabilities The models, encodings and gNMI version onos-config supports
+	// (GET /capabilities)
+	GetCapabilities(ctx echo.Context) error
+	// GET /healthz Liveness - whether the process is serving
+	// (GET /healthz)
+	GetHealthz(ctx echo.Context) error
 	// GET /spec The OpenAPI specification for this service
 	GetSpec(ctx echo.Context) error
 	// GET /spec/aether-2.0.0-openapi3.yaml The OpenAPI specification for Aether 2.0.0
@@ -30,6 +105,14 @@ type TopLevelServerInterface interface {
 	GetAether400Spec(ctx echo.Context) error
 	// GET /spec/aether-app-gtwy-openapi3.yaml The OpenAPI specification for Aether App Gateway
 	GetAetherAppGtwySpec(ctx echo.Context) error
+	// GET /specs The OpenAPI specifications of all the model versions, by version
+	// (GET /specs)
+	GetAllSpecs(ctx echo.Context) error
+	// GET /schemas The schemas of all model versions consolidated in to one view
+	GetConsolidatedSchema(ctx echo.Context) error
+	// GET /schemas/{version}/{component} The JSON Schema of one component of a model version
+	// (GET /schemas/{version}/{component})
+	GetSchema(ctx echo.Context, version string, component string) error
 }
 
 // TopLevelInterfaceWrapper converts echo contexts to parameters.
@@ -44,6 +127,48 @@ func (w *TopLevelInterfaceWrapper) PatchAetherRocAPI(ctx echo.Context) error {
 	return w.Handler.PatchAetherRocAPI(ctx)
 }
 
+// GetAetherRocAPI converts echo context to params.
+func (w *TopLevelInterfaceWrapper) GetAetherRocAPI(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetAetherRocAPI(ctx)
+}
+
+// DeleteAetherRocAPI converts echo context to params.
+func (w *TopLevelInterfaceWrapper) DeleteAetherRocAPI(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.DeleteAetherRocAPI(ctx)
+}
+
+// PutAetherRocAPI converts echo context to params.
+func (w *TopLevelInterfaceWrapper) PutAetherRocAPI(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PutAetherRocAPI(ctx)
+}
+
+// GetAetherRocAPIDiff converts echo context to params.
+func (w *TopLevelInterfaceWrapper) GetAetherRocAPIDiff(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetAetherRocAPIDiff(ctx)
+}
+
+// SubscribePath - stream the notifications of a gNMI subscription to a path
+func (w *TopLevelInterfaceWrapper) SubscribePath(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.SubscribePath(ctx)
+}
+
+// PatchAetherRocAPIBatch - set a list of paths to their values, all in one transaction
+func (w *TopLevelInterfaceWrapper) PatchAetherRocAPIBatch(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PatchAetherRocAPIBatch(ctx)
+}
+
 // GetTargets - get the full list of targets (devices)
 func (w *TopLevelInterfaceWrapper) GetTargets(ctx echo.Context) error {
 
@@ -51,6 +176,41 @@ func (w *TopLevelInterfaceWrapper) GetTargets(ctx echo.Context) error {
 	return w.Handler.GetTargets(ctx)
 }
 
+// GetTargetsSubscribe - get the list of targets with a gNMI Subscribe
+func (w *TopLevelInterfaceWrapper) GetTargetsSubscribe(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTargetsSubscribe(ctx)
+}
+
+// GetTargetsHealth - get the connection and sync status of each target (device)
+func (w *TopLevelInterfaceWrapper) GetTargetsHealth(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTargetsHealth(ctx)
+}
+
+// GetTargetConfig - get the configuration of a target (device)
+func (w *TopLevelInterfaceWrapper) GetTargetConfig(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTargetConfig(ctx, ctx.Param("target"))
+}
+
+// GetTargetExport - export the configuration of a target (device) as a file
+func (w *TopLevelInterfaceWrapper) GetTargetExport(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTargetExport(ctx, ctx.Param("target"))
+}
+
+// PostTargetImport - apply a configuration file to a target (device)
+func (w *TopLevelInterfaceWrapper) PostTargetImport(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PostTargetImport(ctx, ctx.Param("target"))
+}
+
 // GetTransactions - get the full list of transactions (network-changes)
 func (w *TopLevelInterfaceWrapper) GetTransactions(ctx echo.Context) error {
 
@@ -58,6 +218,34 @@ func (w *TopLevelInterfaceWrapper) GetTransactions(ctx echo.Context) error {
 	return w.Handler.GetTransactions(ctx)
 }
 
+// GetTransaction - get a single transaction (network-change) by its ID
+func (w *TopLevelInterfaceWrapper) GetTransaction(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTransaction(ctx, ctx.Param("id"))
+}
+
+// PostTransactionRollback - roll back a transaction (network-change)
+func (w *TopLevelInterfaceWrapper) PostTransactionRollback(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PostTransactionRollback(ctx, ctx.Param("id"))
+}
+
+// PostTransactionCancel - cancel a pending transaction (network-change)
+func (w *TopLevelInterfaceWrapper) PostTransactionCancel(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PostTransactionCancel(ctx, ctx.Param("id"))
+}
+
+// GetTransactionsStream - stream transactions (network-changes) as they happen
+func (w *TopLevelInterfaceWrapper) GetTransactionsStream(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetTransactionsStream(ctx)
+}
+
 // PostSdcoreSynchronize - call synchronize on the sdcore adapter
 func (w *TopLevelInterfaceWrapper) PostSdcoreSynchronize(ctx echo.Context) error {
 
@@ -65,6 +253,76 @@ func (w *TopLevelInterfaceWrapper) PostSdcoreSynchronize(ctx echo.Context) error
 	return w.Handler.PostSdcoreSynchronize(ctx)
 }
 
+// GetVersions - get the model versions served
+func (w *TopLevelInterfaceWrapper) GetVersions(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetVersions(ctx)
+}
+
+// GetOperationPaths - get the gNMI path that each operation of a model version maps to
+func (w *TopLevelInterfaceWrapper) GetOperationPaths(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetOperationPaths(ctx, ctx.Param("version"))
+}
+
+// PostAuthorizeCheck - check which of a list of operations the user may make, without making them
+func (w *TopLevelInterfaceWrapper) PostAuthorizeCheck(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PostAuthorizeCheck(ctx)
+}
+
+// GetLatencyStats - get the recent request latency percentiles of each endpoint
+func (w *TopLevelInterfaceWrapper) GetLatencyStats(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetLatencyStats(ctx)
+}
+
+// GetLogLevel - get the effective level of each logger
+func (w *TopLevelInterfaceWrapper) GetLogLevel(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetLogLevel(ctx)
+}
+
+// PutLogLevel - change the level of a logger, or of all of them
+func (w *TopLevelInterfaceWrapper) PutLogLevel(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.PutLogLevel(ctx)
+}
+
+// GetConfig - get the effective settings of the server
+func (w *TopLevelInterfaceWrapper) GetConfig(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetConfig(ctx)
+}
+
+// GetReady - check that onos-config can be reached
+func (w *TopLevelInterfaceWrapper) GetReady(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetReady(ctx)
+}
+
+// GetCapabilities - get the gNMI capabilities of onos-config
+func (w *TopLevelInterfaceWrapper) GetCapabilities(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetCapabilities(ctx)
+}
+
+// GetHealthz - check that the process is serving
+func (w *TopLevelInterfaceWrapper) GetHealthz(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetHealthz(ctx)
+}
+
 // GetSpec - Get the OpenAPI3 specification in YAML format
 func (w *TopLevelInterfaceWrapper) GetSpec(ctx echo.Context) error {
 
@@ -93,6 +351,27 @@ func (w *TopLevelInterfaceWrapper) GetAetherAppGtwySpec(ctx echo.Context) error
 	return w.Handler.GetAetherAppGtwySpec(ctx)
 }
 
+// GetAllSpecs - Get the specs of all the model versions, keyed by version
+func (w *TopLevelInterfaceWrapper) GetAllSpecs(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetAllSpecs(ctx)
+}
+
+// GetConsolidatedSchema - Get the schemas of all model versions and the versions each is present in
+func (w *TopLevelInterfaceWrapper) GetConsolidatedSchema(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetConsolidatedSchema(ctx)
+}
+
+// GetSchema - Get the JSON Schema of one component of a model version
+func (w *TopLevelInterfaceWrapper) GetSchema(ctx echo.Context) error {
+
+	// Invoke the callback with all the unmarshalled arguments
+	return w.Handler.GetSchema(ctx, ctx.Param("version"), ctx.Param("component"))
+}
+
 // EchoRouter is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -119,14 +398,65 @@ func RegisterHandlers(router EchoRouter, si TopLevelServerInterface) error {
 		Handler: si,
 	}
 
-	router.PATCH("/aether-roc-api", wrapper.PatchAetherRocAPI, openapi3mw.ValidateOpenapi3(openAPIDefinition))
+	// A body of a type not allowed is rejected. Otherwise a gzip body is decompressed, and a YAML body
+	// converted to JSON and given the default target, before it is validated
+	maxBodyBytes := int64(defaultMaxBodyBytes)
+	defaultTarget := ""
+	bodyContentTypes := DefaultBodyContentTypes
+	if server, ok := si.(*TopLevelServer); ok {
+		maxBodyBytes = server.maxBodyBytes()
+		defaultTarget = server.DefaultTarget
+		bodyContentTypes = server.bodyContentTypes()
+	}
+	// The OPTIONS of the /aether-roc-api routes list the methods registered below
+	aetherRocAPI := make(routeMethods)
+	aetherRocAPI.add(router.PATCH("/aether-roc-api", wrapper.PatchAetherRocAPI, contentTypeMiddleware(bodyContentTypes),
+		gzipBodyMiddleware(maxBodyBytes), yamlBodyMiddleware(maxBodyBytes), defaultTargetMiddleware(defaultTarget, maxBodyBytes),
+		mergePatchMiddleware(maxBodyBytes, openAPIDefinition.Components.Schemas["Elements"]),
+		openapi3mw.ValidateOpenapi3(openAPIDefinition)))
+	aetherRocAPI.add(router.GET("/aether-roc-api", wrapper.GetAetherRocAPI))
+	aetherRocAPI.add(router.DELETE("/aether-roc-api", wrapper.DeleteAetherRocAPI))
+	aetherRocAPI.add(router.PUT("/aether-roc-api", wrapper.PutAetherRocAPI, contentTypeMiddleware(bodyContentTypes),
+		gzipBodyMiddleware(maxBodyBytes), yamlBodyMiddleware(maxBodyBytes), defaultTargetMiddleware(defaultTarget, maxBodyBytes),
+		openapi3mw.ValidateOpenapi3(openAPIDefinition)))
+	aetherRocAPI.add(router.PATCH("/aether-roc-api/batch", wrapper.PatchAetherRocAPIBatch, gzipBodyMiddleware(maxBodyBytes)))
+	aetherRocAPI.add(router.GET("/aether-roc-api/diff", wrapper.GetAetherRocAPIDiff))
+	aetherRocAPI.add(router.GET("/aether-roc-api/subscribe", wrapper.SubscribePath))
+	aetherRocAPI.registerOptions(router)
 	router.GET("/targets", wrapper.GetTargets)
+	router.GET("/targets/health", wrapper.GetTargetsHealth)
+	router.GET("/targets/subscribe", wrapper.GetTargetsSubscribe)
+	router.GET("/targets/:target/config", wrapper.GetTargetConfig)
+	router.GET("/targets/:target/export", wrapper.GetTargetExport)
+	router.POST("/targets/:target/import", wrapper.PostTargetImport, yamlBodyMiddleware(maxBodyBytes))
 	router.GET("/transactions", wrapper.GetTransactions)
+	router.GET("/transactions/stream", wrapper.GetTransactionsStream)
+	router.GET("/transactions/:id", wrapper.GetTransaction)
+	router.POST("/transactions/:id/rollback", wrapper.PostTransactionRollback)
+	router.POST("/transactions/:id/cancel", wrapper.PostTransactionCancel)
 	router.GET("/aether-top-level-openapi3.yaml", wrapper.GetSpec)
 	router.GET("/aether-2.0.0-openapi3.yaml", wrapper.GetAether200Spec)
 	router.GET("/aether-4.0.0-openapi3.yaml", wrapper.GetAether400Spec)
+	router.HEAD("/aether-top-level-openapi3.yaml", wrapper.GetSpec)
+	router.HEAD("/aether-2.0.0-openapi3.yaml", wrapper.GetAether200Spec)
+	router.HEAD("/aether-4.0.0-openapi3.yaml", wrapper.GetAether400Spec)
 	router.GET("/aether-app-gtwy-openapi3.yaml", wrapper.GetAetherAppGtwySpec)
+	router.HEAD("/aether-app-gtwy-openapi3.yaml", wrapper.GetAetherAppGtwySpec)
+	router.GET("/specs", wrapper.GetAllSpecs)
+	router.HEAD("/specs", wrapper.GetAllSpecs)
+	router.GET("/schemas", wrapper.GetConsolidatedSchema)
+	router.GET("/schemas/:version/:component", wrapper.GetSchema)
 	router.POST("/sdcore/synchronize/:service", wrapper.PostSdcoreSynchronize)
+	router.GET("/versions", wrapper.GetVersions)
+	router.GET("/operation-paths/:version", wrapper.GetOperationPaths)
+	router.GET("/latency-stats", wrapper.GetLatencyStats)
+	router.GET("/log-level", wrapper.GetLogLevel)
+	router.PUT("/log-level", wrapper.PutLogLevel)
+	router.GET("/config", wrapper.GetConfig)
+	router.GET("/ready", wrapper.GetReady)
+	router.GET("/capabilities", wrapper.GetCapabilities)
+	router.GET("/healthz", wrapper.GetHealthz)
+	router.POST("/authorize-check", wrapper.PostAuthorizeCheck)
 
 	return nil
 }
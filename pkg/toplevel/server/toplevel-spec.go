@@ -22,54 +22,175 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xafW/jNtL/KoT6AG3xWLaTXRzucjjgvImTCnVsI5b3brsJAloaW2wkUiWpZNXC3/3A",
-	"F8l680u6uV7/SiRxZvj7cTicGfo3J2BJyihQKZyL3xwRRJBg/e9oxbicR1jAQmIJ6hXQLHEuPjujD7M7",
-	"35veOD3z7/jKeeg5Mk/BuXCE5IRunG3PGaVpnO/RMJ9PPlkN8/nEG185Ped65E32qPqQS9CzWjOeYOlc",
-	"OKtcgtMx8jLCdAM+5huQSiDlLAUuiRFPsYzcZxxn5vH/OKydC+ebwY6FgaVgMMcy+mhGbnuO1ApdihON",
-	"o2F2W06ErX6GQFYmwjEVOJCEUSVHJCRHLdcg7FRjznGuNbMkIXuW5nJ2e+v5dnHswx5OryAGCWEFzoqx",
-	"GDA1HyUmsWgzGOjJnQihAn7bcziL4xUOno4J39lxNXHF8TiGpPDU+qxwmsYkwGqo+74/7A8rNvoDDDIC",
-	"bj64LAWKU/Kun+Mk7rQ/2ilT0w4YpRBI8kxk7grgzySArzdy2aF1nzXhnu8zd/4V5rRjh6DxbDjL0q9H",
-	"dVXRprQDlcBTTsQbMDYuddU1vwE7O9WaE5K6IUsweQNf8gpVSq8g8g14WBCpGZCQpDF+C42+1aS1crxe",
-	"k8ANYizEG6iuqlP6s3T99VqX6Vrpeg7eYIYfA2GjCw1rJ0youJUk6TxmrjGJMw7tSBSCCDhJi4DfEjQv",
-	"GuMU60WoQ2ujGumRvTKyL6c/Tmf/mqqwPppejif6yJzO/Mfr2XKq/h9N7sajq0+P4397C3/h9JzldLT0",
-	"f5jdeT+Z43V298G7uhprFbPp9cS79J2e400/jibelRn/ceRNRh8mY6t6sZzPzfnec3zvdjxbGgl/fDcd",
-	"TTpOFcWjR0P4UmOSUPmX9zsWCZWwAe7osUQSHJNfofs486ae740m3k/mQCsfj+ULnmAxLtagUHY1vh4t",
-	"JwrBYnyn1WioXfJzLIPoAwvz9gKbY/PoIV6eVcqzvkiggjDa9hDnloUQ67VGmIboGbgaiNgafWtyjm+R",
-	"8gnChUQBBw0KfY4JfXr4LpIyFReDQcgC0WeUiZQzlX70Gd8M1LMbMLomGz1gsKEJeYRyKoNvMgEuW7vl",
-	"K/dseOba88DOwyXUFSA5/JKBkN87vc5sQKdF7tlwaOClHAKsMwvJM+g5kshY0dsc3MF7othw1Wv3bHh+",
-	"WF1j7F5tBZSz4dkpCqvDO3RWdqpL6Jq5Z2fD9qouBYSIUCQjQBxEyqgA0UMB5pyAQEqQ40SvJV6xTOqB",
-	"FdX9FtPeVWc4IcVma+6t7Q5X55Q7MtbqOCE5lrDJ3bOzszY8LwQqyVpBeYl0uEUYWSdBFCAUSDK0AhRh",
-	"GsYg0EjkNIg4oywTcY6+e8bxBRp+jxhHi44vZ8rTuqZfm1bvEGjl7Wjn7V14l6kK8a/aySGscRZLV5b1",
-	"RZ0Yk7Sj78wuQsrVv1dUZALQKkdWHJE1okwikUKgWAzVFic0JM9hhmOUmXkNQhNpEBYIU4TDkCgrOEbW",
-	"M3LlJFhK4Mr056H7N+z+en/v3t/3Hx/+v+28KgWHXzLClet/bmJ5MGEv6nQz9eFQTdUptCu2Tq61ukup",
-	"3ef2wRkBEurUUBETI2VyoE0Wu89EwIybwCk5QGtrhbtC6NAki3rJIjsFk05STiHAz1MICwaqiMXJVWNl",
-	"iTpqxjlnKRM43hNG7uCZCHtcnnBsd1VoLb8oyr3HMkYdmr7JGjT4hcRcnp6KtZKG+Xh6ZfIFndmMTP5S",
-	"/f+knoPSm3XUmutd6ncIUJEhKndRuc3RBayQOTcCW+Opat3EHtcHqRy/HIUSTPEGQhVsGkeK0zvRj3ae",
-	"0uFHoiD7kAqzInYtTcBu00iqKdpBzygHKvv2tCABkfnRedQGm/k05cuO1OLT9PKHu9l0tlS5c/WpyznM",
-	"TpvadlAd2b4mUSkmlNzpO7tiq2NFGnuwcR5V6goOa+D6YMYoyWJJbOSvOgmOkcnS+sjHT0DRmrMEFXnm",
-	"hsgoW/UDlgwq2abJNHFKBiq6DhIsJPBByplk+tPAJqHP5x2Rt+wyHY68ZpjKd8LujZBR8osK+kViwtW2",
-	"aO+A/flTXSVGCaNMKj/BcZwjQlXuLQjd9NAmZiv9srCpVBTmbHusd0oUTUDibjTqS6HRrwFopN+qIIA9",
-	"lKh4ibBELxEJoiYX6AULVIj3Toy0lYPyZHOmj6bNFeKnmnuCvNvUE+Td7LRU8Mq5drDlWIzTHYrwa0iN",
-	"sZCo0HEqVFt0dNus1IRtyEf9bGujdiZOCduZMFF+F7UPS9hxijYBvIh8bQzqSwFAjUQywhIlOITju7SR",
-	"txJFq9m3dg891MPg7uaiHZtPOr+aVx8nM9g8wgtGt40Jlhcjb5FdnAapcRfz1pAqdxK/k/TWrcZbT7HR",
-	"a/rDqO/scb01uAkR8vSEon4vcyCjqJps8QX0aM00pqGFajL6I+GHyxaweZk7N2581B59BdBKUNj29H1R",
-	"/hrp3Y7VdzTKVV8hXt0dOukoPOIVOprua0pLEp7ggRUtH62I1dEgu/71j9ogNatvuT3yFGapFM2i9t15",
-	"ZzpWqcFbR5hpKXBIOQigEkLdlEGrXAIy+6aZmq3yE1pL5lq7citwhKYM1CQLgUdmwTVzV1sV6qYy06/F",
-	"qdVfyVkrLihGO1aqUkLZCrtdeh8osnegutMtBWHNOMLI9FF29yHj27n/SVVq/l1xOeA7PWdp/nyYzSZO",
-	"z7kaX3q3I/Xf9WQ20h8++WNV4E3Go+uJt/AfS/nyjdFQPi4bz1Z1+byzUb4qjO1ktNXu+xJC10wn84xK",
-	"HOiwAgkmsfbONfsnS4FSkC+MPxG66TO+cXq2xnRmKVA0LT+ia5bRENs0KuNKR1G8dajRKX2tXIwA3Tsj",
-	"09H1WYom8AzxvYMCTNFKZ26hXg61NvORf/kDUrEQ07B/Tz2JcByzF4EEPAPHcZHv3YFgGQ9AFC90o73o",
-	"fgaIl99N09iEV2k66IxCxcbN2BdIRCyLQ6T4IjQDKxWqkTLiLNuYxFw3VInuqN6NF/7OTP+e3tP7bDh8",
-	"B8iPiEAqCPA1DgDZBxpCaNVqyIzGOVrlCL6oHa4TWNFHnlTj9QDbbrlZekoswU9gSuw0hnuKLCKlG53V",
-	"2vwI+pu+KYzU8iWY5hU6sESMBtB3ek5MAqAmJtulH6U4iMA97w9rS30xGLy8vPSx/qovgqyoGEy8y/F0",
-	"MdYilU55c7mdSjnimKv1bc+xF6nOhfNOvzJNUB1PihtXzgJXD9E94UB3SFVI1P7ohc6FuVbzWVrYsVcG",
-	"xUWbWlGgsvGDjsHPwlRGJkad0Ae1V3fbrSkd7PWLEjwfdlzW6MlCqAsNkSUJ5rmaq3ZvbK9mWIpiNWnd",
-	"Zq7DVWIDEQaMw6BsT/0Kg9/szyy2hhCOE5DAhXPxuYZUwhc5SGNM6N9REGEuQP4jk2v3r3XIHYGjjsLM",
-	"AFmjpuDS3mU+uDjEqQTuPr/X5ZPBHe1CSfEblGqxJXkG24eekzKTXdZXc6EVzzMRXeoOT21hj5FeYarF",
-	"/Gzho8OMGs5TCJRieyvRmFwKwZEJ7XG3HCfxK7m/Gfto9iNSeutQ1Ac9T6RCa7nFkJqdigafRrcTZHKT",
-	"PlqoJVN5hfEvyVJX+1z9Rww77Ad+5XKQFrPhz/UN7P+alwMYNGU2OOnP+sRow3//u+C//1PBf38Y/vsD",
-	"8HGauhv5kr+agVGa3siX/E/EQjeUKhGVn+ahGyzhBecVWkw/W+zFbrvvvy8svO4UqjX6X02KBYJGKCZC",
-	"5/I/Z0Ii26+nVqlCvMsl9sO+AelXx/03YTc6Eq9HXp+oxBt1YLY6HQ/qbP9PAAAA//+RiLwUvSwAAA==",
+	"H4sIAAAAAAAC/+x9/XMbN7Lgv4Liu6pNdoeU5DjvLr7auqMlJuGtLKkk2vuyscsFzoAk4iEwC4CSmZT+",
+	"96tufMwXZkjKsh2/8i+JzJkBuhtAf3fjj0Eq14UUTBg9ePbHQKcrtqb453gulblaUc1uDDUMfmJisx48",
+	"+3Uwfn55PZte/DRI7J+Ts8GbZGC2BRs8G2ijuFgO7pPBuCjybccIV1fnv7gRrq7Op5OzQTL4cTw97xjq",
+	"OTXp6kfK843CcTKmU8ULw6UYPBtQMofnxKyoIXdUEynyLSmoMvmW0KLIOcsSQkVG7lbMrJgi3L6nZJ6z",
+	"jMxp+m6QDAolC6YMZ4j/mmlNl5HZ7lZbYlbMzbmgPC9Hp4bMWUrXjMgF4WYQQQXmhAlnigpNUxh0mrVn",
+	"gRmmZzAM/OU/Iqb8qmtwlj0HfCJwW9xhvMowfgqLToMqCckZveViia+kUiz4cqMofke1I2MJyFzKnFEB",
+	"kJhD0OsCJ4biRrgVjQ9qqFoyg8tRULOC4RhNV2RTZNSwcosIafzWGCQDbtgaV701nfuBKkW3g3sgMfv3",
+	"hiuY/tewR2qEb+JeBbnc23L+G0tN2NsvEbzY1r6l+YYRI4kGtAyhAS/qkG1tXXghTp3lxYtp+B5+yBld",
+	"EDZajsiR5obhf37l2d/h/8OTN0cZ10VOt0NB1yy2Gg6CvqX4JmO3PGUEhvjWI8INie9gRDc+Hj6qAT4k",
+	"lNhPEyI26zlTRCoSdmFjtZAsAWQ/1Y4l0T2w6ICNAEC4WOascULDxvofii0Gzwb/cVQy3CPHbY8q08Hs",
+	"ay6m9rOT5v5LBs+3DqaFVGtq4NBtTXRpTmlB5zznhnchsZYZy3VCmEhlxsVS47HBPXLLlIbTiOdFCqmH",
+	"9vATvSkKqYxubbqlWPNX9qsOkrkh5cJOgbvuePQ/R8cx6N08LJt44OKjlrDLhV8VHPn/3VxeJOTq+nJ2",
+	"ecABr0z8AqkTn/WX8cVPjnyBh+G20vuu+U1tmp2cpkrdKHHagMc29umKiphMU6xQTAOAhDbYfIqfwE4v",
+	"t3ic7dif3/IOzswzJgxfcKbqJIOh71Yc5TfXfj7LMXU3z3lrf2/ORAWR+DfNw/jbglUmkTj2tjpbzyy3",
+	"XVs6MlE4NIfOZfdtZA7kLnLhiVJyHRx2371mV/0VfLxzo5WL2L1/zrnuYPkWTm3ZBiVX49npz+RObvKM",
+	"rOk7lhApGCmYKrfQAQjETqt9MgtSqC0IhyVx+6a4omb1yr4ZFt9KvTbTuO+kTEWpg+8OQM6h0Iniq7hc",
+	"pIJwkfFbnm1oTgCJI3wTOblia3nLMrLI6ZKkcj3nwp5plFenfjvuqzzAE9h73fvYTdj+HGBMqWGalFoo",
+	"14RrQknGclaVYBUlskcZqJ6INigxyR/dz3K95h1WzunlixfTmbNz3D86zJNT5JhnfLHoppyuqmz2gFgc",
+	"MjJn5o4xQcydJIrdcmAibQFLsyxGWju2Uw6NdBouUwxVXPf7Qsn1o3CLZIBDRbH0oMNWK6iCjWenDaoK",
+	"F+Y/n5arxYVhS6ZQ55EZiIZd+M2lWZUkSsgdhy0ZdEOkwCPh2bmXawABho7b1SluZAy6R1yHPTRvvw5R",
+	"8Sb3XUQj91nCqBgZuO2C0yVuB5ekrSx77HCeIWPIKgy4whrOmKHcqmf1Y5IGFWcPplth1xXTfNfH120T",
+	"fnAPFJjkbO1dKY3DC+pMitx3+HR0PDquzDE6osgT7YOhLJigBf9utKXrPDr/uBwMwE6lECw1/Jab7VAz",
+	"BdbWh09yGhm1azY9fNI13ZMPmA5FsbUeh0slN8WHY3VWGQ1GZ8IwVSiuH4FikzBWfeRHoE45NNKEF8NM",
+	"ril/hL009UPBuGj1f/CQN9zasYati5w+xogzN5JzLC0WPB2mOdX6EYauDof+pWLx4aO+LBaov6SPAOGr",
+	"VDvuIrKa4Z8BbQ2PO2Ym7wuWGpa92uVPodaVUlVO1httyIreMrKQyjqcrDEzZ2RNM/apHE4J2YjMeSwL",
+	"xRb8PaEa/2W9eRqE7qN4pSrAjcg/V0yQnC0MkRuDv2dsQTe5GbpvuSYbHRerO91XgQYlkdterMT7sIhU",
+	"JOfajIjY5DlhuKi6HERI49ZFo7SFt+g8Z4NnRm1YhwOs2+9V8bDXF7iGTacTpYV1xa27sEOjMT5IgpL9",
+	"8uIfF5f/vAANe3xxOjnHQMDF5eztj5cvL+Dv8fn1ZHz2y9vJf01vZjeDZPDyYvxy9vPl9fRfNmhwef18",
+	"enY2wSEuL348n57OBslgevFqfD49s++/Gk/Px8/PJ27om5dXVzZqkQxm0xeTy5f2i9nk+mJ8HlHwgY5T",
+	"kbH3tePXrcxOBTec5vx3FrcsphfT2XR8Pv2XtS3CP3dFQaZa5tSvgR/sbPLj+OU5YHAzucZhENXY9+dy",
+	"ec5uWR5zNORySXJ46HyaCZwJKRg8WFq/KrCIPHdnZd1iAnl86IzNN8uEcLEAjZgq3NNMKakq2wBfGiQD",
+	"eGuQDOA1eIpvxTCxQMXPmQMYeYyRBYI1IuMScrBBBR6dJb9lYqf1aBGLnRdPUG+fcesPuqrRpQV8G2S2",
+	"WKDuw9wS+KCFRSUh8y2pO+BLENDZ1+l3pVWvK8w0tta3c12CngX2pw1oja+mbaOz4HEqz6lmNcbuJvqL",
+	"hnEch7eS7ujWysDIOuqCpT3OhtbQlwUTMDx+F2O/fQ5oRDoQBCHsAKyxAW5Lx6udF6jyZsdi6L0dQNWv",
+	"YpbeVTQMCWJ5yazcBhGREAoLKgwK7XGassJFuKylcHQrspGS6ej2yd9+09IeQ3HLclmwv4O0aK19AL5N",
+	"Snzkl6ewITD/egSDnK+56VoUbdxwVL8Dn4FUI3JcnlH8lmVRRisXC90l5308aOFGn7OFVKwKcHs8Iw3N",
+	"48Pho+agu+3g8BqO7CkRIH9jl5cLalhmXYD6gq7jvmC/3hUtBriCTohiZqMEyyzVcA7LsBfaqiue0dUX",
+	"WLD3HcRzX7rJ4D2cfUQu19wYlhEMDTGSU216yGkR2rX/a3gftgpl0GUvf0S5EpbwJl09l9m2re9Y18NO",
+	"yIO9jwq3YSIc/DroeMJtHIKKrMqT/2IB+wsQdMGVNiRVzDppf825ePfmm5UxhX52dJTJVI+kkLpQEvjN",
+	"SKrlUSUyhy8cQZzoLQugHP3HRrOhXAzDT8OT45Ohs6kdHEMuhpoZIBfT5ttBEvWooEY+PDk+tugViqUU",
+	"vTPAO5KB4QbUztbLESaNfHgIPw9Pjp/0D9d4t3M0j8rJ8ck+A1Zfj4xZUVyHoJIMT06O26v6UrOMcHsQ",
+	"FNOFFBoOY0qV4kyjxqPoGteSzr0pURl61KL09CyqMHCve0Y2uccrCnJERFXf00ZRw5bb4cnJSRu9qY/X",
+	"lU57StwmIYKxTDvTY0VFljNNxnor0pWSQm50viXf3NL8GTn+FjjRTeTJybeDOPg1sJI+pGG3k3K3x/Ct",
+	"RPH3Pcl1a69NmFlHXsNGM1Cl3OeEW/MMVAb0cRLpYjUYqnEW7JGNfmhCNaGClDokcTtj22OPeing2DRq",
+	"c8pbpwkAwA1ZYT4USndqDFOAwa/Hwx/o8PfXr4evX4/evvlb7Aww50Bo4//KRSFX1GB6ENMNj4HT32o+",
+	"g4RQ71IeUpEN9R0tRmS2Ylv0nCtGM/IbjFKT0zAG134AkQFOVGxJxhcLpjABCUSbJk9PnuzrV697RiLa",
+	"inU1tPEeV7wZxaYJqU01umVq69YW4bXLmxC6MEyVr9op6gs3IlOU1ajDOT9H/T2c/oaZa3sId+qtjY3s",
+	"ZN4qymPgQV8YNfpRGV/dO7waj56Wj6NiXxtqnKoLUx7hlJ711tMVjGJtTTYrIwl9QPqAg8NsH5xqPp9e",
+	"JWdbhC13X8V4fzuhskQxK0HJQmqad8iQaxfX2dOFEQtxtPaFj5e8DQKqD3zrQUHkb3DD26hpfMVLi1gz",
+	"Y3x+DzxRGyG4WIZDc8NSxYxOiN6kKzg379jWJjLh5lAMxRjNNeyXOzZfSfmOvLw+10kZslvJu7Z2TDdm",
+	"JRX/PXhc4umUTiKiL5L4b6r2SiVoNZfZ9lQKw4SB/dBhXLk3hvgKuFzknbWLXD4F4Hb1ckbmMuPwgvGG",
+	"9lDJdEgLflC+U5pzJswpU+Za5qzXk7H/oG2kFAweHBt2UpLC2AvMDHDpQWYbE+KOkc12u3XlolwQI5uE",
+	"sTIrk8H54yRjCx9QLCYiKyQXHfPRLFNMa+slC0p411gv6Pup+DHny1WfGYz8HcwUXGKNfxgiRcrAIga5",
+	"LvqsYZjomhkVzfdbyTuyBtFp+Bo2TZgMxlX4FZiPPGdVfOyk9JZy61bumnbG10xuupbGPgyJf2GB0AVz",
+	"cqy7qOZGfUHfd3n6xJJpEyYo1dM13YI7AQ5NbHCrsapeqP3g9UENfce84AflA9bo++PvLCrfrY/1iBzr",
+	"jqUq5//t7p0+pemKzWbn8aWC2a29IN8xQTRfItOzvE0x1PAqCohVohbMpCuWEbqk3Dm4TlbHNaAMzwkV",
+	"ZCPeCXknYDwyPesC8aXKo3xPsT7QfMS/PaIZbzLORMqiPGRN34MxHhJcI0sCZ1wbxwaBmRKKfi6k8j5Z",
+	"JvT9jVGMrnXPKaxGLbR9m8iCiYPOIugPVx16ZD0uFtEkYzx9RCbrwmxRDIgOrqWoYc83yibntWGCx+fd",
+	"nrhwLAumiGapFFkpfCDNpcq5p1cRMpSRSbmpcQvrsEEYWEZTA5rMgRJFv+OF5f+nK9ZX3oBGgw0NWlUa",
+	"snCAO6TwnS1loGhGcZ+GqAl7z7WJimywaa+k6iApPL0B/Sa+peFxhcnEn+e6Gxnq6T07vyElS0Z3XjC1",
+	"dJbC7vHWN/+dEZ+vEcPI4jzOOdXssMBF25lgybcnK8u5NhWnHSCSUmRYfYKgchwPnqjyKcymV5jQNN+S",
+	"nyYzclR7XBdKTwLLFNI4KHcBd81odh0t4oixltoxQ+sXz1hF9j7sgBm10YZlV0q+P1hru6U5B8vV2Zc6",
+	"nnnldOiXqis53r3g0/NA6Sio1ndSZUQq8u8N2MgulRlVb0I1+etf//rXhxfi1HX1uk7SkCyVI93ewLGg",
+	"zo2h9vjvl3jRivZeTS7ObKAXQ9JjG3iu/r1XCRyMu4lkli3KmH2f9eVD+yCbVv7k9xqs5V69sh/cWwsJ",
+	"jMyOlXcxg/AWaJx0WUYYH1AbUzFrY1LBE7u31AJfsoancy22ycirsfVeMza86Bg48tyUm+1OOGovW3jq",
+	"VSCRoE9ZaUJCgQeQs2501HHxaevtBRKuNDGEQ2M7WKolFZ12L3xafcOK02Izz7leMd0/9O2eBUI9gzRO",
+	"vouL12Au54me5+aahaLUm18uTn++vry4fAmJJtV/xQ6k1UZ+ZjTvSn7yqZJSWINuK1J0ZW10b/me+4xl",
+	"3XpB1URLqbC2bNsZVgsWRpWBnGoDBIlOJQitHlmsm4QPfOGkTTevjL8fh+yuqogsbEmM2FraNbhwAzac",
+	"fjaT7CJ6GChZbdZUoOQFy7Z2Miw+iY2mOhc6FYSCynQIQgFAXe6SvZhebWtF2F4zUnzAoPBNdMi6o68R",
+	"8SgfEsXQ8471YOtNbrjPiqvsFJq72oYRmVEwElG38ZHMJTerzXyUyvVRJZ6Jf4Olc2QUY0drqg1TR4WS",
+	"RuKjIxfmvH0Sce+GXPB+9659DSJqHTVqG8H/vYmWqvXXXQcHaHOXraWQBngNzfMt4SJVjGrM9Fvmco4/",
+	"+jlhCD9dKLHZw6iFMPEh0vwc3wdrmBnaoawyQz0osxrmDU6lGDWdldh8jTUPvraPtXiJ/3xfxlFx4+89",
+	"XUrDdP7zfad7x7bxqcBrEqVOpCCq9Lr3VhT49zABOfsQoiKD9mPsi+ohcrmO8u7CEMfXmTa7GwH8PJtd",
+	"BX8bKhYQ+mviWXJmQNi9oeRm6UpIbSJbC0kdNOhd2uJG2/dLZbH/C/ceLJ5maj/1C97sRnGn3sOzgWc7",
+	"7iS/qXPxsn9HWzTupTY3G4DsTcGm5eApet8AMLQHeQyjZj+UGh1JHhulSjnhA4neKkh8fBA3YmfGXNVP",
+	"kVjh3fKY/J8URopnDqaHTuIPgnFMbcFzw5R+QOGZnTqqK5bzTcWio/ytyk3xXM63hDpxnLh0hoV0XlF0",
+	"b7QIc/QHz+5bJOnQEGDOmuRvsDluEyBqlXnuzUY3lpBgcIju8NAuLTvZU33gnctRy5f/ZOwgmqf/2Afu",
+	"3KtnddKi1hYMqNZRoIqRlG40aoiK5SDNrc5tevQxH5AfysUBi+kd4lzXfoXBtO+N1NFpaIizzbcHz1bp",
+	"MdSauaMdCFOaZV2hoemZCy6rnDNVHQ2y16QOvRNc4qqmax9raSEub5m6U9KwQ/yRzUW3QZj9DLN6FWqP",
+	"ZVbdZ61DwsTOBBeoILP7W5ld71q35/19BILI5HQudw8Z107uk4HtZnHA16XqgBWpaxfU2vPzqphG482z",
+	"gQPGaPKsivv8gFFeuU/cGA1i159+Kq5Ym/UxeeK2YJeF0c0MpO+eREVTJWGqdeLxLJPQtIZlmD5JoBMS",
+	"seemyRnn2z2SQG2AoFLOtoNMGwZA+g/eSodcRw8XeMe1jNm7S1CgWZThRFaq4s50EYZ26KEnyFAiFdeO",
+	"AAVbE+obb/jZJi+uZr+A13R27avaZlB9Z//3/PLyfJAMzian0xdj+OvH88sxPvhlNgFn6/lk/OP59Gb2",
+	"NnwffrEjhH++bPzbDR3+Xc4RfvKTld/grPFCP+50w1QKQ1NkK2xNeY67cyH/ryyYEMzcSQXBZMjCH3gP",
+	"4ADKk8hFeEh+lBuReZ/0RsEY3gkWGaaVNzVbMfJ64Eq2ZrIgWGz2eoAu37lLAvFRYJsUAbyQimz0WkyN",
+	"jd5roiErlfryN3LNtNyolOmao93nKadEhedW3bXs1dhcdylYZY6fJjMM4UF/H6AXF7ZV3ZyRTIqqVc6q",
+	"bWquJzezcprRa/FavN4cH3/HyAxkMReGqQVNGXH/EJlVfjzK2N5xvsVyWGXgN6V99uxGl+Gmn15O4TPo",
+	"PGRdlUXOXgviMIKxyUktId8Gf9FPBMuH+VIlOXz+B5bxpExYnuyWflzQdMWgoL+21M+Oju7u7kYUn2LJ",
+	"hvtUH51PTycXNxP8pJLT3lzuSjTj2cA2EoAYjS0bHzwbfIc/2YwT5CfNZMCQ/wp/AU+kXt13ZS4zWfiZ",
+	"CqromqHp9ezXnjQ7Iy2VbLbPiJz5hPQdyentpHQOI2NIuDxGIZ5g2WA0VtCfVBPgczFozMs2K1hUW1LO",
+	"TU8Req3uPCTyNNPs90DEFVyXhpGtRTkQrcmMLv3kwQgsLb3SCWbXYlqrEAMAfZ8hzUUKVuyKkbPJ+WQ2",
+	"sbl/v2F8xRLK5dHD1CtGM6ZKZKaL4QvXlLMbgTfJIJTCwPMntl4otSmtjU4oR1CHWLadPUj7WkjLrpvF",
+	"xt7Fa6FHGIB+O5rdfFPV/9Ee/3ZvM7ubGADf0+On7bmFtHuRmrDH4FAD8fuSW1trWd8SNiuICxJW6h4t",
+	"p/Waqi2cdrvmblIji7LauMEyIP+SmTa/+ImZhzGLkOAyIn91FRveH9psoPinZCYI/0O6VuzFPeoo361k",
+	"3th2/ezlIYj5tpkBOTj9JRyhbWZkVv9pbeaQ7OI+hO6b7n9vp5PZjzFl6wN5ReT41zuBOfonJGOpdCqE",
+	"g+vRuIMPFaNSXJ48Aqyi0jqTmz0YxXHv4eearLm2scOyxmpFsIzbLSbqS2iGDg5iPTU2AQ7N/XiEN2Za",
+	"fOLSPqjwisZCRwEjbuWxLAwaNsg7YhcKM/pcagkzK5mF1gVVNGrLip93RjdxCMWWXBumKkq03S9UZOTy",
+	"aja9vLjZsWw1wrlPamD6BNoGp8ck1TbdsCJ5Xw6LMTBQKOq1cpjmyrKyAsa15IRoWWjE6WrufNW4y5BF",
+	"N4xr9r3uOP2Z2l5vRIwslbbLj6PFcKP95u/QYqzN8+hKTAv+5e+8sH1RHFEx9xxUFMW0ZpndsRkrf7Fv",
+	"cO3bF1gHRZFTZ0Nx3QWhr/6ZxNhslIVitNTXsT+KplVWxt/fJ7Vx1kwt2RA3298ea0zofPWwce7vW5zl",
+	"MHVTCna5wLN1kOKZ7NNcEH3A928iYgrJBxW6UtkNaw8VaXXQtQe27Lr151FqO2SV3/VCGoJyCHGEg4xH",
+	"yJZTwAupVGpTmE4xRRsaX2BvMLKtXTGylgT5EfRnBN71R8sks5NjlTPHmgFbR+xcYTj/d91kSdB5UGMS",
+	"CTIIgA2oT11LDWAYdrTvd9cHepI4ORNUgyYX8e/BMjTkvSsq3EviF5uIVXC1ebBVUOTo5hFRpX9Y3wJA",
+	"xI+r4yM0n8hjMCIvRc7fORmW2LtDuG6MQ+Ybg+vGRaCBlXfYQXVEJtWC8wqhbEX+nLnxuOmg3J/XQwEV",
+	"rl8l+6eT7J9RCn+g08cd3OwLFZCrkJqoibUi/XHeGM0zVjFMqnYf14RBReDeIrQ69aeSoF+wVHy5jxVM",
+	"hkGIuXt5wOyKyA4cveGZP5qXVmC/If28YRd+tab3tqafH2JSP0yWUYHpwKW5qr/aq59WqtXua/r8AinY",
+	"d2W71NCx2NGznu70ZxdbirleKR4N5KiAxwqvUguSBsWXc0EG9yTaR4EH9xiCJ096pNgXIHriYiUZfH98",
+	"/LhbPaT0tPcexsa1rOSZu42HV4K4wi17/mvbqHbtnzNVbJpB3FwMRdbhbpNQ1t3a4QnRsuxSAYdC280i",
+	"4EWqWFQ6Zu46lWgkCu5aeZjR6ZqQ7bIkP9Ai6jms2KjV2XNw2r+tQOWEChZ+u6YQIF3C8Y2B7PptdAPc",
+	"ygNec8HXEK85jqVT96JTAdXetBKjoXxEcD5mQLlybU9HMMlubry3JAnXKYFC4y8uKe/uqVAJKt+lAic8",
+	"eNXdFTH187bi2kjllPf+wI+0fRfdApRhIHjgWG3SfimnpsrE0o1STBg4mZFwD7xhW+oxkTId76xWvSEg",
+	"fmPRHiGhxrn9qsjWo3BNHqg3cwBzzjoZ4Y1/42HcMExAjPxTRt6rAH4RkXUHcGFLSGQGTZMvTieEiUxX",
+	"NDKXdOzS0hNydXl+TgpMubdNJDEL7pbmCbmZXU/GL1CZ1yE+YFs54Sm1HWIyrl2tdCWFwn7agSQAFw3i",
+	"A7yDZAAg2YxOGONNspsI0IVFLgwWjiNCNWJwjQiiYkyyKlvBPqLakBPXfuW74woOti9MDAFPog/MSDLs",
+	"vTlit6DBWb20LkEiOaJNFdX2MxzewDpMbn0PduyXhHtCSNfmzlb1cLOy1iJfM23oukhCHoHX1r4JzgTc",
+	"IN9Wmolqe/OyJhk1dETGgiDsmEzjmgzYCiUd3WgwkBTMvY5XLLi96e6yri3a4OGZCQnufiul7Do18hO+",
+	"P/6up1eOb70VWCWqjorRbIvtuEYEet1th2M8Upputa0KNZIYtbU90Oq8v/J+dIUrJattEdm+dhmWobXw",
+	"2op72uYF+0jHGDP/KiKbItL32BymvhNYIXVENI79i9gxrEbSh7kgQsp+vbYgzBonGtY0W6wsd0MLKlbk",
+	"tOPqTSfnmOtLGbtj4yh6F9zt06PyNrLqnzRdxy/PbHf6ahQffKiHpYOUbtHjjadqdO4kXuuBYlRHv9kH",
+	"z5hRkLHUqtmByQfIEsIr9WVSZa5CtWI+X97MSHMPQ4vvcDc/Foav6dYm6jTH97k6a4oVBs73iAk7eDrS",
+	"xq3fUaWxejV4N6t5REOrClSEqsBgL/9BYMo2562hhAzug+4tt1QKrYe78lytbfgpiFNrhnw4cSx6s96e",
+	"yV5r95tH257J5BuardFdk2+/tYRZYc+Z3zspY3vS/P5YdGmcvw4jXMmUadvBDziaWMYI4SAn5+AIhNeH",
+	"hOZ3oBZc/sM1to0PNiJnPrcjYwVD9ageFwPCgD0t0u1QG2q6D9a5fQtqsfQno1H/BqkBjvuk+P74qPjh",
+	"+6Pihx9Crw33VmjQHIQMFMOWV70s2B1Zc7ExLLZ5crkchlvAug6WvzPrUxwtP9cDmE7ApXG0Yhd0NUjR",
+	"nSYTxf3xwyF+mo8dCukl7+57zdrxiT5jw43gNqMdoBrNjsRvK4t46lKxVxVQaLhfrXW5XeLsa8WwVppl",
+	"kd0eVneIXsKjP5zsue/c/Zf+X9j/9rEOQLNEfX9lpv8EdOKHJ6LipHGXs1qZi+vrr2kLQ1hy1y9gW9NC",
+	"EyMH9y2HVdM2xzDk/4ZNojQzf9+YxfB/HWij99z9xkXp8HGOhfLCt7ob+x567ByhDdq5yNAGdvvJeH+z",
+	"EeAcr1LBdrVdFnbjEzhA1a/aGwHxJYAXd4I11oaQCn3HVOgsX1X5vJSx58Zxj27lVAotbWFzhr2V6Z9E",
+	"kjrAcfv7v8OdL7UNlmBfW9DPbQcX97O2p8N+izqrv+QHfWO2mJ1wUaNTee6O/gg8uJvHfDaKWd8D1NcQ",
+	"C0MZk3ZAVy7E9+TjxvYTDGEHm0tjv+SKoPTpkgq+iX2N9D2FL/aGkACON9Uan3eue3whYli7Imk3TZv1",
+	"fVEsL/kowDW7oZXkcqcD4cWbxKPghvc7eLTtiX5U6Yl+9Idzh9zbK40+Nvl9V3Y7qUUWkbIPhjSjhWFq",
+	"ePs0jmHZwr2JX9Lh77rBga82erWX8doAt6RUFnVZ9FLUMix3mWucLxUs3TdEhTkMsCvCENqzDl7eAWvo",
+	"MimrnIJ2SjDRYKPZiIzhHauWCFkOhsEhW4RKse2BHVxIG+7tjGYtD4sIoTd6QXPNEssg/N1e3grnImPC",
+	"OL+OkUTTW4Y9TKo1sBA8d286b6tCQbwkXBBK5kreaaY6YC4UM2YbA7ss1joswP5+iB1Z55tF/Ti0TzcS",
+	"FrPIllIuczbyH45ujNqkxmbEIWEgI8Bm0FVy732nOEpCs/uQOTDngqpt1HPZn9K889Tu0AAAJ2D4oTsD",
+	"gX0NS/HL+MU5sQCOyA0cdsDdRnONLKwZUr/tH2+iYTTrPyw/wxv7nOCfJ+Ozmix2Pn2g7E+TWen9CcUU",
+	"dQzx+xLFytemhXE5WCbvRC7djjQlI/CRbOxY0cC7j0fY9hdPjo+/MomvTOKLZRI9u9+G6PAxwcdIhH5e",
+	"EA7FZ2AGu1Bp8IkWZvuziqcPYhVPv7KKr6zivwGreNrPKp4exCqeflZW8fQgVvH0AayCFsVwae62B3OL",
+	"cVH8ZO62XznGV47xxXOM+CGoMo1xCQr5iRp2R7d7sxB3Uj4jG+nBL85JutDdzVd0L+vQXx0XXzzbaPu2",
+	"2wzDLxCS1scqG479d2xrG0xGHatNf3nyAaxLH8i7IG75BXAvFz/Bv+LRE19BZKnYJnk/99Kf0XGiY8yp",
+	"D9U4ZyqzuVxNVSd3chdBHZL7by+itN/Zthdmo8QIUtCErXWz4fCFtqm9trDOlrdYSsKvlFzRJRfUsKx6",
+	"GZUrRLmiS2a3osGExVuWy6KrTADnrB31UBB0sm99UuVWiRIzuDK2Y06LXnzSvYqi6s23/KTVS5ib13kS",
+	"RV2KHxU+BzeUBuENox2wKrZQTK8ObLlVB7C1dI27zjQWpQGTHuOFyqTKBm5FNlIyHd0+wZZPJY+nZA4Y",
+	"5/bu3HivwLD0H5GT79nFqbJPd7Zwiu7ufb5i2PIpGfQQ8JAuG0u2g8vuWTzs678OLiI+JI0bebw/C+NQ",
+	"IfrbJnAc2/Gzxttc4t4uFmcTDz9F0lhtwq7YZf/Vjqg4WPysMgd/WX2uzLiAo8JZFi4ZDhcddhPV0Qql",
+	"zN4Q1Km9u6LM4b9HLcKj09ydsjjJA6dynNXlIAU4CRe20soWXf0Ty9arDDi08HK5wOWnSa1q4ydb21El",
+	"fN+aBIrisgQoQ1fVGKgIZ1UgUJi1vlJ/2D/udyUru1vRmyHfnYqA3JhiY1zMigwJrBj5xpX9fQuCPM25",
+	"b2waFPnT8+kQ+1YpJjKmbMOHaFkyjhutL4OZBskgzflHaAsb0cHLkP6jarMdC4XbYLHJ8+762U+TAGLn",
+	"giWFIP23xN2oGsk16Kh6t6kULTTZ+8Jd9tKzHyf40sfKQ9rTTtk/b6m+VO6+V2NoulqXtXVlvmogWEX4",
+	"+i4QZ1wXUnNfqPKA9uCNhktC1sGzKU7/2f5OMI4cBWPRQqpgvVNU6vpFS2N599jF1j5d8Jx92fuZr/1+",
+	"/iJw6Er/seduum6cu1454JtX1dtcAd6UC6ZCJwBY5KQmsLARrJVvvnUhPOw2YnCiB/QNrrsGShXVQYh3",
+	"gaKgd9eFkLVUzMIIv5V5bTGwyqeP1fHQbqYHdTx0n36cOxkeVmXwwXz3M3disiTFxj6fpe+Snf/h7QLh",
+	"3IVOf+hcCUpseVmmrqXbtrNhu3r61YH+wMslbJJgB29FzzxepFmb0nIViq103TLZKw7x49iSWQW5/EH3",
+	"VRrNqu8d1Ey9OoNrlU53eHScK0dq58wpK6Org53Co9ns/FFdPTbq0LpC0t8+gNcbemHyWH062nPaWxZz",
+	"apg2pFhR62zC2XsuyIH3ojbC9GI6m47Pp/+aVG4vGySD08sXL6azQTIYX12dw4Vj4+eX17O9ulO0YUbp",
+	"xjWx9+LFQfTP2iBeTS7O2rerWQDt3wDjtP/OtT7PXQgntRfXtmpIQiWGTZdHWIHsdjrfpC2k0yO5tft4",
+	"RE7les4F06WrVuJg7vbdxMY2eveOHeqDN6y7CR6v2CrrwrhtkdG1MMCkBtHOUj13nXcpGdo0qOw941Y2",
+	"24tFtSELrrTrs6CYDn1xXD8n78xRNvbw8d3dKAUwZtAAHpzfI/JPjl1EvDvfcSTvla00xanAD7AjIwaW",
+	"7zRDQ/MOdB7Zk97jqK7dSf0ZvdVJV9RXg7xmeVZxwFV74OFh4llinXSJ2/J2K5XfrZmhWP0/9y1arGLJ",
+	"FZF3ArqG26IX9wVgxJdCKta13+yLh3F3xfRm7aoluca78EqdpqBLV+u3pLdOvqQbpbHRC+FGk/8aXrD3",
+	"Znhqfww6aww6++Gh0MG5LLnjQVeKJ9Zqbd2I3gGee3bIdnDgtfj2RvimcQiDYlbngcBmVamoFZp2qAn0",
+	"7k8RUGncd3yf7P2+pfmfPZxS28eDZ3HO5U/EimLAHu+MrOuPumwjmvhmfNqWHMO/BHtvcIiHmQnU7exK",
+	"c6XQhskfSyuU7I2c1BqnVp+XGxP1CdU1Z0OXoDO3Lrh+01LHj8oGWvto5Tf27c/SoQt1nhojqPbVKnu7",
+	"+Lv/+xqufXHdrGKLRmbtXbslK1oUTBy2Cf7g2f2eW2DfjKovVrZ+ogsxd0UvuotiK3Ss5KpNz3bxBVxl",
+	"Miaai2XedKx0b5ZP4iiuoDQ9i7tXedblHm7ieJRSkdqeJp8adNs8L2PvD8Chyz18iljUj15tZx66QUrg",
+	"cHf90BHHqHyNItIxPdjOeFcrGfp+nXPGRHnbdOKbVcM88NiZmshqT3ZPhmY9z3NSMJFhb0K4S6eqabnY",
+	"CmSJGknoHJ2vzrZbM61BqttsS260i63HvV4dW4ZYmhPqYagB2FD0DuSvR9CuG3p1f+n78trh0bMzP7HT",
+	"GO1QB1VtxYLy9KmuYwtr/MBbhj/S2cWDWmkX724DKE/WHZw79IPIPIfjjUjsd3QC5WFj4IeEPvzc+M4b",
+	"nerIK/fCp8hyeQGueT/h4fkGHhcya7r5fVPPxAfkGPZnJwsuMu8axCx32C3/fwAzKPll9NEAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
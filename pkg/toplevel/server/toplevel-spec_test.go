@@ -6,9 +6,24 @@
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"encoding/xml"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+	"github.com/labstack/echo/v4"
 	"gotest.tools/assert"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
+
+	yamlv2 "gopkg.in/yaml.v2"
 )
 
 func Test_TopLevelSpec(t *testing.T) {
@@ -19,4 +34,166 @@ func Test_TopLevelSpec(t *testing.T) {
 	assert.NilError(t, err)
 
 	assert.Assert(t, swagger != nil)
+	// Each path keeps its own operations - a path key lost in an edit would fold its methods into the one above
+	assert.Equal(t, "TargetsTopLevel", swagger.Paths.Find("/targets").Get.OperationID)
+}
+
+// Test_specsNoDuplicateKeys - a key repeated in a mapping, such as a second get under one path, is
+// silently dropped by the usual YAML decoding, so each spec is decoded strictly
+func Test_specsNoDuplicateKeys(t *testing.T) {
+	// Test_TopLevelSpec changes the working directory, so the specs are found from this file
+	_, thisFile, _, _ := runtime.Caller(0)
+	specs, err := filepath.Glob(filepath.Join(filepath.Dir(thisFile), "../../../api/*.yaml"))
+	assert.NilError(t, err)
+	assert.Assert(t, len(specs) > 0)
+	for _, spec := range specs {
+		content, err := ioutil.ReadFile(spec)
+		assert.NilError(t, err)
+		var doc interface{}
+		assert.NilError(t, yamlv2.UnmarshalStrict(content, &doc), spec)
+	}
+}
+
+func Test_acceptTypesGzip(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	for _, accept := range []string{"application/json", "application/yaml"} {
+		req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+		req.Header.Set("Accept", accept)
+		req.Header.Set(echo.HeaderAcceptEncoding, "gzip, deflate")
+		rec := httptest.NewRecorder()
+		assert.NilError(t, acceptTypes(echo.New().NewContext(req, rec), swagger))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+		gz, err := gzip.NewReader(rec.Body)
+		assert.NilError(t, err)
+		body, err := ioutil.ReadAll(gz)
+		assert.NilError(t, err)
+
+		spec := new(openapi3.T)
+		assert.NilError(t, yaml.Unmarshal(body, spec), accept)
+		assert.Equal(t, swagger.Info.Title, spec.Info.Title)
+		assert.Equal(t, len(swagger.Paths), len(spec.Paths))
+	}
+}
+
+func Test_acceptTypesNoGzip(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	assert.NilError(t, acceptTypes(echo.New().NewContext(req, rec), swagger))
+
+	assert.Equal(t, "", rec.Header().Get(echo.HeaderContentEncoding))
+	spec := new(openapi3.T)
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), spec))
+	assert.Equal(t, swagger.Info.Title, spec.Info.Title)
+}
+
+func Test_acceptTypesETag(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+		req.Header.Set("Accept", "application/yaml")
+		if ifNoneMatch != "" {
+			req.Header.Set(headerIfNoneMatch, ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPath("/spec")
+		assert.NilError(t, acceptTypes(ctx, swagger))
+		return rec
+	}
+
+	first := get("")
+	assert.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get(headerETag)
+	assert.Assert(t, etag != "")
+
+	notModified := get(`"other", ` + etag)
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+	assert.Equal(t, etag, notModified.Header().Get(headerETag))
+	assert.Equal(t, 0, notModified.Body.Len())
+
+	assert.Equal(t, http.StatusOK, get(`W/"other"`).Code)
+}
+
+func Test_acceptTypesETagPerRepresentation(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	get := func(accept string, acceptEncoding string, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+		req.Header.Set("Accept", accept)
+		req.Header.Set(echo.HeaderAcceptEncoding, acceptEncoding)
+		req.Header.Set(headerIfNoneMatch, ifNoneMatch)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPath("/spec")
+		assert.NilError(t, acceptTypes(ctx, swagger))
+		return rec
+	}
+
+	yamlETag := get("application/yaml", "", "").Header().Get(headerETag)
+	yamlGzipETag := get("application/yaml", "gzip", "").Header().Get(headerETag)
+	jsonETag := get("application/json", "", "").Header().Get(headerETag)
+	assert.Assert(t, yamlETag != yamlGzipETag)
+	assert.Assert(t, yamlETag != jsonETag)
+
+	// A cached YAML response must not stand in for the JSON one
+	rec := get("application/json", "", yamlETag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, jsonETag, rec.Header().Get(headerETag))
+	assert.DeepEqual(t, []string{echo.HeaderAccept, echo.HeaderAcceptEncoding}, rec.Header().Values(echo.HeaderVary))
+}
+
+func Test_acceptTypesXML(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+	req.Header.Set("Accept", "application/xml")
+	rec := httptest.NewRecorder()
+	assert.NilError(t, acceptTypes(echo.New().NewContext(req, rec), swagger))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echo.MIMEApplicationXML, rec.Header().Get(echo.HeaderContentType))
+	// Well-formed - the whole document can be read back
+	var doc struct {
+		XMLName xml.Name
+		Openapi string `xml:"openapi"`
+		Info    struct {
+			Title string `xml:"title"`
+		} `xml:"info"`
+	}
+	assert.NilError(t, xml.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Equal(t, "openapi", doc.XMLName.Local)
+	assert.Equal(t, swagger.OpenAPI, doc.Openapi)
+	assert.Equal(t, swagger.Info.Title, doc.Info.Title)
+	dec := xml.NewDecoder(bytes.NewReader(rec.Body.Bytes()))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+	}
+}
+
+func Test_acceptTypesUnsupported(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.NilError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/spec", nil)
+	req.Header.Set("Accept", "application/pdf")
+	err = acceptTypes(echo.New().NewContext(req, httptest.NewRecorder()), swagger)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusNotImplemented, httpErr.Code)
 }
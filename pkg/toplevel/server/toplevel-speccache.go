@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	aether_2_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_2_0_0/server"
+	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
+	app_gtwy "github.com/onosproject/aether-roc-api/pkg/app_gtwy/server"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"sync"
+)
+
+// The specs served by GetSpec, GetAether200Spec, GetAether400Spec, GetAetherAppGtwySpec and GetAllSpecs
+var (
+	topLevelSpec  = newSpecCache(GetSwagger)
+	aether200Spec = newSpecCache(aether_2_0_0.GetSwagger)
+	aether400Spec = newSpecCache(aether_4_0_0.GetSwagger)
+	appGtwySpec   = newSpecCache(app_gtwy.GetSwagger)
+	allSpecs      = newDocumentCache(modelVersionSpecs)
+)
+
+// specEncoding - one encoding of a spec, made the first time it is asked for
+type specEncoding struct {
+	once sync.Once
+	data []byte
+	err  error
+}
+
+func (e *specEncoding) get(encode func() ([]byte, error)) ([]byte, error) {
+	e.once.Do(func() {
+		e.data, e.err = encode()
+	})
+	return e.data, e.err
+}
+
+// specCache - a spec and its encodings, each made only once. The specs are compiled in, so
+// nothing is ever invalidated
+type specCache struct {
+	getDocument func() (interface{}, error)
+	specOnce    sync.Once
+	spec        interface{}
+	specErr     error
+	compact     specEncoding
+	indented    specEncoding
+	yaml        specEncoding
+	xml         specEncoding
+	protobuf    specEncoding
+	etag        specEncoding
+}
+
+func newSpecCache(getSwagger func() (*openapi3.T, error)) *specCache {
+	return newDocumentCache(func() (interface{}, error) {
+		return getSwagger()
+	})
+}
+
+// newDocumentCache - a cache of any document that is encoded as a spec is, e.g. a map of specs
+func newDocumentCache(getDocument func() (interface{}, error)) *specCache {
+	return &specCache{getDocument: getDocument}
+}
+
+// load - the spec, loaded once
+func (c *specCache) load() (interface{}, error) {
+	c.specOnce.Do(func() {
+		c.spec, c.specErr = c.getDocument()
+	})
+	return c.spec, c.specErr
+}
+
+// compactJSON - the spec as JSON without indentation, from which the other encodings are made
+func (c *specCache) compactJSON() ([]byte, error) {
+	return c.compact.get(func() ([]byte, error) {
+		spec, err := c.load()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(spec)
+	})
+}
+
+func (c *specCache) indentedJSON() ([]byte, error) {
+	return c.indented.get(func() ([]byte, error) {
+		spec, err := c.load()
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(spec, "", "  ")
+	})
+}
+
+func (c *specCache) yamlBytes() ([]byte, error) {
+	return c.yaml.get(func() ([]byte, error) {
+		jsonFirst, err := c.compactJSON()
+		if err != nil {
+			return nil, err
+		}
+		return yaml.JSONToYAML(jsonFirst)
+	})
+}
+
+func (c *specCache) xmlBytes() ([]byte, error) {
+	return c.xml.get(func() ([]byte, error) {
+		jsonFirst, err := c.compactJSON()
+		if err != nil {
+			return nil, err
+		}
+		return utils.JSONToXML("openapi", jsonFirst)
+	})
+}
+
+// protobufBytes - the spec as a google.protobuf.Struct. There is no protobuf message of the OpenAPI
+// model itself, so this is the JSON form of the spec in protobuf's own JSON types - numbers are doubles
+func (c *specCache) protobufBytes() ([]byte, error) {
+	return c.protobuf.get(func() ([]byte, error) {
+		jsonFirst, err := c.compactJSON()
+		if err != nil {
+			return nil, err
+		}
+		var spec types.Struct
+		if err = jsonpb.Unmarshal(bytes.NewReader(jsonFirst), &spec); err != nil {
+			return nil, err
+		}
+		return proto.Marshal(&spec)
+	})
+}
+
+// eTag - a weak ETag of one representation of the spec e.g. yaml-gzip, from the hash of the spec
+// and the name of the representation, so that no two representations share one
+func (c *specCache) eTag(representation string) (string, error) {
+	hash, err := c.etag.get(func() ([]byte, error) {
+		jsonResp, err := c.compactJSON()
+		if err != nil {
+			return nil, err
+		}
+		return []byte(fmt.Sprintf("%x", sha256.Sum256(jsonResp))), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`W/"%s-%s"`, hash, representation), nil
+}
+
+// servedSpecs - the specs of every API the server registers, by name
+var servedSpecs = []struct {
+	name       string
+	getSwagger func() (*openapi3.T, error)
+}{
+	{name: "top level", getSwagger: GetSwagger},
+	{name: "aether-2.0.0", getSwagger: aether_2_0_0.GetSwagger},
+	{name: "aether-4.0.0", getSwagger: aether_4_0_0.GetSwagger},
+	{name: "aether-app-gtwy", getSwagger: app_gtwy.GetSwagger},
+}
+
+// ValidateSpecs - check every embedded spec loads and is valid OpenAPI 3. Called at startup, so that
+// a spec broken by code generation stops the server rather than failing the first GetSpec
+func ValidateSpecs() error {
+	for _, served := range servedSpecs {
+		swagger, err := served.getSwagger()
+		if err != nil {
+			return fmt.Errorf("unable to load %s spec %v", served.name, err)
+		}
+		if err = swagger.Validate(context.Background()); err != nil {
+			return fmt.Errorf("invalid %s spec %v", served.name, err)
+		}
+	}
+	return nil
+}
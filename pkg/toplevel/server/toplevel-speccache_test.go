@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/labstack/echo/v4"
+	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func Test_specCache(t *testing.T) {
+	loads := 0
+	cache := newSpecCache(func() (*openapi3.T, error) {
+		loads++
+		return GetSwagger()
+	})
+
+	for _, accept := range []string{"application/json", "application/yaml", "application/xml", "application/json"} {
+		req := httptest.NewRequest(http.MethodGet, "/aether-top-level-openapi3.yaml", nil)
+		req.Header.Set("Accept", accept)
+		rec := httptest.NewRecorder()
+		assert.NilError(t, serveSpec(echo.New().NewContext(req, rec), cache))
+		assert.Equal(t, http.StatusOK, rec.Code, accept)
+	}
+	assert.Equal(t, 1, loads)
+
+	jsonFirst, err := cache.indentedJSON()
+	assert.NilError(t, err)
+	jsonAgain, err := cache.indentedJSON()
+	assert.NilError(t, err)
+	assert.Equal(t, &jsonFirst[0], &jsonAgain[0])
+}
+
+func Test_specPretty(t *testing.T) {
+	cache := newSpecCache(GetSwagger)
+	serve := func(query string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, "/aether-top-level-openapi3.yaml"+query, nil)
+		req.Header.Set("Accept", "application/json")
+		rec := httptest.NewRecorder()
+		return rec, serveSpec(echo.New().NewContext(req, rec), cache)
+	}
+	compact, err := cache.compactJSON()
+	assert.NilError(t, err)
+	indented, err := cache.indentedJSON()
+	assert.NilError(t, err)
+
+	// Indented unless asked otherwise
+	for query, expected := range map[string][]byte{"": indented, "?pretty=true": indented, "?pretty=false": compact} {
+		rec, err := serve(query)
+		assert.NilError(t, err, query)
+		assert.DeepEqual(t, expected, rec.Body.Bytes())
+	}
+	_, err = serve("?pretty=maybe")
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_specHead(t *testing.T) {
+	e := echo.New()
+	assert.NilError(t, RegisterHandlers(e, &TopLevelServer{}))
+	for _, path := range []string{"/aether-top-level-openapi3.yaml", "/aether-2.0.0-openapi3.yaml", "/aether-4.0.0-openapi3.yaml",
+		"/aether-app-gtwy-openapi3.yaml", "/specs"} {
+		for _, encoding := range []string{"", "gzip"} {
+			call := func(method string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(method, path, nil)
+				req.Header.Set(echo.HeaderAccept, mimeAny)
+				req.Header.Set(echo.HeaderAcceptEncoding, encoding)
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				return rec
+			}
+			get := call(http.MethodGet)
+			head := call(http.MethodHead)
+			assert.Equal(t, http.StatusOK, head.Code, path)
+			assert.Equal(t, 0, head.Body.Len(), path)
+			assert.Equal(t, strconv.Itoa(get.Body.Len()), head.Header().Get(echo.HeaderContentLength), path)
+			for _, header := range []string{echo.HeaderContentType, echo.HeaderContentEncoding, headerETag} {
+				assert.Equal(t, get.Header().Get(header), head.Header().Get(header), path+" "+header)
+			}
+		}
+	}
+}
+
+func Test_specProtobuf(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/aether-4.0.0-openapi3.yaml", nil)
+	req.Header.Set("Accept", mimeProtobuf)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, serveSpec(echo.New().NewContext(req, rec), aether400Spec))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, mimeProtobuf, rec.Header().Get(echo.HeaderContentType))
+
+	var spec types.Struct
+	assert.NilError(t, proto.Unmarshal(rec.Body.Bytes(), &spec))
+	assert.Equal(t, "3.0.0", spec.Fields["openapi"].GetStringValue())
+	assert.Assert(t, spec.Fields["components"].GetStructValue().Fields["schemas"] != nil)
+}
+
+func benchmarkSpec(b *testing.B, serve func(ctx echo.Context) error) {
+	e := echo.New()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		req := httptest.NewRequest(http.MethodGet, "/aether-4.0.0-openapi3.yaml", nil)
+		req.Header.Set("Accept", "application/json")
+		if err := serve(e.NewContext(req, httptest.NewRecorder())); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_serveSpec(b *testing.B) {
+	swagger, err := aether_4_0_0.GetSwagger()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("uncached", func(b *testing.B) {
+		benchmarkSpec(b, func(ctx echo.Context) error {
+			return acceptTypes(ctx, swagger)
+		})
+	})
+	b.Run("cached", func(b *testing.B) {
+		cache := newSpecCache(aether_4_0_0.GetSwagger)
+		benchmarkSpec(b, func(ctx echo.Context) error {
+			return serveSpec(ctx, cache)
+		})
+	})
+}
+
+func Test_GetAllSpecs(t *testing.T) {
+	server := &TopLevelServer{}
+	for _, accept := range []string{"application/json", "application/yaml"} {
+		req := httptest.NewRequest(http.MethodGet, "/specs", nil)
+		req.Header.Set(echo.HeaderAccept, accept)
+		rec := httptest.NewRecorder()
+		assert.NilError(t, server.GetAllSpecs(echo.New().NewContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code, accept)
+
+		body := rec.Body.Bytes()
+		if accept == "application/yaml" {
+			var err error
+			body, err = yaml.YAMLToJSON(body)
+			assert.NilError(t, err)
+		}
+		var specs map[string]*openapi3.T
+		assert.NilError(t, json.Unmarshal(body, &specs))
+		assert.Equal(t, 2, len(specs), accept)
+		assert.Equal(t, "4.0.0", specs["4.0.0"].Info.Version)
+		assert.Assert(t, specs["2.0.0"].Components.Schemas["Enterprises"] != nil)
+	}
+}
+
+func Test_ValidateSpecs(t *testing.T) {
+	assert.NilError(t, ValidateSpecs())
+
+	served := servedSpecs
+	defer func() { servedSpecs = served }()
+	servedSpecs = append(servedSpecs, servedSpecs[0])
+	servedSpecs[len(servedSpecs)-1].name = "broken"
+	servedSpecs[len(servedSpecs)-1].getSwagger = func() (*openapi3.T, error) {
+		return &openapi3.T{OpenAPI: "3.0.0"}, nil
+	}
+	assert.ErrorContains(t, ValidateSpecs(), "invalid broken spec")
+}
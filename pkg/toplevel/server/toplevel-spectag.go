@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"strings"
+)
+
+const componentsRefPrefix = "#/components/"
+
+// forTag - a cache of the spec reduced to the operations with the tag, made for the one request as
+// any tag may be asked for. A map of specs, as GetAllSpecs serves, has each of them reduced
+func (c *specCache) forTag(tag string) *specCache {
+	return newDocumentCache(func() (interface{}, error) {
+		document, err := c.load()
+		if err != nil {
+			return nil, err
+		}
+		switch spec := document.(type) {
+		case *openapi3.T:
+			return tagFilteredSpec(spec, tag)
+		case map[string]*openapi3.T:
+			filtered := make(map[string]*openapi3.T, len(spec))
+			for version, versionSpec := range spec {
+				if filtered[version], err = tagFilteredSpec(versionSpec, tag); err != nil {
+					return nil, err
+				}
+			}
+			return filtered, nil
+		}
+		return nil, fmt.Errorf("unable to filter a %T by tag", document)
+	})
+}
+
+// tagFilteredSpec - a copy of the spec with only the operations that have the tag, and only the
+// components they refer to, directly or through other components. For a tag that no operation has,
+// there are no paths, which is still a valid spec
+func tagFilteredSpec(spec *openapi3.T, tag string) (*openapi3.T, error) {
+	filtered := &openapi3.T{
+		ExtensionProps: spec.ExtensionProps,
+		OpenAPI:        spec.OpenAPI,
+		Info:           spec.Info,
+		Paths:          openapi3.Paths{},
+		Security:       spec.Security,
+		Servers:        spec.Servers,
+		ExternalDocs:   spec.ExternalDocs,
+		Components:     openapi3.Components{SecuritySchemes: spec.Components.SecuritySchemes},
+	}
+	for _, specTag := range spec.Tags {
+		if specTag.Name == tag {
+			filtered.Tags = openapi3.Tags{specTag}
+		}
+	}
+	for path, pathItem := range spec.Paths {
+		var kept *openapi3.PathItem
+		for method, operation := range pathItem.Operations() {
+			if !hasTag(operation, tag) {
+				continue
+			}
+			if kept == nil {
+				kept = &openapi3.PathItem{
+					ExtensionProps: pathItem.ExtensionProps,
+					Summary:        pathItem.Summary,
+					Description:    pathItem.Description,
+					Servers:        pathItem.Servers,
+					Parameters:     pathItem.Parameters,
+				}
+			}
+			kept.SetOperation(method, operation)
+		}
+		if kept != nil {
+			filtered.Paths[path] = kept
+		}
+	}
+
+	// Follow the $refs from the paths kept to every component they need
+	refs := make(map[string]bool)
+	pending, err := jsonRefs(filtered.Paths)
+	if err != nil {
+		return nil, err
+	}
+	for len(pending) > 0 {
+		ref := pending[0]
+		pending = pending[1:]
+		if refs[ref] {
+			continue
+		}
+		refs[ref] = true
+		component, ok := addComponent(&filtered.Components, &spec.Components, ref)
+		if !ok {
+			continue
+		}
+		componentRefs, err := jsonRefs(component)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, componentRefs...)
+	}
+	return filtered, nil
+}
+
+func hasTag(operation *openapi3.Operation, tag string) bool {
+	for _, operationTag := range operation.Tags {
+		if operationTag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonRefs - every $ref in the JSON form of value
+func jsonRefs(value interface{}) ([]string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	refs := make([]string, 0)
+	var walk func(interface{})
+	walk = func(node interface{}) {
+		switch typed := node.(type) {
+		case map[string]interface{}:
+			for key, child := range typed {
+				if ref, ok := child.(string); ok && key == "$ref" {
+					refs = append(refs, ref)
+					continue
+				}
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range typed {
+				walk(child)
+			}
+		}
+	}
+	walk(decoded)
+	return refs, nil
+}
+
+// addComponent - copy the component that ref (#/components/kind/name) names from the spec's components
+// to the filtered ones. Returns the component, or false if ref is not to a component of the spec
+func addComponent(filtered *openapi3.Components, components *openapi3.Components, ref string) (interface{}, bool) {
+	kindName := strings.SplitN(strings.TrimPrefix(ref, componentsRefPrefix), "/", 2)
+	if !strings.HasPrefix(ref, componentsRefPrefix) || len(kindName) != 2 {
+		return nil, false
+	}
+	name := kindName[1]
+	switch kindName[0] {
+	case "schemas":
+		if component, ok := components.Schemas[name]; ok {
+			if filtered.Schemas == nil {
+				filtered.Schemas = openapi3.Schemas{}
+			}
+			filtered.Schemas[name] = component
+			return component, true
+		}
+	case "parameters":
+		if component, ok := components.Parameters[name]; ok {
+			if filtered.Parameters == nil {
+				filtered.Parameters = openapi3.ParametersMap{}
+			}
+			filtered.Parameters[name] = component
+			return component, true
+		}
+	case "headers":
+		if component, ok := components.Headers[name]; ok {
+			if filtered.Headers == nil {
+				filtered.Headers = openapi3.Headers{}
+			}
+			filtered.Headers[name] = component
+			return component, true
+		}
+	case "requestBodies":
+		if component, ok := components.RequestBodies[name]; ok {
+			if filtered.RequestBodies == nil {
+				filtered.RequestBodies = openapi3.RequestBodies{}
+			}
+			filtered.RequestBodies[name] = component
+			return component, true
+		}
+	case "responses":
+		if component, ok := components.Responses[name]; ok {
+			if filtered.Responses == nil {
+				filtered.Responses = openapi3.Responses{}
+			}
+			filtered.Responses[name] = component
+			return component, true
+		}
+	case "examples":
+		if component, ok := components.Examples[name]; ok {
+			if filtered.Examples == nil {
+				filtered.Examples = openapi3.Examples{}
+			}
+			filtered.Examples[name] = component
+			return component, true
+		}
+	}
+	return nil, false
+}
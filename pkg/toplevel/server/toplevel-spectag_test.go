@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	aether_4_0_0 "github.com/onosproject/aether-roc-api/pkg/aether_4_0_0/server"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_tagFilteredSpec(t *testing.T) {
+	spec, err := aether_4_0_0.GetSwagger()
+	assert.NilError(t, err)
+
+	filtered, err := tagFilteredSpec(spec, "Site_Site")
+	assert.NilError(t, err)
+	assert.NilError(t, filtered.Validate(context.Background()))
+	assert.Assert(t, len(filtered.Paths) > 0)
+	assert.Assert(t, len(filtered.Paths) < len(spec.Paths))
+	for path, pathItem := range filtered.Paths {
+		for _, operation := range pathItem.Operations() {
+			assert.Assert(t, hasTag(operation, "Site_Site"), path)
+		}
+	}
+	assert.Assert(t, filtered.Components.Schemas["Site_Site"] != nil)
+	assert.Assert(t, len(filtered.Components.Schemas) < len(spec.Components.Schemas))
+	// Every $ref left can be followed
+	refs, err := jsonRefs(filtered)
+	assert.NilError(t, err)
+	for _, ref := range refs {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		if name != ref {
+			assert.Assert(t, filtered.Components.Schemas[name] != nil, ref)
+		}
+	}
+	// The spec served unfiltered is not changed
+	assert.Assert(t, len(spec.Paths) > len(filtered.Paths))
+
+	empty, err := tagFilteredSpec(spec, "no-such-tag")
+	assert.NilError(t, err)
+	assert.NilError(t, empty.Validate(context.Background()))
+	assert.Equal(t, 0, len(empty.Paths))
+	assert.Equal(t, 0, len(empty.Components.Schemas))
+}
+
+func Test_GetAether400Spec_tag(t *testing.T) {
+	server := &TopLevelServer{}
+	for tag, want := range map[string]bool{"Site_Site": true, "no-such-tag": false} {
+		req := httptest.NewRequest(http.MethodGet, "/aether-4.0.0-openapi3.yaml?tag="+tag, nil)
+		req.Header.Set("Accept", "application/json")
+		rec := httptest.NewRecorder()
+		assert.NilError(t, server.GetAether400Spec(echo.New().NewContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code, tag)
+
+		var spec openapi3.T
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &spec), tag)
+		assert.Equal(t, want, len(spec.Paths) > 0, tag)
+	}
+}
@@ -0,0 +1,369 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTransactionClient - a TransactionServiceClient that serves a fixed set of transactions
+type fakeTransactionClient struct {
+	transactions []*configapi.Transaction
+}
+
+func (f *fakeTransactionClient) GetTransaction(ctx context.Context, in *admin.GetTransactionRequest, opts ...grpc.CallOption) (*admin.GetTransactionResponse, error) {
+	for _, t := range f.transactions {
+		if t.ID == in.ID || (in.Index > 0 && t.Index == in.Index) {
+			return &admin.GetTransactionResponse{Transaction: t}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "transaction %s not found", in.ID)
+}
+
+func (f *fakeTransactionClient) ListTransactions(ctx context.Context, in *admin.ListTransactionsRequest, opts ...grpc.CallOption) (admin.TransactionService_ListTransactionsClient, error) {
+	return &fakeListStream{transactions: f.transactions}, nil
+}
+
+func (f *fakeTransactionClient) WatchTransactions(ctx context.Context, in *admin.WatchTransactionsRequest, opts ...grpc.CallOption) (admin.TransactionService_WatchTransactionsClient, error) {
+	return &fakeWatchStream{transactions: f.transactions}, nil
+}
+
+type fakeListStream struct {
+	grpc.ClientStream
+	transactions []*configapi.Transaction
+}
+
+func (s *fakeListStream) Recv() (*admin.ListTransactionsResponse, error) {
+	if len(s.transactions) == 0 {
+		return nil, io.EOF
+	}
+	t := s.transactions[0]
+	s.transactions = s.transactions[1:]
+	return &admin.ListTransactionsResponse{Transaction: t}, nil
+}
+
+type fakeWatchStream struct {
+	grpc.ClientStream
+	transactions []*configapi.Transaction
+}
+
+func (s *fakeWatchStream) Recv() (*admin.WatchTransactionsResponse, error) {
+	if len(s.transactions) == 0 {
+		return nil, io.EOF
+	}
+	t := s.transactions[0]
+	s.transactions = s.transactions[1:]
+	return &admin.WatchTransactionsResponse{
+		TransactionEvent: configapi.TransactionEvent{Type: configapi.TransactionEvent_CREATED, Transaction: *t},
+	}, nil
+}
+
+func Test_GetTransactionsStream(t *testing.T) {
+	server := &TopLevelServer{
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			{ID: "tx-1", Index: 1},
+			{ID: "tx-2", Index: 2},
+		}},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions/stream", nil)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+
+	assert.NoError(t, server.GetTransactionsStream(ctx))
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(body, "id: 1\ndata: {"), body)
+	assert.Contains(t, body, `"id":"tx-1"`)
+	assert.Contains(t, body, "\n\nid: 2\ndata: {")
+	assert.Contains(t, body, `"id":"tx-2"`)
+}
+
+func Test_GetTransactionsStream_maxStreams(t *testing.T) {
+	server := &TopLevelServer{
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{{ID: "tx-1", Index: 1}}},
+		MaxStreams:   1,
+	}
+	stream := func() (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/transactions/stream", nil), rec)
+		return rec, server.GetTransactionsStream(ctx)
+	}
+
+	// Another client has the only stream
+	assert.True(t, server.acquireStream())
+	rec, err := stream()
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	assert.Equal(t, "5", rec.Header().Get(headerRetryAfter))
+
+	// Once it disconnects there is room
+	server.releaseStream()
+	rec, err = stream()
+	assert.NoError(t, err)
+	assert.Contains(t, rec.Body.String(), `"id":"tx-1"`)
+	assert.True(t, server.acquireStream(), "the slot is released when the stream ends")
+}
+
+// unimplementedTransactionClient - as an onos-config that predates the transactions API
+type unimplementedTransactionClient struct {
+	fakeTransactionClient
+}
+
+func (f *unimplementedTransactionClient) ListTransactions(ctx context.Context, in *admin.ListTransactionsRequest, opts ...grpc.CallOption) (admin.TransactionService_ListTransactionsClient, error) {
+	return &unimplementedListStream{}, nil
+}
+
+type unimplementedListStream struct {
+	grpc.ClientStream
+}
+
+func (s *unimplementedListStream) Recv() (*admin.ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown service onos.config.admin.TransactionService")
+}
+
+func Test_grpcGetTransactions_unimplemented(t *testing.T) {
+	server := &TopLevelServer{ConfigClient: &unimplementedTransactionClient{}}
+
+	_, err := server.grpcGetTransactions(context.Background(), nil)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotImplemented, httpErr.Code)
+}
+
+func Test_GetTransaction(t *testing.T) {
+	server := &TopLevelServer{
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			{ID: "tx-1", Index: 1},
+		}},
+	}
+	get := func(id string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/transactions/"+id, nil), rec)
+		return rec, server.GetTransaction(ctx, id)
+	}
+
+	rec, err := get("tx-1")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"id":"tx-1"`)
+
+	_, err = get("tx-2")
+	assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+
+	_, err = get("")
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+// failAfterListStream - sends its transactions, then fails as a stream cut off would
+type failAfterListStream struct {
+	fakeListStream
+}
+
+func (s *failAfterListStream) Recv() (*admin.ListTransactionsResponse, error) {
+	if len(s.transactions) == 0 {
+		return nil, status.Error(codes.Unavailable, "stream reset")
+	}
+	return s.fakeListStream.Recv()
+}
+
+type failAfterTransactionClient struct {
+	fakeTransactionClient
+}
+
+func (f *failAfterTransactionClient) ListTransactions(ctx context.Context, in *admin.ListTransactionsRequest, opts ...grpc.CallOption) (admin.TransactionService_ListTransactionsClient, error) {
+	return &failAfterListStream{fakeListStream{transactions: f.transactions}}, nil
+}
+
+func Test_grpcGetTransactions_limit(t *testing.T) {
+	server := &TopLevelServer{ConfigClient: &failAfterTransactionClient{fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"), changeTransaction("tx-2", 2, "/a")},
+	}}}
+	filter, err := newTransactionFilter("", "", "")
+	assert.NoError(t, err)
+	assert.NoError(t, filter.setBounds("2", ""))
+
+	// The stream is not read past the limit, so its failure is never seen
+	transactions, err := server.grpcGetTransactions(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Len(t, *transactions, 2)
+	raw, err := server.grpcGetTransactionsRaw(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Len(t, raw, 2)
+
+	_, err = server.grpcGetTransactions(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func Test_GetTransactions_count(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"), changeTransaction("tx-2", 2, "/a")},
+	}}
+	getCount := func(query string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		return rec, server.GetTransactions(echo.New().NewContext(req, rec))
+	}
+
+	rec, err := getCount("count=true")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count":2}`, rec.Body.String())
+
+	// Limit and cursor page the listing, so do not change the count
+	rec, err = getCount("count=true&limit=1&cursor=" + transactionsCursor(1))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count":2}`, rec.Body.String())
+
+	rec, err = getCount("count=true&target=no-such-target")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count":0}`, rec.Body.String())
+
+	_, err = getCount("count=maybe")
+	assert.Error(t, err)
+}
+
+func Test_GetTransactions_raw(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a")},
+	}}
+	getRaw := func(query string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		return rec, server.GetTransactions(echo.New().NewContext(req, rec))
+	}
+
+	// Any form of true gives the raw transactions, as onos-config has them
+	for _, query := range []string{"raw=true", "raw=1", "raw=True"} {
+		rec, err := getRaw(query)
+		assert.NoError(t, err, query)
+		assert.Contains(t, rec.Body.String(), `"Details"`, query)
+	}
+	rec, err := getRaw("raw=false")
+	assert.NoError(t, err)
+	assert.NotContains(t, rec.Body.String(), `"Details"`)
+
+	_, err = getRaw("raw=yes")
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func Test_GetTransactions_rawAdmin(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := newTestJWKS(t, key)
+	defer jwks.Close()
+	server := &TopLevelServer{Authorization: true, JWKSURL: jwks.URL, GnmiTimeout: time.Minute,
+		ConfigClient: &fakeTransactionClient{
+			transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a")},
+		}}
+	getRaw := func(roles ...string) error {
+		req := httptest.NewRequest(http.MethodGet, "/transactions?raw=true", nil)
+		req.Header.Set(authorization, "Bearer "+signToken(t, key, jwt.MapClaims{
+			"sub": "alice", "roles": roles, "exp": time.Now().Add(time.Hour).Unix()}))
+		return server.GetTransactions(echo.New().NewContext(req, httptest.NewRecorder()))
+	}
+
+	// The admin role of the default role map, as well as the admin group
+	assert.NoError(t, getRaw(roleAdmin))
+	assert.NoError(t, getRaw(adminGroup))
+	httpErr, ok := getRaw(roleRead).(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, httpErr.Code)
+}
+
+func Test_GetTransactions_failed(t *testing.T) {
+	failed := changeTransaction("tx-2", 2, "/a")
+	failed.Status.State = configapi.TransactionStatus_FAILED
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"), failed},
+	}}
+	getTransactions := func(query string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		return rec, server.GetTransactions(echo.New().NewContext(req, rec))
+	}
+
+	rec, err := getTransactions("failed=true&fields=id")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"tx-2"}]`, rec.Body.String())
+
+	// None failed on the target
+	rec, err = getTransactions("failed=true&target=no-such-target")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+
+	_, err = getTransactions("failed=maybe")
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func Test_GetTransactions_cursor(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"),
+			changeTransaction("tx-2", 2, "/a"), changeTransaction("tx-3", 3, "/a")},
+	}}
+	getPage := func(query string) (*httptest.ResponseRecorder, externalRef0.TransactionList, error) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		err := server.GetTransactions(echo.New().NewContext(req, rec))
+		var page externalRef0.TransactionList
+		if err == nil {
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		}
+		return rec, page, err
+	}
+
+	rec, page, err := getPage("limit=2")
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+	cursor := rec.Header().Get(headerNextCursor)
+	assert.NotEmpty(t, cursor)
+
+	// The last page is not full, so has no cursor
+	rec, page, err = getPage("limit=2&cursor=" + cursor)
+	assert.NoError(t, err)
+	assert.Len(t, page, 1)
+	assert.Equal(t, "tx-3", page[0].Id)
+	assert.Empty(t, rec.Header().Get(headerNextCursor))
+
+	_, _, err = getPage("limit=2&cursor=" + cursor[1:])
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func Test_GetTransactions_readRateTimeout(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Second, TransactionsReadRate: 0.001,
+		ConfigClient: &fakeTransactionClient{transactions: []*configapi.Transaction{
+			changeTransaction("tx-1", 1, "/a"), changeTransaction("tx-2", 2, "/a")},
+		}}
+	req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+	err := server.GetTransactions(echo.New().NewContext(req, httptest.NewRecorder()))
+
+	// Reading the second would pass the deadline, so it fails at once as a timeout
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusGatewayTimeout, httpErr.Code)
+}
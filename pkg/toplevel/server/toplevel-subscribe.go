@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultPollInterval - how often a POLL subscription is polled when no interval is given
+	defaultPollInterval = 10 * time.Second
+	// minPollInterval - the shortest interval a POLL subscription may ask for
+	minPollInterval = time.Second
+)
+
+// subscribeModes - the gNMI subscription modes SubscribePath supports
+var subscribeModes = map[string]gnmi.SubscriptionList_Mode{
+	"ONCE":   gnmi.SubscriptionList_ONCE,
+	"POLL":   gnmi.SubscriptionList_POLL,
+	"STREAM": gnmi.SubscriptionList_STREAM,
+}
+
+// subscribeUpdate - a path of a notification and its value decoded to JSON
+type subscribeUpdate struct {
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// subscribeEvent - the data of a Server-Sent Event of SubscribePath, from one gNMI notification. Its
+// paths are under the pathPrefix of the server, as the path subscribed to was
+type subscribeEvent struct {
+	Timestamp int64             `json:"timestamp"`
+	Target    string            `json:"target,omitempty"`
+	Updates   []subscribeUpdate `json:"updates,omitempty"`
+	Deletes   []string          `json:"deletes,omitempty"`
+}
+
+// SubscribePath - a gNMI subscription to a path of a target, each notification pushed to the client as
+// a Server-Sent Event. An event named sync follows the initial values. A ONCE subscription ends there,
+// a POLL subscription is polled every interval, and a STREAM subscription is held open until the client
+// goes away, which closes the subscription
+func (i *TopLevelServer) SubscribePath(ctx echo.Context) error {
+	target, path := i.queryTarget(ctx), ctx.QueryParam("path")
+	if target == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "target cannot be empty")
+	}
+	if path == "" {
+		path = "/"
+	}
+	modeParam := strings.ToUpper(ctx.QueryParam("mode"))
+	if modeParam == "" {
+		modeParam = "STREAM"
+	}
+	mode, ok := subscribeModes[modeParam]
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("unsupported mode %s. Only ONCE, POLL and STREAM are supported", ctx.QueryParam("mode")))
+	}
+	interval := defaultPollInterval
+	if intervalParam := ctx.QueryParam("interval"); intervalParam != "" {
+		var err error
+		if interval, err = time.ParseDuration(intervalParam); err != nil || interval < minPollInterval {
+			return echo.NewHTTPError(http.StatusBadRequest,
+				fmt.Sprintf("invalid interval %s. Must be a duration of at least %v", intervalParam, minPollInterval))
+		}
+	}
+	gnmiPath, err := ygot.StringToStructuredPath(path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path %s %v", path, err))
+	}
+	prefixed, err := i.withPathPrefix(gnmiPath)
+	if err != nil {
+		return err
+	}
+
+	if !i.acquireStream() {
+		ctx.Response().Header().Set(headerRetryAfter, strconv.Itoa(int(streamRetryAfter.Seconds())))
+		return echo.NewHTTPError(http.StatusServiceUnavailable,
+			fmt.Sprintf("too many streams open. The limit is %d", i.MaxStreams))
+	}
+	defer i.releaseStream()
+
+	streamCtx, cancel := utils.NewGnmiStreamContext(ctx)
+	defer cancel()
+
+	request := &gnmi.SubscribeRequest{
+		Request: &gnmi.SubscribeRequest_Subscribe{
+			Subscribe: &gnmi.SubscriptionList{
+				Prefix:       &gnmi.Path{Target: target},
+				Subscription: []*gnmi.Subscription{{Path: prefixed[0]}},
+				Mode:         mode,
+				Encoding:     gnmi.Encoding_PROTO,
+			},
+		},
+	}
+	log.Debugf("gnmiSubscribeRequest %s", request.String())
+	stream, err := i.GnmiClient.Subscribe(streamCtx, request)
+	i.Metrics.ObserveGnmiCall("Subscribe", err)
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	log.Debugf("SubscribePath %s %s %s opened by %s", target, path, modeParam, requester(ctx))
+
+	responses := make(chan *gnmi.SubscribeResponse)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(responses)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case responses <- response:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	var poll <-chan time.Time
+	for {
+		select {
+		case <-streamCtx.Done():
+			log.Debugf("SubscribePath closed by client")
+			return nil
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(resp, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			resp.Flush()
+		case <-poll:
+			if err = stream.Send(&gnmi.SubscribeRequest{Request: &gnmi.SubscribeRequest_Poll{Poll: &gnmi.Poll{}}}); err != nil {
+				log.Warnf("SubscribePath unable to poll %v", err)
+				return nil
+			}
+		case response, ok := <-responses:
+			if !ok {
+				if err = <-recvErr; err != io.EOF && streamCtx.Err() == nil {
+					log.Warnf("SubscribePath ended %v", err)
+					data, _ := json.Marshal(utils.ConvertGrpcError(err).Message)
+					_, _ = fmt.Fprintf(resp, "event: error\ndata: %s\n\n", data)
+					resp.Flush()
+				}
+				return nil
+			}
+			if response.GetSyncResponse() {
+				if _, err = fmt.Fprint(resp, "event: sync\ndata: {}\n\n"); err != nil {
+					return nil
+				}
+				resp.Flush()
+				if mode == gnmi.SubscriptionList_ONCE {
+					return nil
+				}
+				if mode == gnmi.SubscriptionList_POLL && poll == nil {
+					ticker := time.NewTicker(interval)
+					defer ticker.Stop()
+					poll = ticker.C
+				}
+				continue
+			}
+			notification := response.GetUpdate()
+			if notification == nil {
+				continue
+			}
+			data, err := json.Marshal(i.subscribeEvent(notification))
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(resp, "id: %d\ndata: %s\n\n", notification.GetTimestamp(), data); err != nil {
+				return nil
+			}
+			resp.Flush()
+		}
+	}
+}
+
+// subscribeEvent - the notification with its values decoded and redacted, and its paths without the
+// pathPrefix of the server
+func (i *TopLevelServer) subscribeEvent(notification *gnmi.Notification) subscribeEvent {
+	event := subscribeEvent{
+		Timestamp: notification.GetTimestamp(),
+		Target:    notification.GetPrefix().GetTarget(),
+	}
+	prefixElems := notification.GetPrefix().GetElem()
+	fullPath := func(path *gnmi.Path) []*gnmi.PathElem {
+		return append(append(make([]*gnmi.PathElem, 0, len(prefixElems)+len(path.GetElem())),
+			prefixElems...), path.GetElem()...)
+	}
+	pathString := func(elems []*gnmi.PathElem) string {
+		pathStr, err := ygot.PathToString(&gnmi.Path{Elem: elems})
+		if err != nil {
+			return "/"
+		}
+		return pathStr
+	}
+	for _, update := range notification.GetUpdate() {
+		elems := fullPath(update.GetPath())
+		value, err := decodeTypedValue(update.GetVal())
+		if err != nil {
+			log.Warnf("Unable to decode the value at %s %v", pathString(elems), err)
+			value = nil
+		}
+		event.Updates = append(event.Updates, subscribeUpdate{
+			Path:  pathString(i.withoutPathPrefix(elems)),
+			Value: i.redactor().treeAt(value, pathString(elems)),
+		})
+	}
+	for _, deleted := range notification.GetDelete() {
+		event.Deletes = append(event.Deletes, pathString(i.withoutPathPrefix(fullPath(deleted))))
+	}
+	return event
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSubscribeClient - a subscription that gives the responses, then EOF or, with block, waits for
+// its context to be cancelled
+type fakeSubscribeClient struct {
+	grpc.ClientStream
+	ctx       context.Context
+	responses []*gnmi.SubscribeResponse
+	block     bool
+}
+
+func (f *fakeSubscribeClient) Send(*gnmi.SubscribeRequest) error {
+	return nil
+}
+
+func (f *fakeSubscribeClient) Recv() (*gnmi.SubscribeResponse, error) {
+	if len(f.responses) > 0 {
+		response := f.responses[0]
+		f.responses = f.responses[1:]
+		return response, nil
+	}
+	if f.block {
+		<-f.ctx.Done()
+		return nil, f.ctx.Err()
+	}
+	return nil, io.EOF
+}
+
+func subscribeUpdateResponse(timestamp int64, path string, value string) *gnmi.SubscribeResponse {
+	elems := make([]*gnmi.PathElem, 0)
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		elems = append(elems, &gnmi.PathElem{Name: name})
+	}
+	return &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+		Timestamp: timestamp,
+		Prefix:    &gnmi.Path{Target: "connectivity-service-v4"},
+		Update: []*gnmi.Update{{
+			Path: &gnmi.Path{Elem: elems},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: value}},
+		}},
+	}}}
+}
+
+var syncResponse = &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}}
+
+func Test_SubscribePath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error) {
+			subscribe := request.GetSubscribe()
+			assert.Equal(t, gnmi.SubscriptionList_ONCE, subscribe.GetMode())
+			assert.Equal(t, "connectivity-service-v4", subscribe.GetPrefix().GetTarget())
+			elems := subscribe.GetSubscription()[0].GetPath().GetElem()
+			assert.Equal(t, 2, len(elems))
+			assert.Equal(t, "config", elems[0].Name)
+			assert.Equal(t, "s1", elems[1].Key["id"])
+			return &fakeSubscribeClient{ctx: ctx, block: true, responses: []*gnmi.SubscribeResponse{
+				subscribeUpdateResponse(5, "/config/site/secret", "x"),
+				subscribeUpdateResponse(6, "/config/site/display-name", "Site 1"),
+				syncResponse,
+			}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, PathPrefix: "/config",
+		RedactPaths: []string{"/config/site/secret"}}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/aether-roc-api/subscribe?target=connectivity-service-v4&path=/site[id=s1]&mode=once", nil)
+	rec := httptest.NewRecorder()
+	assert.NilError(t, server.SubscribePath(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `id: 5
+data: {"timestamp":5,"target":"connectivity-service-v4","updates":[{"path":"/site/secret","value":"****"}]}
+
+id: 6
+data: {"timestamp":6,"target":"connectivity-service-v4","updates":[{"path":"/site/display-name","value":"Site 1"}]}
+
+event: sync
+data: {}
+
+`, rec.Body.String())
+}
+
+func Test_SubscribePath_disconnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var streamCtx context.Context
+	mockClient.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SubscribeRequest) (gnmi.GNMI_SubscribeClient, error) {
+			assert.Equal(t, gnmi.SubscriptionList_STREAM, request.GetSubscribe().GetMode())
+			streamCtx = ctx
+			return &fakeSubscribeClient{ctx: ctx, block: true, responses: []*gnmi.SubscribeResponse{syncResponse}}, nil
+		},
+	)
+	server := &TopLevelServer{GnmiClient: mockClient, DefaultTarget: "connectivity-service-v4", MaxStreams: 1}
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/aether-roc-api/subscribe", nil).WithContext(reqCtx)
+	rec := httptest.NewRecorder()
+	done := make(chan error)
+	go func() {
+		done <- server.SubscribePath(echo.New().NewContext(req, rec))
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		assert.NilError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("SubscribePath did not end when the client went away")
+	}
+	// The subscription is closed, and its stream given back
+	assert.Assert(t, streamCtx.Err() != nil)
+	assert.Assert(t, server.acquireStream())
+}
+
+func Test_SubscribePath_invalid(t *testing.T) {
+	server := &TopLevelServer{}
+	for _, query := range []string{
+		"path=/site",
+		"target=t1&mode=sample",
+		"target=t1&mode=poll&interval=10ms",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/aether-roc-api/subscribe?"+query, nil)
+		err := server.SubscribePath(echo.New().NewContext(req, httptest.NewRecorder()))
+		httpErr, ok := err.(*echo.HTTPError)
+		assert.Assert(t, ok, query)
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code, query)
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"time"
+)
+
+// targetsCache - the last list of targets from onos-config, kept for the TargetsCacheTTL
+type targetsCache struct {
+	fetchCache
+}
+
+// get - the cached targets, unless they have expired or refresh is set, when they are fetched again
+func (c *targetsCache) get(ctx context.Context, ttl time.Duration, timeout time.Duration, refresh bool,
+	fetch func(context.Context) (*externalRef0.TargetsNames, error)) (*externalRef0.TargetsNames, error) {
+	targets, err := c.fetchCache.get(ctx, ttl, timeout, refresh, func(ctx context.Context) (interface{}, error) {
+		return fetch(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targets.(*externalRef0.TargetsNames), nil
+}
+
+// getTargets - the targets, from the cache when TargetsCacheTTL is set
+func (i *TopLevelServer) getTargets(ctx context.Context, refresh bool) (*externalRef0.TargetsNames, error) {
+	if i.TargetsCacheTTL <= 0 {
+		return i.gnmiGetTargets(ctx)
+	}
+	return i.targetsCache.get(ctx, i.TargetsCacheTTL, i.GnmiTimeout, refresh, i.gnmiGetTargets)
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_targetsCache_ttl(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache := &targetsCache{}
+	cache.now = func() time.Time { return now }
+	var fetches int32
+	fetch := func(ctx context.Context) (*externalRef0.TargetsNames, error) {
+		atomic.AddInt32(&fetches, 1)
+		return &externalRef0.TargetsNames{}, nil
+	}
+
+	for n := 0; n < 3; n++ {
+		_, err := cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), fetches)
+
+	_, err := cache.get(context.Background(), time.Minute, time.Minute, true, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), fetches)
+
+	now = now.Add(2 * time.Minute)
+	_, err = cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), fetches)
+}
+
+func Test_targetsCache_errorNotCached(t *testing.T) {
+	cache := &targetsCache{}
+	_, err := cache.get(context.Background(), time.Minute, time.Minute, false, func(ctx context.Context) (*externalRef0.TargetsNames, error) {
+		return nil, assert.AnError
+	})
+	assert.Equal(t, assert.AnError, err)
+
+	targets, err := cache.get(context.Background(), time.Minute, time.Minute, false, func(ctx context.Context) (*externalRef0.TargetsNames, error) {
+		return &externalRef0.TargetsNames{}, nil
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, targets)
+}
+
+func Test_targetsCache_concurrent(t *testing.T) {
+	cache := &targetsCache{}
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*externalRef0.TargetsNames, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return &externalRef0.TargetsNames{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for n := 0; n < 20; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			targets, err := cache.get(context.Background(), time.Minute, time.Minute, true, fetch)
+			assert.NoError(t, err)
+			assert.NotNil(t, targets)
+		}()
+	}
+	// Give the requests time to pile up behind the first fetch
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), fetches)
+}
+
+func Test_targetsCache_firstCallerCancelled(t *testing.T) {
+	cache := &targetsCache{}
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*externalRef0.TargetsNames, error) {
+		<-release
+		return &externalRef0.TargetsNames{}, ctx.Err()
+	}
+
+	first, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := cache.get(first, time.Minute, time.Minute, false, fetch)
+		firstErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	waiter := make(chan error)
+	go func() {
+		_, err := cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+		waiter <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancelFirst()
+	assert.Equal(t, context.Canceled, <-firstErr)
+	close(release)
+	assert.NoError(t, <-waiter)
+}
+
+func Test_GetTargets_badRefresh(t *testing.T) {
+	server := &TopLevelServer{GnmiTimeout: time.Minute, TargetsCacheTTL: time.Minute}
+	req := httptest.NewRequest(http.MethodGet, "/targets?refresh=soon", nil)
+	err := server.GetTargets(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	if assert.True(t, ok) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	}
+}
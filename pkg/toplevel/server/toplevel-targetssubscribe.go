@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sort"
+)
+
+// GetTargetsSubscribe - the targets, as from GetTargets, read with one gNMI Subscribe in ONCE mode.
+// Where onos-config does not support Subscribe, they are read with a Get instead
+func (i *TopLevelServer) GetTargetsSubscribe(ctx echo.Context) error {
+	gnmiCtx, cancel := utils.NewGnmiContext(ctx, i.gnmiTimeout(ctx))
+	defer cancel()
+
+	targets, err := i.gnmiSubscribeTargets(gnmiCtx)
+	if status.Code(err) == codes.Unimplemented {
+		log.Infof("gNMI Subscribe is not supported. Getting the targets instead. %v", err)
+		targets, err = i.gnmiGetTargets(gnmiCtx)
+	}
+	if err != nil {
+		return utils.ConvertGrpcError(err)
+	}
+	log.Debugf("GetTargetsSubscribe by %s", requester(ctx))
+	return ctx.JSON(http.StatusOK, targets)
+}
+
+// gnmiSubscribeTargets - the targets from a Subscribe ONCE to all of them, as the gnmiGetTargets Get.
+// The notifications are taken in the order of their timestamps, so the latest list of targets wins.
+// A notification for a single target adds it, or removes it when the target is deleted
+func (i *TopLevelServer) gnmiSubscribeTargets(ctx context.Context) (*externalRef0.TargetsNames, error) {
+	request := &gnmi.SubscribeRequest{
+		Request: &gnmi.SubscribeRequest_Subscribe{
+			Subscribe: &gnmi.SubscriptionList{
+				Prefix:       &gnmi.Path{Target: "*"},
+				Subscription: []*gnmi.Subscription{{Path: &gnmi.Path{}}},
+				Mode:         gnmi.SubscriptionList_ONCE,
+				Encoding:     gnmi.Encoding_PROTO,
+			},
+		},
+	}
+	log.Debugf("gnmiSubscribeRequest %s", request.String())
+	notifications, err := i.gnmiSubscribeOnce(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(notifications, func(a, b int) bool {
+		return notifications[a].GetTimestamp() < notifications[b].GetTimestamp()
+	})
+
+	names := make([]string, 0)
+	for _, notification := range notifications {
+		target := notification.GetPrefix().GetTarget()
+		for _, deleted := range notification.GetDelete() {
+			deletedTarget := deleted.GetTarget()
+			if deletedTarget == "" {
+				deletedTarget = target
+			}
+			if deletedTarget != "" && deletedTarget != "*" {
+				names = removeName(names, deletedTarget)
+			}
+		}
+		for _, update := range notification.GetUpdate() {
+			if leafList, ok := update.GetVal().GetValue().(*gnmi.TypedValue_LeaflistVal); ok {
+				names = make([]string, 0, len(leafList.LeaflistVal.GetElement()))
+				for _, elem := range leafList.LeaflistVal.GetElement() {
+					names = append(names, elem.GetStringVal())
+				}
+			} else if target != "" && target != "*" && !contains(names, target) {
+				names = append(names, target)
+			}
+		}
+	}
+	return i.targetsNames(names), nil
+}
+
+func removeName(names []string, name string) []string {
+	kept := names[:0]
+	for _, n := range names {
+		if n != name {
+			kept = append(kept, n)
+		}
+	}
+	return kept
+}
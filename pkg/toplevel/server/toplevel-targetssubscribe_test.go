@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func targetsLeafList(names ...string) *gnmi.TypedValue {
+	elements := make([]*gnmi.TypedValue, 0, len(names))
+	for _, name := range names {
+		elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: name}})
+	}
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{Element: elements}}}
+}
+
+func Test_GetTargetsSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{
+		GnmiClient:    mockClient,
+		GnmiTimeout:   time.Minute,
+		TargetAliases: map[string]string{"connectivity-service-v4": "Aether 4"},
+	}))
+	get := func() (*httptest.ResponseRecorder, []string) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/targets/subscribe", nil))
+		var targets externalRef0.TargetsNames
+		names := make([]string, 0)
+		if rec.Code == http.StatusOK {
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &targets))
+			for _, target := range targets {
+				names = append(names, *target.Name)
+			}
+		}
+		return rec, names
+	}
+
+	// Out of order - the later list replaces the earlier, then a target is added and one removed
+	mockClient.EXPECT().SubscribeOnce(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SubscribeRequest) ([]*gnmi.Notification, error) {
+			assert.Equal(t, gnmi.SubscriptionList_ONCE, request.GetSubscribe().GetMode())
+			assert.Equal(t, "*", request.GetSubscribe().GetPrefix().GetTarget())
+			return []*gnmi.Notification{
+				{Timestamp: 3, Prefix: &gnmi.Path{Target: "connectivity-service-v2"},
+					Delete: []*gnmi.Path{{}}},
+				{Timestamp: 2, Prefix: &gnmi.Path{Target: "*"},
+					Update: []*gnmi.Update{{Path: &gnmi.Path{}, Val: targetsLeafList("connectivity-service-v2", "connectivity-service-v4")}}},
+				{Timestamp: 1, Prefix: &gnmi.Path{Target: "*"},
+					Update: []*gnmi.Update{{Path: &gnmi.Path{}, Val: targetsLeafList("old-target")}}},
+				{Timestamp: 4, Prefix: &gnmi.Path{Target: "plproxy-amp"},
+					Update: []*gnmi.Update{{Path: &gnmi.Path{}, Val: &gnmi.TypedValue{}}}},
+			}, nil
+		})
+	rec, names := get()
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{"connectivity-service-v4", "plproxy-amp"}, names)
+	assert.Contains(t, rec.Body.String(), `"displayName":"Aether 4"`)
+
+	// Falls back to the Get
+	mockClient.EXPECT().SubscribeOnce(gomock.Any(), gomock.Any()).Return(nil,
+		status.Error(codes.Unimplemented, "Subscribe is not implemented"))
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&gnmi.GetResponse{
+		Notification: []*gnmi.Notification{{Update: []*gnmi.Update{{
+			Path: &gnmi.Path{}, Val: targetsLeafList("connectivity-service-v2"),
+		}}}},
+	}, nil)
+	rec, names = get()
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{"connectivity-service-v2"}, names)
+
+	mockClient.EXPECT().SubscribeOnce(gomock.Any(), gomock.Any()).Return(nil,
+		status.Error(codes.PermissionDenied, "no"))
+	rec, _ = get()
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
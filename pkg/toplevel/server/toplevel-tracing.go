@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const tracerName = "github.com/onosproject/aether-roc-api/pkg/toplevel/server"
+
+// traceContext - reads and writes the W3C traceparent header
+var traceContext = propagation.TraceContext{}
+
+// tracer - from TracerProvider, or the global provider (a no-op unless one is set) when it is nil
+func (i *TopLevelServer) tracer() trace.Tracer {
+	if i.TracerProvider != nil {
+		return i.TracerProvider.Tracer(tracerName)
+	}
+	return otel.GetTracerProvider().Tracer(tracerName)
+}
+
+// TracingMiddleware - starts a span for each request, as a child of the caller's span if it sent a traceparent header
+func (i *TopLevelServer) TracingMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			parent := traceContext.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			spanCtx, span := i.tracer().Start(parent, req.Method+" "+ctx.Path(), trace.WithSpanKind(trace.SpanKindServer))
+			defer span.End()
+			ctx.SetRequest(req.WithContext(spanCtx))
+
+			err := next(ctx)
+			code := ctx.Response().Status
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				code = httpErr.Code
+			}
+			span.SetAttributes(attribute.Int("http.status_code", code))
+			setSpanError(span, err)
+			return err
+		}
+	}
+}
+
+// startClientSpan - starts a child span for a call to onos-config, and passes it on in the
+// traceparent of the outgoing gRPC metadata so the traces of both services are joined up
+func (i *TopLevelServer) startClientSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	spanCtx, span := i.tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	carrier := propagation.MapCarrier{}
+	traceContext.Inject(spanCtx, carrier)
+	for _, key := range carrier.Keys() {
+		spanCtx = metadata.AppendToOutgoingContext(spanCtx, key, carrier.Get(key))
+	}
+	return spanCtx, span
+}
+
+// setSpanError - marks the span as failed with err. Does nothing if err is nil
+func setSpanError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+func Test_TracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	server := &TopLevelServer{
+		ConfigClient:   &fakeTransactionClient{transactions: []*configapi.Transaction{{ID: "tx-1", Index: 1}}},
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	}
+	e := echo.New()
+	e.Use(server.TracingMiddleware())
+	e.GET("/transactions", func(ctx echo.Context) error {
+		_, err := server.grpcGetTransactions(ctx.Request().Context(), nil)
+		return err
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+	req.Header.Set("traceparent", "00-"+parentTraceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	client, request := spans[0], spans[1]
+	assert.Equal(t, "admin.ListTransactions", client.Name())
+	assert.Equal(t, "GET /transactions", request.Name())
+	assert.Equal(t, parentTraceID, request.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", request.Parent().SpanID().String())
+	assert.Equal(t, request.SpanContext().SpanID(), client.Parent().SpanID())
+}
+
+func Test_startClientSpan(t *testing.T) {
+	server := &TopLevelServer{
+		TracerProvider: sdktrace.NewTracerProvider(),
+	}
+	ctx, span := server.startClientSpan(context.Background(), "gnmi.Get")
+	defer span.End()
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	traceparent := md.Get("traceparent")
+	assert.Len(t, traceparent, 1)
+	assert.Contains(t, traceparent[0], trace.SpanFromContext(ctx).SpanContext().SpanID().String())
+}
+
+func Test_startClientSpan_noop(t *testing.T) {
+	// Without a TracerProvider the spans are no-ops and nothing is propagated
+	server := &TopLevelServer{}
+	ctx, span := server.startClientSpan(context.Background(), "gnmi.Get")
+	defer span.End()
+
+	assert.False(t, span.SpanContext().IsValid())
+	md, _ := metadata.FromOutgoingContext(ctx)
+	assert.Empty(t, md.Get("traceparent"))
+}
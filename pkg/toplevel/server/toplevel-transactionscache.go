@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"time"
+)
+
+// transactionsCacheKey - the key of whether a request's transactions may come from the transactionsCache
+type transactionsCacheKey struct{}
+
+// transactionsCache - the last whole list of transactions from onos-config, kept for the
+// TransactionsCacheTTL, so that a busy dashboard does not open a ListTransactions stream for each request
+type transactionsCache struct {
+	fetchCache
+}
+
+// get - the cached transactions, unless they have expired or refresh is set, when they are fetched
+// again. The list is shared, so must not be changed
+func (c *transactionsCache) get(ctx context.Context, ttl time.Duration, timeout time.Duration, refresh bool,
+	fetch func(context.Context) ([]*configapi.Transaction, error)) ([]*configapi.Transaction, error) {
+	transactions, err := c.fetchCache.get(ctx, ttl, timeout, refresh, func(ctx context.Context) (interface{}, error) {
+		return fetch(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transactions.([]*configapi.Transaction), nil
+}
+
+// withTransactionsCache - a context whose transactions may be read from the transactionsCache, or
+// with refresh read from onos-config and cached. Others always read from onos-config, as they need
+// the current transactions e.g. for a rollback
+func withTransactionsCache(ctx context.Context, refresh bool) context.Context {
+	return context.WithValue(ctx, transactionsCacheKey{}, refresh)
+}
+
+// cachedTransactions - the whole list of transactions from the cache, when TransactionsCacheTTL is set
+// and the context allows it. False when they are to be read from onos-config
+func (i *TopLevelServer) cachedTransactions(ctx context.Context) ([]*configapi.Transaction, bool, error) {
+	refresh, ok := ctx.Value(transactionsCacheKey{}).(bool)
+	if !ok || i.TransactionsCacheTTL <= 0 {
+		return nil, false, nil
+	}
+	transactions, err := i.transactionsCache.get(ctx, i.TransactionsCacheTTL, i.GnmiTimeout, refresh,
+		func(ctx context.Context) ([]*configapi.Transaction, error) {
+			transactions, err := i.listTransactions(ctx, nil)
+			if err == nil {
+				i.revisions.observe(transactions)
+			}
+			return transactions, err
+		})
+	return transactions, true, err
+}
+
+// filterTransactions - the transactions that match the filter, up to its limit
+func filterTransactions(transactions []*configapi.Transaction, filter *transactionFilter) []*configapi.Transaction {
+	matched := make([]*configapi.Transaction, 0)
+	for _, t := range transactions {
+		if t == nil || !filter.matches(t) {
+			continue
+		}
+		matched = append(matched, t)
+		if filter.full(len(matched)) {
+			break
+		}
+	}
+	return matched
+}
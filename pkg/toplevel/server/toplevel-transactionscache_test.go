@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/metrics"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingTransactionClient - counts the ListTransactions streams opened
+type countingTransactionClient struct {
+	fakeTransactionClient
+	lists int32
+}
+
+func (c *countingTransactionClient) ListTransactions(ctx context.Context, in *admin.ListTransactionsRequest, opts ...grpc.CallOption) (admin.TransactionService_ListTransactionsClient, error) {
+	atomic.AddInt32(&c.lists, 1)
+	return c.fakeTransactionClient.ListTransactions(ctx, in, opts...)
+}
+
+func Test_GetTransactions_cache(t *testing.T) {
+	client := &countingTransactionClient{fakeTransactionClient: fakeTransactionClient{
+		transactions: []*configapi.Transaction{changeTransaction("tx-1", 1, "/a"), changeTransaction("tx-2", 2, "/b")},
+	}}
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := &TopLevelServer{GnmiTimeout: time.Minute, ConfigClient: client, TransactionsCacheTTL: 2 * time.Second,
+		Metrics: metrics.NewPrometheusMetrics(prometheus.NewRegistry())}
+	server.transactionsCache.now = func() time.Time { return now }
+	getTransactions := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+		assert.NoError(t, server.GetTransactions(echo.New().NewContext(req, rec)))
+		return rec
+	}
+
+	assert.JSONEq(t, `[{"id":"tx-1"},{"id":"tx-2"}]`, getTransactions("fields=id").Body.String())
+	// The filters are applied to the cached list
+	assert.JSONEq(t, `[{"id":"tx-1"}]`, getTransactions("fields=id&limit=1").Body.String())
+	assert.JSONEq(t, `{"count":2}`, getTransactions("count=true").Body.String())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&client.lists))
+
+	// Refresh reads onos-config, as does a request once the TTL has passed
+	client.transactions = append(client.transactions, changeTransaction("tx-3", 3, "/c"))
+	assert.JSONEq(t, `{"count":3}`, getTransactions("count=true&refresh=true").Body.String())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&client.lists))
+	now = now.Add(3 * time.Second)
+	getTransactions("fields=id")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&client.lists))
+
+	// Others, e.g. a rollback, always read onos-config
+	_, err := server.grpcGetTransactionsRaw(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&client.lists))
+	// Every read of onos-config is counted, including those that fill the cache
+	assert.Equal(t, 4.0, testutil.ToFloat64(server.Metrics.GnmiCalls.WithLabelValues("ListTransactions", "OK")))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/transactions?refresh=maybe", nil)
+	err = server.GetTransactions(echo.New().NewContext(req, rec))
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func Test_transactionsCache_sharedFetch(t *testing.T) {
+	cache := &transactionsCache{}
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) ([]*configapi.Transaction, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return []*configapi.Transaction{changeTransaction("tx-1", 1, "/a")}, nil
+	}
+	results := make(chan int, 3)
+	for n := 0; n < 3; n++ {
+		go func() {
+			transactions, err := cache.get(context.Background(), time.Minute, time.Minute, false, fetch)
+			assert.NoError(t, err)
+			results <- len(transactions)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	for n := 0; n < 3; n++ {
+		assert.Equal(t, 1, <-results)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
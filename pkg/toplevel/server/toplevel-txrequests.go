@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"google.golang.org/grpc/metadata"
+	"sync"
+)
+
+// maxTransactionRequests - the most transactions whose request IDs are kept. The oldest are forgotten
+const maxTransactionRequests = 10000
+
+// transactionRequests - the ID of the HTTP request that made each transaction. The request ID is
+// sent to onos-config as gRPC metadata, but it does not keep it, so they are kept here. They are
+// only known for the transactions made through this instance since it started
+type transactionRequests struct {
+	mu         sync.Mutex
+	requestIDs map[configapi.TransactionID]string
+	order      []configapi.TransactionID
+}
+
+// record - the transaction was made by the request, keeping no more than maxTransactionRequests
+func (r *transactionRequests) record(id configapi.TransactionID, requestID string) {
+	if id == "" || requestID == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.requestIDs == nil {
+		r.requestIDs = make(map[configapi.TransactionID]string)
+	}
+	if _, ok := r.requestIDs[id]; !ok {
+		r.order = append(r.order, id)
+	}
+	r.requestIDs[id] = requestID
+	for len(r.order) > maxTransactionRequests {
+		delete(r.requestIDs, r.order[0])
+		r.order = r.order[1:]
+	}
+}
+
+// requestID - the ID of the request that made the transaction. Empty if it is not known
+func (r *transactionRequests) requestID(id configapi.TransactionID) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requestIDs[id]
+}
+
+// recordTransactionRequest - keep the request ID, from the gRPC metadata of a gNMI Set's context, of
+// the transaction the Set made
+func (i *TopLevelServer) recordTransactionRequest(ctx context.Context, id configapi.TransactionID) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return
+	}
+	if requestIDs := md.Get(utils.ContextRequestID); len(requestIDs) > 0 {
+		i.transactionRequests.record(id, requestIDs[0])
+	}
+}
+
+// withRequestID - the transaction with the ID of the request that made it, when that is known
+func (i *TopLevelServer) withRequestID(transaction *externalRef0.Transaction) {
+	if requestID := i.transactionRequests.requestID(configapi.TransactionID(transaction.Id)); requestID != "" {
+		transaction.RequestId = &requestID
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_transactionRequests(t *testing.T) {
+	var requests transactionRequests
+	assert.Equal(t, "", requests.requestID("tx-1"))
+	requests.record("tx-1", "req-1")
+	requests.record("tx-2", "")
+	assert.Equal(t, "req-1", requests.requestID("tx-1"))
+	assert.Equal(t, "", requests.requestID("tx-2"))
+
+	// The oldest are forgotten
+	for n := 2; n <= maxTransactionRequests+1; n++ {
+		requests.record(configapi.TransactionID(fmt.Sprintf("tx-%d", n)), fmt.Sprintf("req-%d", n))
+	}
+	assert.Equal(t, "", requests.requestID("tx-1"))
+	assert.Equal(t, "req-2", requests.requestID("tx-2"))
+	assert.Equal(t, maxTransactionRequests, len(requests.requestIDs))
+}
+
+func Test_PatchAetherRocAPI_requestID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).Return(&gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+		Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+			Id: 100, Msg: []byte("tx-7"),
+		}},
+	}}}, nil)
+	server := &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute, SkipTargetCheck: true}
+
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":"Site 1","enterprise":"e1"}]}}}`))
+	ctx := echo.New().NewContext(req, httptest.NewRecorder())
+	ctx.Set(utils.ContextRequestID, "req-abc")
+	assert.NilError(t, server.PatchAetherRocAPI(ctx))
+
+	transaction := externalRef0.Transaction{Id: "tx-7"}
+	server.withRequestID(&transaction)
+	assert.Assert(t, transaction.RequestId != nil)
+	assert.Equal(t, "req-abc", *transaction.RequestId)
+
+	other := externalRef0.Transaction{Id: "tx-8"}
+	server.withRequestID(&other)
+	assert.Assert(t, other.RequestId == nil)
+}
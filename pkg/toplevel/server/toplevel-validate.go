@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ValidationErrors - the body of a 400 response to a PATCH that does not match the schema
+type ValidationErrors struct {
+	Message string   `json:"message"`
+	Errors  []string `json:"errors"`
+}
+
+// String - keeps the HTTPError text to a summary
+func (v ValidationErrors) String() string {
+	return fmt.Sprintf("%s: %s", v.Message, strings.Join(v.Errors, "; "))
+}
+
+var (
+	patchBodySchemaOnce sync.Once
+	patchBodySchema     *openapi3.Schema
+	patchBodySchemaErr  error
+)
+
+// getPatchBodySchema - the PatchBody schema, with the model schemas it refers to, loaded once
+func getPatchBodySchema() (*openapi3.Schema, error) {
+	patchBodySchemaOnce.Do(func() {
+		spec, err := GetSwagger()
+		if err != nil {
+			patchBodySchemaErr = err
+			return
+		}
+		ref, ok := spec.Components.Schemas["PatchBody"]
+		if !ok || ref.Value == nil {
+			patchBodySchemaErr = fmt.Errorf("no PatchBody in the top level spec")
+			return
+		}
+		patchBodySchema = ref.Value
+	})
+	return patchBodySchema, patchBodySchemaErr
+}
+
+// validatePatchBody - check body against the PatchBody schema, returning every error rather than the
+// first. As in openapi3mw.ValidateRequest, attributes that are required by the models may be left out
+// of the Updates and Deletes, since only what is changing is given
+func validatePatchBody(body []byte) error {
+	schema, err := getPatchBodySchema()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	var value interface{}
+	if err = json.Unmarshal(body, &value); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("body is not valid JSON %v", err))
+	}
+
+	errs := make([]string, 0)
+	for _, err := range flattenSchemaErrors(schema.VisitJSON(value, openapi3.MultiErrors(), openapi3.VisitAsRequest())) {
+		schemaErr, ok := err.(*openapi3.SchemaError)
+		if !ok {
+			errs = append(errs, err.Error())
+			continue
+		}
+		pointer := schemaErr.JSONPointer()
+		if len(pointer) > 0 {
+			switch {
+			case pointer[0] == "Updates" && schemaErr.SchemaField == "required":
+				continue
+			case pointer[0] == "Deletes" && (schemaErr.SchemaField == "required" || schemaErr.SchemaField == "minLength"):
+				continue
+			}
+		}
+		errs = append(errs, fmt.Sprintf("/%s: %s", strings.Join(pointer, "/"), schemaErr.Reason))
+	}
+	if len(errs) > 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, ValidationErrors{
+			Message: "body does not match the PatchBody schema",
+			Errors:  errs,
+		})
+	}
+	return nil
+}
+
+// flattenSchemaErrors - the individual errors of err, which may be nested MultiErrors
+func flattenSchemaErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	multi, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+	errs := make([]error, 0, len(multi))
+	for _, e := range multi {
+		errs = append(errs, flattenSchemaErrors(e)...)
+	}
+	return errs
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_validatePatchBody(t *testing.T) {
+	// Only the attributes that are changing - the required site is not given
+	assert.NoError(t, validatePatchBody([]byte(`{
+  "default-target": "connectivity-service-v4",
+  "Updates": {"device-group-4.0.0": {"device-group": [{"id": "dg1", "display-name": "DG 1"}]}},
+  "Deletes": {"device-group-4.0.0": {"device-group": [{"id": "dg2"}]}}
+}`)))
+
+	err := validatePatchBody([]byte(`{
+  "default-target": "connectivity-service-v4",
+  "Updates": {"device-group-4.0.0": {"device-group": [
+    {"id": "dg1", "display-name": ""},
+    {"id": "dg2", "description": 123}
+  ]}}
+}`))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	validationErrs, ok := httpErr.Message.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Len(t, validationErrs.Errors, 2, validationErrs.Errors)
+	assert.Contains(t, validationErrs.Errors[0], "/Updates/device-group-4.0.0/device-group/0/display-name")
+	assert.Contains(t, validationErrs.Errors[1], "/Updates/device-group-4.0.0/device-group/1/description")
+
+	err = validatePatchBody([]byte(`{"default-target":`))
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func Test_PatchAetherRocAPI_validateRequests(t *testing.T) {
+	// Rejected before onos-config would be called - there is no GnmiClient
+	server := &TopLevelServer{ValidateRequests: true}
+	req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(
+		`{"default-target":"connectivity-service-v4","Updates":{"site-4.0.0":{"site":[{"id":"s1","display-name":""}]}}}`))
+	err := server.PatchAetherRocAPI(echo.New().NewContext(req, httptest.NewRecorder()))
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.True(t, ok, err)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
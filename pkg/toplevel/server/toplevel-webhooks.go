@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	externalRef0 "github.com/onosproject/aether-roc-api/pkg/toplevel/types"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	webhookAttempts       = 5
+	webhookInitialBackoff = time.Second
+	webhookMaxBackoff     = 30 * time.Second
+	webhookTimeout        = 10 * time.Second
+	webhookEventApplied   = "transaction-applied"
+)
+
+// webhookEvent - the body POSTed to each webhook
+type webhookEvent struct {
+	Event       string                   `json:"event"`
+	Transaction externalRef0.Transaction `json:"transaction"`
+}
+
+// webhookNotifier - POSTs each transaction that reaches APPLIED (the apply phase is complete) to the webhook
+// URLs, once. A failed delivery is retried with exponential backoff, up to attempts in all
+type webhookNotifier struct {
+	urls           []string
+	client         *http.Client
+	attempts       int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	notified       map[configapi.TransactionID]bool
+	redact         *redactor
+	deliveries     sync.WaitGroup
+}
+
+func newWebhookNotifier(urls []string) *webhookNotifier {
+	return &webhookNotifier{
+		urls:           urls,
+		client:         &http.Client{Timeout: webhookTimeout},
+		attempts:       webhookAttempts,
+		initialBackoff: webhookInitialBackoff,
+		maxBackoff:     webhookMaxBackoff,
+		notified:       make(map[configapi.TransactionID]bool),
+	}
+}
+
+// RunWebhooks - watch the transactions in onos-config and notify the WebhookURLs of each one that is applied,
+// until ctx is done. The watch is reopened, with backoff, if it ends. Nothing is done when there are no webhooks
+func (i *TopLevelServer) RunWebhooks(ctx context.Context) {
+	if len(i.WebhookURLs) == 0 {
+		return
+	}
+	notifier := newWebhookNotifier(i.WebhookURLs)
+	notifier.redact = i.redactor()
+	i.runWebhooks(ctx, notifier)
+}
+
+func (i *TopLevelServer) runWebhooks(ctx context.Context, notifier *webhookNotifier) {
+	defer notifier.deliveries.Wait()
+	log.Infof("Notifying webhooks %v of applied transactions", notifier.urls)
+	backoff := notifier.initialBackoff
+	for {
+		received, err := i.watchForWebhooks(ctx, notifier)
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			backoff = notifier.initialBackoff
+		}
+		log.Warnf("Watch of transactions for webhooks ended. Reopening in %v. %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > notifier.maxBackoff {
+			backoff = notifier.maxBackoff
+		}
+	}
+}
+
+// watchForWebhooks - pass the transaction events to the notifier until the watch ends. Whether any were received
+func (i *TopLevelServer) watchForWebhooks(ctx context.Context, notifier *webhookNotifier) (bool, error) {
+	stream, err := i.ConfigClient.WatchTransactions(ctx, &admin.WatchTransactionsRequest{Noreplay: true})
+	if err != nil {
+		return false, err
+	}
+	received := false
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		notifier.handle(ctx, &event.TransactionEvent)
+	}
+}
+
+// handle - start the deliveries for a transaction that has just been applied
+func (n *webhookNotifier) handle(ctx context.Context, event *configapi.TransactionEvent) {
+	transaction := &event.Transaction
+	if event.Type == configapi.TransactionEvent_DELETED {
+		delete(n.notified, transaction.ID)
+		return
+	}
+	if transaction.Status.State != configapi.TransactionStatus_APPLIED || n.notified[transaction.ID] {
+		return
+	}
+	n.notified[transaction.ID] = true
+
+	payload := webhookEvent{
+		Event:       webhookEventApplied,
+		Transaction: networkChangeToTransaction(transaction),
+	}
+	n.redact.transaction(&payload.Transaction)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Warnf("Unable to marshal transaction %s for webhooks. %v", transaction.ID, err)
+		return
+	}
+	for _, url := range n.urls {
+		n.deliveries.Add(1)
+		go func(url string) {
+			defer n.deliveries.Done()
+			n.deliver(ctx, url, body)
+		}(url)
+	}
+}
+
+// deliver - POST the body to the url, retrying with backoff when it fails
+func (n *webhookNotifier) deliver(ctx context.Context, url string, body []byte) {
+	backoff := n.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := n.post(ctx, url, body)
+		if err == nil {
+			log.Debugf("Webhook %s notified", url)
+			return
+		}
+		if attempt >= n.attempts {
+			log.Warnf("Giving up on webhook %s after %d attempts. %v", url, attempt, err)
+			return
+		}
+		log.Debugf("Webhook %s failed (attempt %d of %d). Retrying in %v. %v", url, attempt, n.attempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > n.maxBackoff {
+			backoff = n.maxBackoff
+		}
+	}
+}
+
+func (n *webhookNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookRecorder - a webhook that fails the first calls, as many as failures, then records the events POSTed to it
+type webhookRecorder struct {
+	mu       sync.Mutex
+	failures int
+	calls    int
+	events   []webhookEvent
+}
+
+func (w *webhookRecorder) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.calls++
+	if w.calls <= w.failures {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	var event webhookEvent
+	if err := json.NewDecoder(req.Body).Decode(&event); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.events = append(w.events, event)
+}
+
+func newTestWebhookNotifier(urls ...string) *webhookNotifier {
+	notifier := newWebhookNotifier(urls)
+	notifier.initialBackoff = time.Millisecond
+	notifier.maxBackoff = time.Millisecond
+	return notifier
+}
+
+func Test_watchForWebhooks(t *testing.T) {
+	recorder := &webhookRecorder{failures: 2}
+	webhook := httptest.NewServer(recorder)
+	defer webhook.Close()
+
+	pending := changeTransaction("tx-1", 1, "/a")
+	pending.Status.State = configapi.TransactionStatus_PENDING
+	applied := changeTransaction("tx-1", 1, "/a")
+	applied.Status.State = configapi.TransactionStatus_APPLIED
+	server := &TopLevelServer{ConfigClient: &fakeTransactionClient{
+		transactions: []*configapi.Transaction{pending, applied, applied},
+	}}
+
+	notifier := newTestWebhookNotifier(webhook.URL)
+	received, err := server.watchForWebhooks(context.Background(), notifier)
+	assert.True(t, received)
+	assert.Equal(t, io.EOF, err)
+	notifier.deliveries.Wait()
+
+	// Delivered once, on the third attempt
+	assert.Equal(t, 3, recorder.calls)
+	if assert.Len(t, recorder.events, 1) {
+		assert.Equal(t, webhookEventApplied, recorder.events[0].Event)
+		assert.Equal(t, "tx-1", recorder.events[0].Transaction.Id)
+	}
+}
+
+func Test_webhookNotifier_givesUp(t *testing.T) {
+	recorder := &webhookRecorder{failures: webhookAttempts + 1}
+	webhook := httptest.NewServer(recorder)
+	defer webhook.Close()
+
+	newTestWebhookNotifier(webhook.URL).deliver(context.Background(), webhook.URL, []byte("{}"))
+	assert.Equal(t, webhookAttempts, recorder.calls)
+	assert.Empty(t, recorder.events)
+}
+
+func Test_RunWebhooks_none(t *testing.T) {
+	// Returns straight away, without watching
+	(&TopLevelServer{}).RunWebhooks(context.Background())
+}
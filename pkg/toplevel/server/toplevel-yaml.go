@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/ghodss/yaml"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/utils"
+	"io/ioutil"
+	"mime"
+	"net/http"
+)
+
+// yamlBodyTypes - the Content-Types of a YAML body
+var yamlBodyTypes = map[string]bool{
+	mimeYAML:             true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+}
+
+// yamlBodyMiddleware - converts a YAML request body to JSON, so that the schema validation and the
+// handler after it see the JSON they would have been sent. Invalid YAML is a 400. Other bodies are
+// passed on as they are
+func yamlBodyMiddleware(maxBodyBytes int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+			if err != nil || !yamlBodyTypes[mediaType] {
+				return next(ctx)
+			}
+			body, err := utils.ReadRequestBodyLimited(req.Body, maxBodyBytes)
+			if err != nil {
+				return err
+			}
+			jsonBody, err := yaml.YAMLToJSON(body)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid YAML body. %v", err))
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(jsonBody))
+			req.ContentLength = int64(len(jsonBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package server
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/aether-roc-api/pkg/southbound"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_PatchAetherRocAPI_yaml(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := southbound.NewMockGnmiClient(ctrl)
+	var displayNames []string
+	mockClient.EXPECT().Set(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+			for _, u := range request.Update {
+				if u.Path.Elem[len(u.Path.Elem)-1].Name == "display-name" {
+					displayNames = append(displayNames, u.Val.GetStringVal())
+				}
+			}
+			return &gnmi.SetResponse{Extension: []*gnmi_ext.Extension{{
+				Ext: &gnmi_ext.Extension_RegisteredExt{RegisteredExt: &gnmi_ext.RegisteredExtension{
+					Id: 100, Msg: []byte("tx-10"),
+				}},
+			}}}, nil
+		}).Times(2)
+	e := echo.New()
+	assert.NoError(t, RegisterHandlers(e, &TopLevelServer{GnmiClient: mockClient, GnmiTimeout: time.Minute,
+		SkipTargetCheck: true}))
+	patch := func(contentType string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/aether-roc-api", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := patch("application/yaml", `
+default-target: connectivity-service-v4
+Updates:
+  site-4.0.0:
+    site:
+      - id: s1
+        display-name: Site 1
+        enterprise: e1
+`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"transactionId":"tx-10"`)
+
+	// JSON is as before
+	rec = patch(echo.MIMEApplicationJSON, `{"default-target":"connectivity-service-v4",
+"Updates":{"site-4.0.0":{"site":[{"id":"s2","display-name":"Site 2","enterprise":"e1"}]}}}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{"Site 1", "Site 2"}, displayNames)
+
+	rec = patch("application/yaml; charset=utf-8", "Updates: [unclosed")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid YAML body")
+}
@@ -143,9 +143,76 @@ type AbortPhaseState string
 // ApplyPhaseState defines model for ApplyPhaseState.
 type ApplyPhaseState string
 
+// BatchFailure a batch that was only partly applied, and whether it was rolled back
+type BatchFailure struct {
+
+	// why the batch failed, and what became of it
+	Message string `json:"message"`
+
+	// whether the transaction of the batch was rolled back, leaving the configuration as it was
+	RolledBack bool `json:"rolledBack"`
+
+	// the ID of the rollback transaction
+	RollbackTransactionId *string `json:"rollbackTransactionId,omitempty"`
+
+	// the ID of the transaction of the batch
+	TransactionId string `json:"transactionId"`
+
+	// the target and path of each update that was not applied
+	Unapplied []string `json:"unapplied"`
+}
+
+// BatchUpdate a value to set at a path of a target
+type BatchUpdate struct {
+
+	// the gNMI path of the leaf e.g. /site/site[id=site-1]/display-name
+	Path string `json:"path"`
+
+	// the target (device name) to set it on
+	Target string `json:"target"`
+
+	// the value of the leaf - a string, number or boolean
+	Value interface{} `json:"value"`
+}
+
+// BatchUpdates the values to set in a single transaction
+type BatchUpdates []BatchUpdate
+
 // Bytes defines model for Bytes.
 type Bytes []byte
 
+// Capabilities the models, encodings and gNMI version that onos-config supports
+type Capabilities struct {
+
+	// the version of gNMI e.g. 0.7.0
+	GnmiVersion string `json:"gnmiVersion"`
+
+	// the encodings of values e.g. JSON, PROTO
+	SupportedEncodings []string `json:"supportedEncodings"`
+
+	// the YANG models of the targets
+	SupportedModels []SupportedModel `json:"supportedModels"`
+}
+
+// Change represents a configuration change to a single target
+type Change struct {
+
+	// the identifier of the target to which this change applies
+	TargetId string `json:"target_id"`
+
+	// an optional target type to which to apply this change
+	TargetType *string `json:"target_type,omitempty"`
+
+	// an optional target version to which to apply this change
+	TargetVersion *string `json:"target_version,omitempty"`
+
+	// a set of change values to apply
+	Values *[]ChangeValue `json:"values,omitempty"`
+}
+
+// ChangeList the changes that a PATCH would make, one per target
+type ChangeList []Change
+
 // ChangeTarget defines model for ChangeTarget.
 type ChangeTarget struct {
 	PathValues *PathValues `json:"path-values,omitempty"`
@@ -155,9 +222,44 @@ type ChangeTarget struct {
 // ChangeTransaction defines model for ChangeTransaction.
 type ChangeTransaction []ChangeTarget
 
+// ChangeValue an individual Path/Value and removed flag combination in a Change
+type ChangeValue struct {
+
+	// the path to change
+	Path string `json:"path"`
+
+	// indicates whether this is a delete
+	Removed *bool `json:"removed,omitempty"`
+
+	// the change value
+	Value *string `json:"value,omitempty"`
+}
+
 // CommitPhaseState defines model for CommitPhaseState.
 type CommitPhaseState string
 
+// ConfigDiff the paths of a target that changed between two revisions
+type ConfigDiff struct {
+
+	// paths set at to that were not set at from
+	Added []ChangeValue `json:"added"`
+
+	// the revision compared from
+	From int64 `json:"from"`
+
+	// paths set at both revisions, with the value at to
+	Modified []ChangeValue `json:"modified"`
+
+	// paths set at from that are not set at to, with the value at from
+	Removed []ChangeValue `json:"removed"`
+
+	// the target compared
+	Target string `json:"target"`
+
+	// the revision compared to
+	To int64 `json:"to"`
+}
+
 // Deleted defines model for Deleted.
 type Deleted bool
 
@@ -210,6 +312,19 @@ type Elements struct {
 // End defines model for End.
 type End time.Time
 
+// ExpectedValue the value a leaf of a target must have for a patch to be made
+type ExpectedValue struct {
+
+	// the gNMI path of the leaf e.g. /site/site[id=site-1]/display-name, under the prefix as the updates are
+	Path string `json:"path"`
+
+	// the target (device name) of the leaf. When left out the default-target is used
+	Target *string `json:"target,omitempty"`
+
+	// the value the leaf must have - a string, number, boolean or list. null expects the leaf not to be set
+	Value interface{} `json:"value"`
+}
+
 // Failure defines model for Failure.
 type Failure struct {
 	Description *string `json:"description,omitempty"`
@@ -230,6 +345,62 @@ type InitializePhaseState string
 // Isolation defines model for Isolation.
 type Isolation string
 
+// LogLevel a log level to set, of one logger or of all of them
+type LogLevel struct {
+
+	// debug, info, warn or error
+	Level string `json:"level"`
+
+	// the logger e.g. toplevel. All of them when not given
+	Logger *string `json:"logger,omitempty"`
+}
+
+// LogLevels the effective level of each logger, by name
+type LogLevels map[string]string
+
+// ModelVersion a version of the Aether models served by the API
+type ModelVersion struct {
+
+	// the base path of the version's API e.g. /aether/v4.0.0
+	Api string `json:"api"`
+
+	// the path of the version's OpenAPI spec
+	Spec string `json:"spec"`
+
+	// the model version e.g. 4.0.0
+	Version string `json:"version"`
+}
+
+// ModelVersions defines model for ModelVersions.
+type ModelVersions []ModelVersion
+
+// Page a page of a list, as sent for Accept: application/vnd.roc.v2+json or envelope=true
+type Page struct {
+
+	// the items of the page
+	Items []interface{} `json:"items"`
+
+	// the most items asked for. 0 when not limited
+	Limit int `json:"limit"`
+
+	// the number of items before the page
+	Offset int `json:"offset"`
+
+	// the total number of items
+	Total int `json:"total"`
+}
+
+// PaginatedTargetsNames a page of the target names, returned when limit or offset is given
+type PaginatedTargetsNames struct {
+
+	// the offset of the next page. Omitted on the last page
+	Next    *int         `json:"next,omitempty"`
+	Targets TargetsNames `json:"targets"`
+
+	// the total number of targets
+	Total int `json:"total"`
+}
+
 // PatchBody defines model for PatchBody.
 type PatchBody struct {
 	Deletes *Elements `json:"Deletes,omitempty"`
@@ -253,6 +424,12 @@ type PatchBody struct {
 
 	// Target (device name) to use by default if not specified on indivdual updates/deletes as an additional property
 	DefaultTarget string `json:"default-target"`
+
+	// Values that leaves must have for the patch to be made, a compare-and-swap. They are read just before the patch is made, and if any differs it gives 412
+	Expected *[]ExpectedValue `json:"expected,omitempty"`
+
+	// A gNMI path put before the path of every update and delete, after the path prefix of the server. It is sent as the prefix of the gNMI SetRequest
+	Prefix *string `json:"prefix,omitempty"`
 }
 
 // Path defines model for Path.
@@ -289,6 +466,91 @@ type RollbackTransaction struct {
 	RollbackIndex *Index `json:"rollback_index,omitempty"`
 }
 
+// ServerConfig the effective settings of the running server. Secrets, such as keys and the credentials in webhook URLs, are not shown
+type ServerConfig struct {
+
+	// whether requests are authorized
+	Authorization bool `json:"authorization"`
+
+	// the Content-Types allowed for PATCH and PUT bodies at /aether-roc-api
+	BodyContentTypes *[]string `json:"bodyContentTypes,omitempty"`
+
+	// the roles of each client certificate identity
+	ClientCertRoles *ServerConfig_ClientCertRoles `json:"clientCertRoles,omitempty"`
+
+	// the target of requests to /aether-roc-api that do not give one
+	DefaultTarget *string `json:"defaultTarget,omitempty"`
+
+	// the address of onos-config
+	GnmiEndpoint *string `json:"gnmiEndpoint,omitempty"`
+
+	// the most gNMI gets and sets at once. 0 is unlimited
+	GnmiMaxInFlight *int `json:"gnmiMaxInFlight,omitempty"`
+
+	// how many times a gNMI get is retried while onos-config is unavailable
+	GnmiRetries *int `json:"gnmiRetries,omitempty"`
+
+	// the timeout of gNMI requests e.g. 10s
+	GnmiTimeout string `json:"gnmiTimeout"`
+
+	// the longest timeout a request may ask for
+	GnmiTimeoutMax *string `json:"gnmiTimeoutMax,omitempty"`
+
+	// the longest a request may take before it gets 503 e.g. 3m0s. 0s is unlimited
+	HandlerTimeout *string `json:"handlerTimeout,omitempty"`
+
+	// how long the token signing keys are used before they are fetched again e.g. 1h0m0s. 0s is until an unknown key ID
+	JwksCacheTTL *string `json:"jwksCacheTTL,omitempty"`
+
+	// where the token signing keys are from
+	JwksUrl     *string `json:"jwksUrl,omitempty"`
+	JwtAudience *string `json:"jwtAudience,omitempty"`
+
+	// the largest PATCH body accepted
+	MaxBodyBytes int64 `json:"maxBodyBytes"`
+
+	// the most transaction streams open at once. 0 is unlimited
+	MaxStreams *int `json:"maxStreams,omitempty"`
+
+	// the gNMI path put before the paths at /aether-roc-api. Empty for none
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+	RateBurst  *int    `json:"rateBurst,omitempty"`
+
+	// the requests per second allowed from each client IP. 0 is unlimited
+	RateLimit   *float64  `json:"rateLimit,omitempty"`
+	RedactPaths *[]string `json:"redactPaths,omitempty"`
+
+	// whether patches are sent without checking that their targets exist
+	SkipTargetCheck *bool   `json:"skipTargetCheck,omitempty"`
+	SyncPort        int     `json:"syncPort"`
+	SyncScheme      *string `json:"syncScheme,omitempty"`
+	SyncTimeout     *string `json:"syncTimeout,omitempty"`
+
+	// whether a client TLS config is given for the sdcore synchronize service
+	SyncTls       *bool                       `json:"syncTls,omitempty"`
+	TargetAliases *ServerConfig_TargetAliases `json:"targetAliases,omitempty"`
+
+	// how long the list of targets is cached e.g. 10s
+	TargetsCacheTTL string `json:"targetsCacheTTL"`
+
+	// how long the list of transactions is shared by GET /transactions requests e.g. 2s. 0s is not cached
+	TransactionsCacheTTL *string `json:"transactionsCacheTTL,omitempty"`
+
+	// the most transactions per second read from onos-config. 0 is unlimited
+	TransactionsReadRate *float64  `json:"transactionsReadRate,omitempty"`
+	TrustedProxies       *[]string `json:"trustedProxies,omitempty"`
+	ValidateRequests     *bool     `json:"validateRequests,omitempty"`
+
+	// the webhooks, with any password or query values shown as ****
+	WebhookUrls *[]string `json:"webhookUrls,omitempty"`
+}
+
+// ServerConfig_ClientCertRoles the roles of each client certificate identity
+type ServerConfig_ClientCertRoles map[string][]string
+
+// ServerConfig_TargetAliases defines model for ServerConfig.TargetAliases.
+type ServerConfig_TargetAliases map[string]string
+
 // Start defines model for Start.
 type Start time.Time
 
@@ -311,14 +573,44 @@ type Strategy struct {
 	Synchronicity *Synchronicity `json:"synchronicity,omitempty"`
 }
 
+// SupportedModel a YANG model supported by onos-config
+type SupportedModel struct {
+
+	// the name of the model
+	Name string `json:"name"`
+
+	// the organization that publishes the model
+	Organization string `json:"organization"`
+
+	// the version of the model
+	Version string `json:"version"`
+}
+
 // Synchronicity defines model for Synchronicity.
 type Synchronicity string
 
+// TargetHealth the connection and sync status of a target
+type TargetHealth struct {
+
+	// whether onos-config can give the configuration of the target
+	Connected bool `json:"connected"`
+
+	// when a transaction was last applied to the target
+	LastSync *time.Time `json:"lastSync,omitempty"`
+	Name     string     `json:"name"`
+}
+
 // TargetName defines model for TargetName.
 type TargetName struct {
-	Name *string `json:"name,omitempty"`
+
+	// a human readable name of the target, when it has an alias
+	DisplayName *string `json:"displayName,omitempty"`
+	Name        *string `json:"name,omitempty"`
 }
 
+// TargetsHealth defines model for TargetsHealth.
+type TargetsHealth []TargetHealth
+
 // TargetsNames defines model for TargetsNames.
 type TargetsNames []TargetName
 
@@ -330,7 +622,8 @@ type Transaction struct {
 	Id string `json:"id"`
 
 	// a monotonically increasing, globally unique index of the change
-	Index int64 `json:"index"`
+	Index int64             `json:"index"`
+	Links *TransactionLinks `json:"links,omitempty"`
 
 	// the meta of the Transaction
 	Meta struct {
@@ -351,8 +644,11 @@ type Transaction struct {
 		// the version of the Transaction
 		Version *int64 `json:"version,omitempty"`
 	} `json:"meta"`
-	Status   *Status   `json:"status,omitempty"`
-	Strategy *Strategy `json:"strategy,omitempty"`
+
+	// the ID of the HTTP request that made the transaction, when it was made through this API
+	RequestId *string   `json:"requestId,omitempty"`
+	Status    *Status   `json:"status,omitempty"`
+	Strategy  *Strategy `json:"strategy,omitempty"`
 
 	// the name of the user that made the transaction
 	Username *string `json:"username,omitempty"`
@@ -377,6 +673,23 @@ type TransactionCommitPhase struct {
 	Status *TransactionPhaseStatus `json:"status,omitempty"`
 }
 
+// TransactionCount the number of transactions, from GET /transactions?count=true
+type TransactionCount struct {
+
+	// the number of transactions that match the filters
+	Count int64 `json:"count"`
+}
+
+// TransactionInfo the transaction made by a change, to be followed with GET /transactions/{id}
+type TransactionInfo struct {
+
+	// the index of the transaction, which is the revision of the configuration after the change
+	Index *int64 `json:"index,omitempty"`
+
+	// the ID of the transaction
+	TransactionId string `json:"transactionId"`
+}
+
 // TransactionInitializePhase defines model for TransactionInitializePhase.
 type TransactionInitializePhase struct {
 	Failure *Failure                `json:"failure,omitempty"`
@@ -384,6 +697,19 @@ type TransactionInitializePhase struct {
 	Status  *TransactionPhaseStatus `json:"status,omitempty"`
 }
 
+// TransactionLinks links to the transactions that are causally related to a transaction
+type TransactionLinks struct {
+
+	// the ID of the transaction that rolled back this transaction
+	RolledBackBy *string `json:"rolled-back-by,omitempty"`
+
+	// the ID of the transaction that this transaction rolls back
+	RollbackOf *string `json:"rollback-of,omitempty"`
+
+	// the IDs of earlier transactions whose values on the same paths this transaction overwrote
+	Supersedes *[]string `json:"supersedes,omitempty"`
+}
+
 // TransactionList defines model for TransactionList.
 type TransactionList []Transaction
 
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const cliIndent = "    "
+
+// listKeyNames - the attributes used (in order of preference) to name the entries of a list
+var listKeyNames = []string{"id", "name", "imsi-id", "app-id", "endpoint-id"}
+
+// RenderCLIConfig - render a decoded JSON config tree as indented, CLI-like hierarchical text.
+// This is intended only for human readability - it is not meant to be parsed back.
+func RenderCLIConfig(doc interface{}) string {
+	var b strings.Builder
+	renderCLINode(&b, "", doc, 0)
+	return b.String()
+}
+
+func renderCLINode(b *strings.Builder, name string, node interface{}, depth int) {
+	indent := strings.Repeat(cliIndent, depth)
+	switch n := node.(type) {
+	case map[string]interface{}:
+		childDepth := depth
+		if name != "" {
+			fmt.Fprintf(b, "%s%s {\n", indent, name)
+			childDepth++
+		}
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			renderCLINode(b, cliName(k), n[k], childDepth)
+		}
+		if name != "" {
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	case []interface{}:
+		if !isObjectList(n) {
+			values := make([]string, 0, len(n))
+			for _, v := range n {
+				values = append(values, cliValue(v))
+			}
+			fmt.Fprintf(b, "%s%s [ %s ]\n", indent, name, strings.Join(values, " "))
+			return
+		}
+		for idx, elem := range n {
+			renderCLINode(b, fmt.Sprintf("%s %s", name, listEntryName(elem.(map[string]interface{}), idx)), elem, depth)
+		}
+	default:
+		fmt.Fprintf(b, "%s%s %s\n", indent, name, cliValue(n))
+	}
+}
+
+// cliName drops any YANG module prefix, e.g. "connectivity-service:connectivity-service"
+func cliName(key string) string {
+	return key[strings.LastIndex(key, ":")+1:]
+}
+
+func cliValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if v == "" || strings.ContainsAny(v, " \t\"{}[]") {
+			return fmt.Sprintf("%q", v)
+		}
+		return v
+	case nil:
+		return `""`
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func isObjectList(list []interface{}) bool {
+	if len(list) == 0 {
+		return false
+	}
+	for _, elem := range list {
+		if _, ok := elem.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// listEntryName - the value of the entry's key attribute, or else its position in the list
+func listEntryName(entry map[string]interface{}, idx int) string {
+	for _, k := range listKeyNames {
+		for attr, value := range entry {
+			if cliName(attr) == k {
+				return cliValue(value)
+			}
+		}
+	}
+	return fmt.Sprintf("%d", idx)
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"encoding/json"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func Test_RenderCLIConfig(t *testing.T) {
+	var doc interface{}
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "enterprise:enterprise": {
+    "enterprise": [
+      {"id": "acme", "description": "ACME Corp", "connectivity-service": [{"connectivity-service": "cs4", "enabled": true}]}
+    ]
+  },
+  "site:site": {"site": [{"id": "s1", "dns-servers": ["1.1.1.1", "8.8.8.8"]}]}
+}`), &doc))
+
+	expected := `enterprise {
+    enterprise acme {
+        connectivity-service 0 {
+            connectivity-service cs4
+            enabled true
+        }
+        description "ACME Corp"
+        id acme
+    }
+}
+site {
+    site s1 {
+        dns-servers [ 1.1.1.1 8.8.8.8 ]
+        id s1
+    }
+}
+`
+	assert.Equal(t, expected, RenderCLIConfig(doc))
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// ParseFieldMask - split a field mask like "a.b,c.d" in to its individual field paths
+func ParseFieldMask(fieldMask string) []string {
+	paths := make([]string, 0)
+	for _, p := range strings.Split(fieldMask, ",") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// ApplyFieldMask - project a decoded JSON document down to only the fields named in the paths.
+// Each path is a "." separated list of object keys. Arrays are traversed transparently, so
+// that the mask is applied to each of their elements.
+// A path that does not match anything in this document just adds nothing to it, since an optional
+// field may be absent from one response and present in the next. Only malformed paths, with an
+// empty key, are returned as invalid.
+func ApplyFieldMask(doc interface{}, paths []string) (interface{}, []string) {
+	var masked interface{}
+	invalid := make([]string, 0)
+	for _, p := range paths {
+		keys := strings.Split(p, ".")
+		if !validFieldPath(keys) {
+			invalid = append(invalid, p)
+			continue
+		}
+		masked, _ = projectField(doc, masked, keys)
+	}
+	if _, isMap := doc.(map[string]interface{}); isMap && masked == nil {
+		masked = make(map[string]interface{})
+	}
+	sort.Strings(invalid)
+	return masked, invalid
+}
+
+// validFieldPath - whether none of the keys of a field path is blank
+func validFieldPath(keys []string) bool {
+	for _, k := range keys {
+		if strings.TrimSpace(k) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// projectField copies the field at path from src in to dst, returning the updated dst
+func projectField(src interface{}, dst interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return src, true
+	}
+	switch srcTyped := src.(type) {
+	case map[string]interface{}:
+		child, ok := srcTyped[path[0]]
+		if !ok {
+			return dst, false
+		}
+		dstMap, ok := dst.(map[string]interface{})
+		if !ok {
+			dstMap = make(map[string]interface{})
+		}
+		projected, found := projectField(child, dstMap[path[0]], path[1:])
+		if found {
+			dstMap[path[0]] = projected
+		}
+		return dstMap, found
+	case []interface{}:
+		dstList, ok := dst.([]interface{})
+		if !ok || len(dstList) != len(srcTyped) {
+			dstList = make([]interface{}, len(srcTyped))
+		}
+		var anyFound bool
+		for idx, elem := range srcTyped {
+			projected, found := projectField(elem, dstList[idx], path)
+			if found {
+				dstList[idx] = projected
+				anyFound = true
+			} else if _, isMap := elem.(map[string]interface{}); isMap && dstList[idx] == nil {
+				dstList[idx] = make(map[string]interface{})
+			}
+		}
+		return dstList, anyFound
+	default:
+		return dst, false
+	}
+}
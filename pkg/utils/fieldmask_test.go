@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"encoding/json"
+	"gotest.tools/assert"
+	"testing"
+)
+
+const testFieldMaskDoc = `{
+  "id": "acme",
+  "description": "ACME Corp",
+  "site": [
+    {"id": "s1", "imsi-definition": {"mcc": "123", "mnc": "456"}, "small-cell": [{"address": "a1"}]},
+    {"id": "s2", "imsi-definition": {"mcc": "321", "mnc": "654"}}
+  ]
+}`
+
+func Test_ParseFieldMask(t *testing.T) {
+	assert.DeepEqual(t, []string{"a.b", "c.d"}, ParseFieldMask("a.b, c.d,"))
+	assert.Equal(t, 0, len(ParseFieldMask("")))
+}
+
+func Test_ApplyFieldMask(t *testing.T) {
+	var doc interface{}
+	assert.NilError(t, json.Unmarshal([]byte(testFieldMaskDoc), &doc))
+
+	masked, invalid := ApplyFieldMask(doc, []string{"id", "site.imsi-definition.mcc", "site.small-cell.address"})
+	assert.Equal(t, 0, len(invalid))
+	maskedJSON, err := json.Marshal(masked)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"id":"acme","site":[{"imsi-definition":{"mcc":"123"},"small-cell":[{"address":"a1"}]},{"imsi-definition":{"mcc":"321"}}]}`,
+		string(maskedJSON))
+}
+
+func Test_ApplyFieldMask_Missing(t *testing.T) {
+	var doc interface{}
+	assert.NilError(t, json.Unmarshal([]byte(testFieldMaskDoc), &doc))
+
+	masked, invalid := ApplyFieldMask(doc, []string{"id", "site.nothere", "missing", "id.toodeep"})
+	assert.Equal(t, 0, len(invalid))
+	maskedJSON, err := json.Marshal(masked)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"id":"acme"}`, string(maskedJSON))
+
+	masked, invalid = ApplyFieldMask(doc, []string{"missing"})
+	assert.Equal(t, 0, len(invalid))
+	maskedJSON, err = json.Marshal(masked)
+	assert.NilError(t, err)
+	assert.Equal(t, `{}`, string(maskedJSON))
+}
+
+func Test_ApplyFieldMask_Invalid(t *testing.T) {
+	var doc interface{}
+	assert.NilError(t, json.Unmarshal([]byte(testFieldMaskDoc), &doc))
+
+	_, invalid := ApplyFieldMask(doc, []string{"id", "site..id", ".id", "site."})
+	assert.DeepEqual(t, []string{".id", "site.", "site..id"}, invalid)
+}
@@ -160,13 +160,25 @@ func NewGnmiSetRequest(updates []*gnmi.Update, deletes []*gnmi.Path,
 
 // ExtractResponseID - the name of the change will be returned as extension 100
 func ExtractResponseID(gnmiResponse *gnmi.SetResponse) (*string, error) {
+	transactionInfo, err := ExtractTransactionInfo(gnmiResponse)
+	if err != nil {
+		return nil, err
+	}
+	changeName := string(transactionInfo.ID)
+	return &changeName, nil
+}
+
+// ExtractTransactionInfo - the ID and index of the change will be returned as extension 110
+// For older versions of onos-config only the name is given, as extension 100, and the index is left at 0
+func ExtractTransactionInfo(gnmiResponse *gnmi.SetResponse) (*configapi.TransactionInfo, error) {
 	for _, ext := range gnmiResponse.Extension {
 		switch extTyped := ext.Ext.(type) {
 		case *gnmi_ext.Extension_RegisteredExt:
 			// NOTE this is used in ONOS config
 			if extTyped.RegisteredExt.Id == 100 {
-				changeName := string(extTyped.RegisteredExt.Msg)
-				return &changeName, nil
+				return &configapi.TransactionInfo{
+					ID: configapi.TransactionID(extTyped.RegisteredExt.Msg),
+				}, nil
 			}
 			if extTyped.RegisteredExt.Id == configapi.TransactionInfoExtensionID {
 				bytes := extTyped.RegisteredExt.Msg
@@ -176,8 +188,7 @@ func ExtractResponseID(gnmiResponse *gnmi.SetResponse) (*string, error) {
 					log.Errorw("cannot unmarshal transactionInfo", "err", err)
 					return nil, err
 				}
-				changeName := string(transactionInfo.ID)
-				return &changeName, nil
+				return transactionInfo, nil
 			}
 		}
 	}
@@ -8,7 +8,9 @@ package utils
 import (
 	"github.com/onosproject/config-models/modelplugin/aether-2.0.0/aether_2_0_0"
 	"github.com/onosproject/config-models/modelplugin/aether-4.0.0/aether_4_0_0"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
 	"gotest.tools/assert"
 	"reflect"
 	"testing"
@@ -286,3 +288,36 @@ func Test_findChildByParamName_5GCore(t *testing.T) {
 	assert.Equal(t, "Core_5GEndpoint", field.Name)
 	assert.Equal(t, 2, skipped)
 }
+
+func Test_ExtractTransactionInfo(t *testing.T) {
+	transactionInfo := configapi.TransactionInfo{
+		ID:    "abc-123",
+		Index: 42,
+	}
+	infoBytes, err := transactionInfo.Marshal()
+	assert.NilError(t, err)
+
+	gnmiResponse := &gnmi.SetResponse{
+		Extension: []*gnmi_ext.Extension{
+			{
+				Ext: &gnmi_ext.Extension_RegisteredExt{
+					RegisteredExt: &gnmi_ext.RegisteredExtension{
+						Id:  configapi.TransactionInfoExtensionID,
+						Msg: infoBytes,
+					},
+				},
+			},
+		},
+	}
+	info, err := ExtractTransactionInfo(gnmiResponse)
+	assert.NilError(t, err)
+	assert.Equal(t, configapi.TransactionID("abc-123"), info.ID)
+	assert.Equal(t, configapi.Index(42), info.Index)
+
+	id, err := ExtractResponseID(gnmiResponse)
+	assert.NilError(t, err)
+	assert.Equal(t, "abc-123", *id)
+
+	_, err = ExtractTransactionInfo(&gnmi.SetResponse{})
+	assert.Error(t, err, "cannot find transaction ID in response")
+}
@@ -9,8 +9,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"time"
 )
 
@@ -19,9 +22,20 @@ const (
 	host          = "Host"
 	userAgent     = "User-Agent"
 	remoteAddr    = "remoteaddr"
+	// ContextUsername - the key of the authenticated user's name, both on the echo context and
+	// in the gNMI metadata, so onos-config can attribute changes to the user
+	ContextUsername = "username"
+	// HeaderGnmiTimeout - lets a request ask for a different timeout for its calls to onos-config
+	HeaderGnmiTimeout = "X-Gnmi-Timeout"
+	// ContextRequestID - the key of the request's ID, both on the echo context and in the gNMI
+	// metadata, so the logs of onos-config can be matched with ours
+	ContextRequestID = "x-request-id"
+	// ContextClientIdentities - the key of the common name and SANs of the request's verified client
+	// certificate, on the echo context
+	ContextClientIdentities = "clientIdentities"
 )
 
-//ReadRequestBody - read the bytes from the Request Body
+// ReadRequestBody - read the bytes from the Request Body
 func ReadRequestBody(bodyReader io.ReadCloser) ([]byte, error) {
 	body := make([]byte, 0)
 	buf := make([]byte, 100)
@@ -39,14 +53,95 @@ func ReadRequestBody(bodyReader io.ReadCloser) ([]byte, error) {
 	return body, nil
 }
 
+// ReadRequestBodyLimited - read the bytes from the Request Body, failing with 413 Payload Too Large
+// rather than reading more than maxBytes in to memory
+func ReadRequestBodyLimited(bodyReader io.ReadCloser, maxBytes int64) ([]byte, error) {
+	defer bodyReader.Close()
+	body, err := ReadRequestBody(ioutil.NopCloser(io.LimitReader(bodyReader, maxBytes+1)))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, echo.NewHTTPError(http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("request body is larger than the limit of %d bytes", maxBytes))
+	}
+	return body, nil
+}
+
 // NewGnmiContext - convert the HTTP context in to a gRPC Context
 func NewGnmiContext(httpContext echo.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx = context.WithValue(ctx, gnmiTimeoutKey{}, timeout)
+	// Kept for ExtendGnmiContext, which is still cancelled with the request
+	ctx = context.WithValue(ctx, requestContextKey{}, httpContext.Request().Context())
+	// Keep the request's span, so that the spans of the gNMI calls are its children
+	ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(httpContext.Request().Context()))
+	return withGnmiMetadata(ctx, httpContext), cancel
+}
+
+// NewGnmiStreamContext - a gRPC Context for a gNMI stream held open for as long as the HTTP request.
+// It has no timeout, and is cancelled when the client goes away
+func NewGnmiStreamContext(httpContext echo.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(httpContext.Request().Context())
+	return withGnmiMetadata(ctx, httpContext), cancel
+}
+
+// withGnmiMetadata - ctx with the user, request ID and client of the HTTP request as gRPC metadata
+func withGnmiMetadata(ctx context.Context, httpContext echo.Context) context.Context {
+	if username := RequestUsername(httpContext); username != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, ContextUsername, username)
+	}
+	if requestID := RequestID(httpContext); requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, ContextRequestID, requestID)
+	}
 
 	return metadata.AppendToOutgoingContext(ctx,
 		authorization, httpContext.Request().Header.Get(authorization),
 		host, httpContext.Request().Host,
 		"ua", httpContext.Request().Header.Get(userAgent), // `User-Agent` would be over written by gRPC
-		remoteAddr, httpContext.Request().RemoteAddr), cancel
+		remoteAddr, httpContext.Request().RemoteAddr)
+}
+
+// RequestGnmiTimeout - the timeout asked for in the X-Gnmi-Timeout header, a Go duration e.g. "90s",
+// clamped to maxTimeout. defaultTimeout if there is no header or it is not a positive duration
+func RequestGnmiTimeout(httpContext echo.Context, defaultTimeout time.Duration, maxTimeout time.Duration) time.Duration {
+	header := httpContext.Request().Header.Get(HeaderGnmiTimeout)
+	if header == "" {
+		return defaultTimeout
+	}
+	timeout, err := time.ParseDuration(header)
+	if err != nil || timeout <= 0 {
+		log.Warnf("Ignoring %s %q, using %s", HeaderGnmiTimeout, header, defaultTimeout)
+		return defaultTimeout
+	}
+	if timeout > maxTimeout {
+		return maxTimeout
+	}
+	return timeout
+}
+
+// RequestUsername - the name of the authenticated user of the request. Empty if there is none
+func RequestUsername(httpContext echo.Context) string {
+	if username, ok := httpContext.Get(ContextUsername).(string); ok {
+		return username
+	}
+	return ""
+}
+
+// RequestClientIdentities - the common name and SANs of the verified client certificate of the request.
+// Empty if it has none
+func RequestClientIdentities(httpContext echo.Context) []string {
+	if identities, ok := httpContext.Get(ContextClientIdentities).([]string); ok {
+		return identities
+	}
+	return nil
+}
+
+// RequestID - the ID of the request, from X-Request-ID or generated for it. Empty if it has none
+func RequestID(httpContext echo.Context) string {
+	if requestID, ok := httpContext.Get(ContextRequestID).(string); ok {
+		return requestID
+	}
+	return ""
 }
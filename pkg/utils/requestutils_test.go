@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"github.com/labstack/echo/v4"
+	"gotest.tools/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ReadRequestBodyLimited(t *testing.T) {
+	body, err := ReadRequestBodyLimited(ioutil.NopCloser(strings.NewReader("0123456789")), 10)
+	assert.NilError(t, err)
+	assert.Equal(t, "0123456789", string(body))
+
+	_, err = ReadRequestBodyLimited(ioutil.NopCloser(strings.NewReader("0123456789A")), 10)
+	httpErr, ok := err.(*echo.HTTPError)
+	assert.Assert(t, ok)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, httpErr.Code)
+}
+
+func Test_RequestGnmiTimeout(t *testing.T) {
+	e := echo.New()
+	timeoutFor := func(header string) time.Duration {
+		req := httptest.NewRequest(http.MethodGet, "/targets", nil)
+		if header != "" {
+			req.Header.Set(HeaderGnmiTimeout, header)
+		}
+		return RequestGnmiTimeout(e.NewContext(req, httptest.NewRecorder()), 10*time.Second, time.Minute)
+	}
+
+	assert.Equal(t, 10*time.Second, timeoutFor(""))
+	assert.Equal(t, 45*time.Second, timeoutFor("45s"))
+	assert.Equal(t, 2*time.Second, timeoutFor("2s"))
+	assert.Equal(t, time.Minute, timeoutFor("1h"))
+	assert.Equal(t, 10*time.Second, timeoutFor("soon"))
+	assert.Equal(t, 10*time.Second, timeoutFor("-5s"))
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	retryInitialBackoff = 100 * time.Millisecond
+	retryMaxBackoff     = 2 * time.Second
+)
+
+// RetryGnmiCall - make the gNMI call, and if it fails with Unavailable or DeadlineExceeded (as happens while
+// onos-config restarts) retry it up to retries more times with exponential backoff.
+// Any other error is returned straight away
+func RetryGnmiCall(ctx context.Context, retries int, call func() error) error {
+	backoff := retryInitialBackoff
+	for attempt := 0; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= retries || !isRetryable(err) {
+			return err
+		}
+		log.Warnf("gNMI call failed (attempt %d of %d). Retrying in %v. %v", attempt+1, retries+1, backoff, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > retryMaxBackoff {
+			backoff = retryMaxBackoff
+		}
+	}
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// gnmiTimeoutKey - the key of the timeout a gNMI context was made with
+type gnmiTimeoutKey struct{}
+
+// GnmiContextTimeout - the timeout the context was made with by NewGnmiContext or ExtendGnmiContext.
+// False if it was not made by them
+func GnmiContextTimeout(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(gnmiTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// requestContextKey - the key of the context of the HTTP request a gNMI context was made for
+type requestContextKey struct{}
+
+// ExtendGnmiContext - a context with the values of ctx, its gNMI metadata and span, but a new timeout
+// from now. It is not cancelled with ctx, so can be used once ctx has expired. It is still cancelled
+// with the HTTP request ctx was made for, as when the client goes away or the handler times out
+func ExtendGnmiContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	parent := DetachedContext(ctx)
+	if request, ok := ctx.Value(requestContextKey{}).(context.Context); ok {
+		parent = requestValuesContext{Context: request, values: ctx}
+	}
+	extended, cancel := context.WithTimeout(parent, timeout)
+	return context.WithValue(extended, gnmiTimeoutKey{}, timeout), cancel
+}
+
+// IsDeadlineExceeded - whether the gNMI call failed because its context ran out of time
+func IsDeadlineExceeded(err error) bool {
+	return status.Code(err) == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded)
+}
+
+// DetachedContext - a context with the values of ctx e.g. its span and gNMI metadata, but without its
+// deadline or cancellation, for work that must outlive the request that started it
+func DetachedContext(ctx context.Context) context.Context {
+	return valuesContext{parent: ctx}
+}
+
+// valuesContext - the values of its parent, without its deadline or cancellation
+type valuesContext struct {
+	parent context.Context
+}
+
+func (valuesContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (valuesContext) Done() <-chan struct{} { return nil }
+
+func (valuesContext) Err() error { return nil }
+
+func (c valuesContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// requestValuesContext - the deadline and cancellation of an HTTP request, with the values of a gNMI context
+type requestValuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c requestValuesContext) Value(key interface{}) interface{} { return c.values.Value(key) }
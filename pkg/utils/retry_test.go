@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_RetryGnmiCall(t *testing.T) {
+	calls := 0
+	err := RetryGnmiCall(context.Background(), 3, func() error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "restarting")
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = RetryGnmiCall(context.Background(), 3, func() error {
+		calls++
+		return status.Error(codes.InvalidArgument, "bad path")
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	err = RetryGnmiCall(context.Background(), 1, func() error {
+		calls++
+		return status.Error(codes.DeadlineExceeded, "slow")
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, 2, calls)
+}
+
+func Test_ExtendGnmiContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	ctx = context.WithValue(ctx, gnmiTimeoutKey{}, time.Millisecond)
+	ctx = metadata.AppendToOutgoingContext(ctx, ContextUsername, "alice")
+	<-ctx.Done()
+
+	extended, cancelExtended := ExtendGnmiContext(ctx, time.Minute)
+	defer cancelExtended()
+	assert.NilError(t, extended.Err())
+	deadline, ok := extended.Deadline()
+	assert.Assert(t, ok)
+	assert.Assert(t, time.Until(deadline) > 50*time.Second)
+	timeout, ok := GnmiContextTimeout(extended)
+	assert.Assert(t, ok)
+	assert.Equal(t, time.Minute, timeout)
+	md, _ := metadata.FromOutgoingContext(extended)
+	assert.DeepEqual(t, []string{"alice"}, md.Get(ContextUsername))
+
+	_, ok = GnmiContextTimeout(context.Background())
+	assert.Assert(t, !ok)
+	assert.Assert(t, IsDeadlineExceeded(ctx.Err()))
+	assert.Assert(t, IsDeadlineExceeded(status.Error(codes.DeadlineExceeded, "slow")))
+	assert.Assert(t, !IsDeadlineExceeded(status.Error(codes.Unavailable, "restarting")))
+}
+
+func Test_ExtendGnmiContext_request(t *testing.T) {
+	reqCtx, cancelRequest := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/targets", nil).WithContext(reqCtx)
+	req.Header.Set(authorization, "Bearer token")
+	ctx, cancel := NewGnmiContext(echo.New().NewContext(req, httptest.NewRecorder()), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	// Only the expired gNMI deadline is replaced
+	extended, cancelExtended := ExtendGnmiContext(ctx, time.Minute)
+	defer cancelExtended()
+	assert.NilError(t, extended.Err())
+	md, _ := metadata.FromOutgoingContext(extended)
+	assert.DeepEqual(t, []string{"Bearer token"}, md.Get(authorization))
+
+	cancelRequest()
+	select {
+	case <-extended.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the extended context was not cancelled with the request")
+	}
+	assert.Equal(t, context.Canceled, extended.Err())
+}
+
+func Test_DetachedContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(context.Background(), ContextUsername, "alice"))
+	detached := DetachedContext(ctx)
+	cancel()
+
+	assert.NilError(t, detached.Err())
+	_, ok := detached.Deadline()
+	assert.Assert(t, !ok)
+	md, _ := metadata.FromOutgoingContext(detached)
+	assert.DeepEqual(t, []string{"alice"}, md.Get(ContextUsername))
+}
@@ -8,6 +8,9 @@ package utils
 import (
 	"fmt"
 	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"strings"
 )
@@ -23,8 +26,58 @@ const (
 	respInvalidValidation = `rpc error: code = InvalidArgument desc = rpc error: code = InvalidArgument desc = validation error field name`
 	respInvalidBase       = `rpc error: code = InvalidArgument desc =`
 	respUnauthorized      = `rpc error: code = Unauthenticated desc =`
+	respCodePrefix        = `rpc error: code = `
 )
 
+// grpcCodeToHTTP - the HTTP status for gRPC codes that are not matched by message
+var grpcCodeToHTTP = map[codes.Code]int{
+	codes.NotFound:         http.StatusNotFound,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.InvalidArgument:  http.StatusBadRequest,
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.Unavailable:      http.StatusServiceUnavailable,
+}
+
+// GrpcErrorBody - the JSON body of an error response that came from a gRPC call
+type GrpcErrorBody struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	GrpcStatus string `json:"grpcStatus"`
+}
+
+// String - keeps the HTTPError text to just the message
+func (b GrpcErrorBody) String() string {
+	return b.Message
+}
+
+func newGrpcHTTPError(httpCode int, grpcCode codes.Code, msg string) *echo.HTTPError {
+	return echo.NewHTTPError(httpCode, GrpcErrorBody{
+		Code:       httpCode,
+		Message:    msg,
+		GrpcStatus: grpcCode.String(),
+	})
+}
+
+// grpcCode - the gRPC code of err, whether it is a gRPC status, an onos typed error
+// or has been flattened in to a string
+func grpcCode(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	if _, ok := err.(*errors.TypedError); ok {
+		return errors.Status(err).Code()
+	}
+	if strings.HasPrefix(err.Error(), respCodePrefix) {
+		name := strings.SplitN(err.Error()[len(respCodePrefix):], " ", 2)[0]
+		for c := codes.OK; c <= codes.Unauthenticated; c++ {
+			if c.String() == name {
+				return c
+			}
+		}
+	}
+	return codes.Unknown
+}
+
 // ConvertGrpcError - capture gRPC error messages properly
 func ConvertGrpcError(err error) *echo.HTTPError {
 
@@ -34,8 +87,9 @@ func ConvertGrpcError(err error) *echo.HTTPError {
 		return e
 	}
 
+	code := grpcCode(err)
 	if strings.HasPrefix(err.Error(), respInternalInvalid) {
-		return echo.NewHTTPError(http.StatusNoContent, err.Error())
+		return newGrpcHTTPError(http.StatusNoContent, code, err.Error())
 	} else if strings.HasPrefix(err.Error(), respInvalidValidation) {
 		var msg string
 		remainingErr := err.Error()[110:]
@@ -55,12 +109,14 @@ func ConvertGrpcError(err error) *echo.HTTPError {
 		} else {
 			msg = remainingErr
 		}
-		return echo.NewHTTPError(http.StatusBadRequest, msg)
+		return newGrpcHTTPError(http.StatusBadRequest, code, msg)
 	} else if strings.HasPrefix(err.Error(), respInvalidBase) {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return newGrpcHTTPError(http.StatusBadRequest, code, err.Error())
 	} else if strings.HasPrefix(err.Error(), respUnauthorized) {
-		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		return newGrpcHTTPError(http.StatusUnauthorized, code, err.Error())
+	} else if httpCode, ok := grpcCodeToHTTP[code]; ok {
+		return newGrpcHTTPError(httpCode, code, err.Error())
 	} else {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return newGrpcHTTPError(http.StatusInternalServerError, code, err.Error())
 	}
 }
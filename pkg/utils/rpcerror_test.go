@@ -6,8 +6,12 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gotest.tools/assert"
 	"net/http"
 	"testing"
@@ -74,3 +78,40 @@ func Test_ConvertGrpcError_Internal(t *testing.T) {
 	httpError := ConvertGrpcError(fmt.Errorf(validationErrMsg))
 	assert.Error(t, httpError, "code=500, message=rpc error: code = test1234")
 }
+
+func Test_ConvertGrpcError_Codes(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		httpCode   int
+		grpcStatus string
+	}{
+		{"not found", status.Error(codes.NotFound, "no such target"), http.StatusNotFound, "NotFound"},
+		{"permission denied", status.Error(codes.PermissionDenied, "not allowed"), http.StatusForbidden, "PermissionDenied"},
+		{"invalid argument", status.Error(codes.InvalidArgument, "bad path"), http.StatusBadRequest, "InvalidArgument"},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), http.StatusServiceUnavailable, "Unavailable"},
+		{"unauthenticated", status.Error(codes.Unauthenticated, "no token"), http.StatusUnauthorized, "Unauthenticated"},
+		{"typed not found", errors.NewNotFound("no such transaction"), http.StatusNotFound, "NotFound"},
+		{"flattened unavailable", fmt.Errorf("rpc error: code = Unavailable desc = down"), http.StatusServiceUnavailable, "Unavailable"},
+		{"internal", status.Error(codes.Internal, "broken"), http.StatusInternalServerError, "Internal"},
+		{"plain", fmt.Errorf("something else"), http.StatusInternalServerError, "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpError := ConvertGrpcError(tt.err)
+			assert.Equal(t, tt.httpCode, httpError.Code)
+			body, ok := httpError.Message.(GrpcErrorBody)
+			assert.Assert(t, ok)
+			assert.Equal(t, tt.httpCode, body.Code)
+			assert.Equal(t, tt.err.Error(), body.Message)
+			assert.Equal(t, tt.grpcStatus, body.GrpcStatus)
+		})
+	}
+}
+
+func Test_ConvertGrpcError_JSONBody(t *testing.T) {
+	httpError := ConvertGrpcError(status.Error(codes.NotFound, "no such target"))
+	body, err := json.Marshal(httpError.Message)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"code":404,"message":"rpc error: code = NotFound desc = no such target","grpcStatus":"NotFound"}`, string(body))
+}
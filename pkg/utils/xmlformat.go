@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+const (
+	xmlItem  = "item"
+	xmlEntry = "entry"
+)
+
+// xmlName - object keys that can be used as XML element names as they are. Others, like
+// the paths of a spec, are written as <entry key="...">
+var xmlName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9._-]*$`)
+
+// JSONToXML - convert a JSON document to XML under a root element. Objects become child
+// elements named by their keys, and each member of an array becomes an <item>
+func JSONToXML(root string, j []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode JSON %v", err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	enc := xml.NewEncoder(&b)
+	enc.Indent("", "  ")
+	if err := encodeXMLNode(enc, xml.StartElement{Name: xml.Name{Local: root}}, doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func encodeXMLNode(enc *xml.Encoder, start xml.StartElement, node interface{}) error {
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := xml.StartElement{Name: xml.Name{Local: k}}
+			if !xmlName.MatchString(k) {
+				child = xml.StartElement{
+					Name: xml.Name{Local: xmlEntry},
+					Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: k}},
+				}
+			}
+			if err := encodeXMLNode(enc, child, n[k]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, v := range n {
+			if err := encodeXMLNode(enc, xml.StartElement{Name: xml.Name{Local: xmlItem}}, v); err != nil {
+				return err
+			}
+		}
+	case nil:
+	default:
+		if err := enc.EncodeToken(xml.CharData(fmt.Sprintf("%v", n))); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
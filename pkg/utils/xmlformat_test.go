@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package utils
+
+import (
+	"gotest.tools/assert"
+	"testing"
+)
+
+func Test_JSONToXML(t *testing.T) {
+	xmlResp, err := JSONToXML("spec", []byte(`{
+  "openapi": "3.0.0",
+  "paths": {"/targets": {"get": {"operationId": "get-targets"}}},
+  "tags": ["a", "b <&>"],
+  "nullable": null,
+  "port": 8080
+}`))
+	assert.NilError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<spec>
+  <nullable></nullable>
+  <openapi>3.0.0</openapi>
+  <paths>
+    <entry key="/targets">
+      <get>
+        <operationId>get-targets</operationId>
+      </get>
+    </entry>
+  </paths>
+  <port>8080</port>
+  <tags>
+    <item>a</item>
+    <item>b &lt;&amp;&gt;</item>
+  </tags>
+</spec>`, string(xmlResp))
+}
+
+func Test_JSONToXML_invalid(t *testing.T) {
+	_, err := JSONToXML("spec", []byte(`{"openapi":`))
+	assert.ErrorContains(t, err, "unable to decode JSON")
+}